@@ -0,0 +1,6 @@
+data "proxmox_acls" "all" {}
+
+# Report every administrator granted on the whole cluster.
+output "cluster_administrators" {
+  value = [for acl in data.proxmox_acls.all.acls : acl.ugid if acl.path == "/" && acl.role_id == "Administrator"]
+}
@@ -0,0 +1,22 @@
+# Restore the latest backup of the database VM from the PBS datastore as a
+# separate VM, e.g. to rehearse disaster recovery.
+data "proxmox_backups" "db" {
+  node    = "pve1"
+  storage = "pbs"
+  vm_id   = 100
+}
+
+resource "proxmox_vm_restore" "db_rehearsal" {
+  node    = "pve2"
+  vm_id   = 9100
+  archive = data.proxmox_backups.db.backups[0].volume_id
+  storage = "local-lvm"
+  unique  = true
+}
+
+output "db_latest_backup" {
+  value = {
+    volume_id = data.proxmox_backups.db.backups[0].volume_id
+    created   = formatdate("YYYY-MM-DD hh:mm", timeadd("1970-01-01T00:00:00Z", "${data.proxmox_backups.db.backups[0].creation_time}s"))
+  }
+}
@@ -0,0 +1,21 @@
+data "proxmox_ceph_pools" "ceph" {
+  node = "pve1"
+}
+
+locals {
+  vm_pool = one([for pool in data.proxmox_ceph_pools.ceph.pools : pool if pool.name == "vm-disks"])
+}
+
+# Guard against binding guest disks to a pool that cannot survive the loss
+# of a node or is running out of space.
+check "vm_pool" {
+  assert {
+    condition     = try(local.vm_pool.size >= 3 && local.vm_pool.min_size >= 2, false)
+    error_message = "Pool vm-disks must exist with size 3 and min_size 2."
+  }
+
+  assert {
+    condition     = try(local.vm_pool.used_ratio < 0.8, true)
+    error_message = "Pool vm-disks is more than 80% full."
+  }
+}
@@ -0,0 +1,23 @@
+# Join a freshly installed node through pve1, taking the address and
+# certificate fingerprint from the cluster instead of hard-coding them.
+data "proxmox_cluster_join_info" "pve1" {
+  node = "pve1"
+}
+
+resource "proxmox_cluster_join" "pve4" {
+  node              = "pve4"
+  node_endpoint     = "https://pve4.example.com:8006"
+  node_token_id     = "root@pam!join"
+  node_token_secret = var.pve4_token_secret
+
+  hostname    = data.proxmox_cluster_join_info.pve1.address
+  fingerprint = data.proxmox_cluster_join_info.pve1.fingerprint
+  password    = var.root_password
+
+  # Use the same number of links as the existing members.
+  links = [
+    for i in range(length(data.proxmox_cluster_join_info.pve1.nodes[0].links)) : { address = "10.${i}.0.14" }
+  ]
+
+  acknowledge_irreversible = true
+}
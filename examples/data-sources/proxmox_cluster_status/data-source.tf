@@ -0,0 +1,9 @@
+data "proxmox_cluster_status" "current" {}
+
+# Refuse to plan changes while the cluster has lost quorum.
+check "quorum" {
+  assert {
+    condition     = data.proxmox_cluster_status.current.quorate
+    error_message = "Cluster ${data.proxmox_cluster_status.current.cluster_name} has no quorum, ${data.proxmox_cluster_status.current.nodes_online} of ${data.proxmox_cluster_status.current.node_count} nodes are online."
+  }
+}
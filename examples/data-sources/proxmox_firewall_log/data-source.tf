@@ -0,0 +1,10 @@
+# Last 50 firewall log lines of a virtual machine
+data "proxmox_firewall_log" "web" {
+  node  = "pve1"
+  vm_id = 100
+  limit = 50
+}
+
+output "dropped" {
+  value = [for entry in data.proxmox_firewall_log.web.entries : entry.text if strcontains(entry.text, "DROP")]
+}
@@ -0,0 +1,17 @@
+data "proxmox_firewall_refs" "ipsets" {
+  type = "ipset"
+}
+
+locals {
+  ipsets = { for ref in data.proxmox_firewall_refs.ipsets.refs : ref.name => ref.ref }
+}
+
+# Allow SSH from an IP set maintained outside of Terraform
+resource "proxmox_firewall_rules" "cluster" {
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "SSH"
+    source = local.ipsets["management"]
+  }
+}
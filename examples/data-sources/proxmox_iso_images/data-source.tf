@@ -0,0 +1,21 @@
+# Look up the most recently uploaded Ubuntu server ISO on any storage of the
+# node, e.g. to attach it as installation medium.
+data "proxmox_iso_images" "ubuntu" {
+  node       = "pve1"
+  name_regex = "^ubuntu-[0-9.]+-live-server-amd64\\.iso$"
+}
+
+output "ubuntu_iso" {
+  value = data.proxmox_iso_images.ubuntu.latest.volume_id
+}
+
+# List the images kept on the local storage, which the other nodes cannot
+# access.
+data "proxmox_iso_images" "local" {
+  node     = "pve1"
+  storages = ["local"]
+}
+
+output "local_isos" {
+  value = [for image in data.proxmox_iso_images.local.images : image.name]
+}
@@ -0,0 +1,11 @@
+data "proxmox_lxc" "proxy" {
+  vm_id = 200
+}
+
+output "proxy_address" {
+  value = data.proxmox_lxc.proxy.networks[0].ipv4
+}
+
+output "proxy_mount_points" {
+  value = { for mp in data.proxmox_lxc.proxy.mount_points : mp.path => mp.volume }
+}
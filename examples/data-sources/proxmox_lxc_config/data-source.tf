@@ -0,0 +1,8 @@
+data "proxmox_lxc_config" "proxy" {
+  node  = "pve1"
+  vm_id = 200
+}
+
+output "proxy_networks" {
+  value = { for key, value in data.proxmox_lxc_config.proxy.config : key => value if startswith(key, "net") }
+}
@@ -0,0 +1,11 @@
+data "proxmox_lxc_snapshots" "proxy" {
+  node  = "pve1"
+  vm_id = 200
+}
+
+output "proxy_snapshots" {
+  value = {
+    current   = data.proxmox_lxc_snapshots.proxy.current
+    snapshots = [for s in data.proxmox_lxc_snapshots.proxy.snapshots : s.name]
+  }
+}
@@ -0,0 +1,12 @@
+data "proxmox_node" "pve1" {
+  node = "pve1"
+}
+
+locals {
+  # Memory that can still be assigned to guests, keeping 4 GiB for the host.
+  pve1_free_memory = data.proxmox_node.pve1.memory.free - 4 * 1024 * 1024 * 1024
+}
+
+output "pve1_cpu" {
+  value = "${data.proxmox_node.pve1.cpu.sockets} x ${data.proxmox_node.pve1.cpu.model}"
+}
@@ -0,0 +1,16 @@
+data "proxmox_node_certificates" "pve1" {
+  node = "pve1"
+}
+
+locals {
+  pve1_certificate = one([for cert in data.proxmox_node_certificates.pve1.certificates : cert if cert.in_use])
+}
+
+# Warn a month before the served certificate expires, so there is time to
+# renew it if the automatic ACME renewal failed.
+check "certificate_expiry" {
+  assert {
+    condition     = timecmp(timeadd("1970-01-01T00:00:00Z", "${local.pve1_certificate.not_after}s"), timeadd(plantimestamp(), "720h")) > 0
+    error_message = "The certificate of pve1 (${local.pve1_certificate.fingerprint}) expires within 30 days."
+  }
+}
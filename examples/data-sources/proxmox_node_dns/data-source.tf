@@ -0,0 +1,11 @@
+data "proxmox_node_dns" "pve1" {
+  node = "pve1"
+}
+
+# Detect nodes that drifted away from the internal resolvers.
+check "node_dns" {
+  assert {
+    condition     = data.proxmox_node_dns.pve1.servers == tolist(["10.0.0.53", "10.0.1.53"])
+    error_message = "pve1 uses the DNS servers ${join(", ", data.proxmox_node_dns.pve1.servers)}."
+  }
+}
@@ -0,0 +1,20 @@
+# Pick the online node with the lowest average CPU usage over the last day,
+# e.g. as target for a new guest.
+data "proxmox_nodes" "all" {}
+
+data "proxmox_node_metrics" "all" {
+  for_each = toset([for node in data.proxmox_nodes.all.nodes : node.node if node.online])
+
+  node      = each.value
+  timeframe = "day"
+}
+
+locals {
+  cpu_by_node = { for node, metrics in data.proxmox_node_metrics.all : node => metrics.cpu_average }
+
+  least_loaded_node = [for node, cpu in local.cpu_by_node : node if cpu == min(values(local.cpu_by_node)...)][0]
+}
+
+output "least_loaded_node" {
+  value = local.least_loaded_node
+}
@@ -0,0 +1,12 @@
+data "proxmox_node_network" "nics" {
+  node = "pve1"
+  type = "eth"
+}
+
+# Bond the physical NICs that are not active yet.
+resource "proxmox_network_bond" "uplink" {
+  node   = "pve1"
+  iface  = "bond0"
+  mode   = "active-backup"
+  slaves = [for nic in data.proxmox_node_network.nics.interfaces : nic.iface if !nic.active]
+}
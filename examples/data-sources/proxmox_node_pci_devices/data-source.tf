@@ -0,0 +1,12 @@
+data "proxmox_node_pci_devices" "pve1" {
+  node = "pve1"
+}
+
+locals {
+  # NVIDIA display controllers of pve1.
+  gpus = [for device in data.proxmox_node_pci_devices.pve1.devices : device if device.vendor_id == "0x10de" && startswith(device.class, "0x03")]
+}
+
+output "gpu_addresses" {
+  value = [for gpu in local.gpus : gpu.id]
+}
@@ -0,0 +1,19 @@
+data "proxmox_node_qemu_capabilities" "pve1" {
+  node = "pve1"
+}
+
+variable "cpu_type" {
+  type    = string
+  default = "x86-64-v3"
+}
+
+check "cpu_type_supported" {
+  assert {
+    condition     = contains([for cpu in data.proxmox_node_qemu_capabilities.pve1.cpu_models : cpu.name], var.cpu_type)
+    error_message = "CPU model ${var.cpu_type} is not supported by pve1."
+  }
+}
+
+output "q35_machine_types" {
+  value = [for machine in data.proxmox_node_qemu_capabilities.pve1.machines : machine.id if machine.type == "q35"]
+}
@@ -0,0 +1,21 @@
+data "proxmox_node_services" "pve1" {
+  node = "pve1"
+}
+
+locals {
+  pve1_services = { for s in data.proxmox_node_services.pve1.services : s.service => s }
+}
+
+# Only replicate to the node when the services keeping the cluster
+# healthy are up.
+resource "proxmox_replication_job" "db" {
+  vm_id  = 100
+  target = "pve1"
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for name in ["corosync", "pve-cluster", "chrony"] : local.pve1_services[name].state == "running"])
+      error_message = "corosync, pve-cluster and chrony must be running on pve1."
+    }
+  }
+}
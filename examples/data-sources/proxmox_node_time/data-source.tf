@@ -0,0 +1,35 @@
+data "proxmox_node_time" "pve1" {
+  node = "pve1"
+}
+
+check "clock_drift" {
+  assert {
+    condition     = abs(data.proxmox_node_time.pve1.clock_skew) < 5
+    error_message = "The clock of pve1 is off by ${data.proxmox_node_time.pve1.clock_skew} seconds."
+  }
+}
+
+# Ceph and corosync are sensitive to differences between the nodes, so check
+# that all online nodes agree on the timezone and time before relying on them.
+data "proxmox_nodes" "all" {}
+
+data "proxmox_node_time" "all" {
+  for_each = toset([for node in data.proxmox_nodes.all.nodes : node.node if node.online])
+
+  node = each.value
+}
+
+check "consistent_time" {
+  assert {
+    condition     = length(distinct([for t in data.proxmox_node_time.all : t.timezone])) == 1
+    error_message = "The nodes use different timezones: ${jsonencode({ for n, t in data.proxmox_node_time.all : n => t.timezone })}"
+  }
+
+  assert {
+    condition = (
+      max([for t in data.proxmox_node_time.all : t.clock_skew]...) -
+      min([for t in data.proxmox_node_time.all : t.clock_skew]...)
+    ) < 2
+    error_message = "The node clocks differ by more than a second: ${jsonencode({ for n, t in data.proxmox_node_time.all : n => t.clock_skew })}"
+  }
+}
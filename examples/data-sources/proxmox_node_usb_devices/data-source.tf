@@ -0,0 +1,12 @@
+data "proxmox_node_usb_devices" "pve1" {
+  node = "pve1"
+}
+
+locals {
+  # The license dongle attached to pve1, passed through as host=<host_id>.
+  dongle = one([for device in data.proxmox_node_usb_devices.pve1.devices : device if device.product == "Sentinel HL"])
+}
+
+output "dongle_host_id" {
+  value = local.dongle.host_id
+}
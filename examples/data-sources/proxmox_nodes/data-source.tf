@@ -0,0 +1,15 @@
+data "proxmox_nodes" "online" {
+  filter {
+    name   = "online"
+    values = ["true"]
+  }
+}
+
+locals {
+  online_nodes = data.proxmox_nodes.online.nodes[*].node
+}
+
+# Spread the web servers across the online nodes.
+output "web_server_nodes" {
+  value = { for i in range(3) : "web${i + 1}" => local.online_nodes[i % length(local.online_nodes)] }
+}
@@ -0,0 +1,11 @@
+data "proxmox_pool" "production" {
+  pool_id = "production"
+}
+
+# Back up the running production virtual machines nightly.
+resource "proxmox_backup_job" "production" {
+  job_id   = "production-nightly"
+  schedule = "02:00"
+  storage  = "backup"
+  vm_ids   = [for guest in data.proxmox_pool.production.guests : guest.vm_id if guest.type == "qemu" && guest.status == "running"]
+}
@@ -0,0 +1,6 @@
+data "proxmox_pools" "all" {}
+
+# Pool of every guest, e.g. to add pool based tags to monitoring.
+output "guest_pools" {
+  value = merge([for pool in data.proxmox_pools.all.pools : { for id in pool.vm_ids : tostring(id) => pool.pool_id }]...)
+}
@@ -0,0 +1,11 @@
+data "proxmox_privileges" "all" {}
+
+# Fail early if a custom role uses a privilege unknown to the cluster
+variable "operator_privileges" {
+  type    = list(string)
+  default = ["VM.PowerMgmt", "VM.Console", "VM.Audit"]
+}
+
+output "unknown_privileges" {
+  value = setsubtract(var.operator_privileges, data.proxmox_privileges.all.privileges)
+}
@@ -0,0 +1,13 @@
+data "proxmox_realms" "all" {}
+
+variable "user_id" {
+  type    = string
+  default = "alice@corp"
+}
+
+check "user_realm" {
+  assert {
+    condition     = contains([for realm in data.proxmox_realms.all.realms : realm.realm], split("@", var.user_id)[1])
+    error_message = "The realm of ${var.user_id} does not exist."
+  }
+}
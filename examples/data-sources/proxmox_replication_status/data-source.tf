@@ -0,0 +1,12 @@
+data "proxmox_replication_status" "pve1" {
+  node = "pve1"
+}
+
+# Fail the plan when a replication job is failing, as a failover would lose
+# the changes since its last successful sync.
+check "replication_healthy" {
+  assert {
+    condition     = alltrue([for job in data.proxmox_replication_status.pve1.jobs : job.fail_count == 0 if job.enabled])
+    error_message = join("\n", [for job in data.proxmox_replication_status.pve1.jobs : "${job.job_id}: ${job.error}" if job.fail_count > 0])
+  }
+}
@@ -0,0 +1,12 @@
+data "proxmox_roles" "all" {}
+
+locals {
+  roles = { for role in data.proxmox_roles.all.roles : role.role_id => role.privileges }
+
+  # The privileges of PVEVMUser plus snapshot management.
+  vm_operator_privileges = distinct(concat(local.roles["PVEVMUser"], ["VM.Snapshot", "VM.Snapshot.Rollback"]))
+}
+
+output "vm_operator_privileges" {
+  value = local.vm_operator_privileges
+}
@@ -0,0 +1,20 @@
+data "proxmox_sdn_subnets" "web" {
+  vnet = "web"
+}
+
+# Derive static guest addresses from the subnet of the VNet, numbering hosts
+# from the tenth address and keeping clear of the gateway.
+locals {
+  subnet = data.proxmox_sdn_subnets.web.subnets[0]
+
+  web_ip_config = [
+    for i in range(3) : {
+      address = "${cidrhost(local.subnet.cidr, 10 + i)}/${split("/", local.subnet.cidr)[1]}"
+      gateway = local.subnet.gateway
+    }
+  ]
+}
+
+output "web_ip_config" {
+  value = local.web_ip_config
+}
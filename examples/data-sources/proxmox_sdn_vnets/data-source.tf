@@ -0,0 +1,13 @@
+# The VNets of the tenant zone are managed by the network team, look them up
+# by alias to attach guests to them.
+data "proxmox_sdn_vnets" "tenant" {
+  zone = "tenant"
+}
+
+locals {
+  vnets = { for vnet in data.proxmox_sdn_vnets.tenant.vnets : vnet.alias => vnet.vnet if vnet.applied && vnet.alias != null }
+}
+
+output "web_bridge" {
+  value = local.vnets["web"]
+}
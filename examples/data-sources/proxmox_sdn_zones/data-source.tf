@@ -0,0 +1,10 @@
+data "proxmox_sdn_zones" "all" {}
+
+# Remind operators of SDN changes that were made outside of Terraform and
+# are still waiting to be applied.
+check "sdn_applied" {
+  assert {
+    condition     = alltrue([for zone in data.proxmox_sdn_zones.all.zones : zone.applied])
+    error_message = "SDN zones with pending changes: ${join(", ", [for zone in data.proxmox_sdn_zones.all.zones : "${zone.zone} (${zone.state})" if !zone.applied])}"
+  }
+}
@@ -0,0 +1,11 @@
+data "proxmox_storage_status" "pve1_local_lvm" {
+  node    = "pve1"
+  storage = "local-lvm"
+}
+
+check "storage_capacity" {
+  assert {
+    condition     = data.proxmox_storage_status.pve1_local_lvm.used_ratio < 0.85
+    error_message = "local-lvm on pve1 is ${floor(data.proxmox_storage_status.pve1_local_lvm.used_ratio * 100)}% full."
+  }
+}
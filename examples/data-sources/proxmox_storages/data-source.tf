@@ -26,7 +26,19 @@ output "storage_names" {
   value = [for storage in data.proxmox_storages.all.storages : storage.storage]
 }
 
-# Filter storages by type
-output "dir_storages" {
-  value = [for storage in data.proxmox_storages.all.storages : storage if storage.type == "dir"]
+# Storages that can hold disk images, excluding local directories
+data "proxmox_storages" "images" {
+  filter {
+    name  = "content"
+    regex = "(^|,)images(,|$)"
+  }
+
+  filter {
+    name   = "type"
+    values = ["lvmthin", "zfspool", "rbd", "nfs"]
+  }
+}
+
+output "image_storages" {
+  value = data.proxmox_storages.images.storages[*].storage
 }
@@ -0,0 +1,15 @@
+data "proxmox_subscriptions" "cluster" {}
+
+# Production clusters must be fully licensed with at least a Basic
+# subscription to receive enterprise repository updates.
+check "licensed" {
+  assert {
+    condition     = data.proxmox_subscriptions.cluster.all_active
+    error_message = "Nodes without active subscription: ${join(", ", [for s in data.proxmox_subscriptions.cluster.subscriptions : s.node if !s.active])}"
+  }
+
+  assert {
+    condition     = alltrue([for s in data.proxmox_subscriptions.cluster.subscriptions : s.level != "c" if s.active])
+    error_message = "Community subscriptions are not allowed in production."
+  }
+}
@@ -0,0 +1,10 @@
+data "proxmox_version" "current" {}
+
+locals {
+  # Proxmox VE 8.1 added software-defined networking to the default installation.
+  sdn_available = data.proxmox_version.current.major > 8 || (data.proxmox_version.current.major == 8 && data.proxmox_version.current.minor >= 1)
+}
+
+output "pve_version" {
+  value = data.proxmox_version.current.version
+}
@@ -0,0 +1,11 @@
+data "proxmox_vm" "database" {
+  name = "db1"
+}
+
+output "database_mac_address" {
+  value = data.proxmox_vm.database.networks[0].mac_address
+}
+
+output "database_disks" {
+  value = { for disk in data.proxmox_vm.database.disks : disk.key => disk.size if disk.media == null }
+}
@@ -0,0 +1,18 @@
+data "proxmox_vm_config" "legacy" {
+  node  = "pve1"
+  vm_id = 100
+}
+
+# Audit a VM managed outside of Terraform: it must boot with the host and
+# must not have configuration changes waiting for a restart.
+check "legacy_vm" {
+  assert {
+    condition     = lookup(data.proxmox_vm_config.legacy.config, "onboot", "0") == "1"
+    error_message = "VM 100 does not start on boot."
+  }
+
+  assert {
+    condition     = length(data.proxmox_vm_config.legacy.pending) == 0 && length(data.proxmox_vm_config.legacy.pending_deletes) == 0
+    error_message = "VM 100 has pending changes to ${join(", ", concat(keys(data.proxmox_vm_config.legacy.pending), data.proxmox_vm_config.legacy.pending_deletes))}."
+  }
+}
@@ -0,0 +1,14 @@
+# Publish the address a VM got via DHCP, e.g. as DNS record or load balancer
+# backend, even though the VM itself is not managed by Terraform.
+data "proxmox_vm_network_interfaces" "web" {
+  node  = "pve1"
+  vm_id = 120
+}
+
+output "web_address" {
+  value = data.proxmox_vm_network_interfaces.web.ipv4_addresses[0]
+}
+
+output "web_eth0" {
+  value = one([for iface in data.proxmox_vm_network_interfaces.web.interfaces : iface if iface.name == "eth0"])
+}
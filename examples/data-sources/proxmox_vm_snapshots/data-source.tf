@@ -0,0 +1,16 @@
+data "proxmox_vm_snapshots" "db" {
+  node  = "pve1"
+  vm_id = 100
+}
+
+# Warn about snapshots older than a week, which slow down the disks and
+# should be removed after the maintenance they were taken for.
+check "stale_snapshots" {
+  assert {
+    condition = alltrue([
+      for s in data.proxmox_vm_snapshots.db.snapshots :
+      timecmp(timeadd("1970-01-01T00:00:00Z", "${s.creation_time}s"), timeadd(plantimestamp(), "-168h")) > 0
+    ])
+    error_message = "VM 100 has snapshots older than a week: ${join(", ", [for s in data.proxmox_vm_snapshots.db.snapshots : s.name])}"
+  }
+}
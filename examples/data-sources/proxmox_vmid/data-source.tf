@@ -0,0 +1,9 @@
+# Next free ID in the range reserved for test machines.
+data "proxmox_vmid" "test" {
+  min = 9000
+  max = 9999
+}
+
+output "next_test_vm_id" {
+  value = data.proxmox_vmid.test.vm_id
+}
@@ -0,0 +1,28 @@
+# Running virtual machines tagged as web servers on any node.
+data "proxmox_vms" "web" {
+  status = "running"
+  tags   = ["web"]
+}
+
+output "web_servers" {
+  value = { for vm in data.proxmox_vms.web.vms : vm.name => vm.node }
+}
+
+# Virtual machines of the staging environments, named e.g. "staging-db-1",
+# that are not running.
+data "proxmox_vms" "staging_stopped" {
+  filter {
+    name  = "name"
+    regex = "^staging-"
+  }
+
+  filter {
+    name   = "status"
+    values = ["stopped", "paused"]
+  }
+}
+
+# Templates available for cloning.
+data "proxmox_vms" "templates" {
+  template = true
+}
@@ -0,0 +1,15 @@
+# Short-lived token of a less privileged user for a second provider
+# configuration. The secret never enters the plan or state and the token is
+# deleted after the run.
+ephemeral "proxmox_api_token" "automation" {
+  user_id  = "automation@pve"
+  comment  = "terraform run"
+  lifetime = "30m"
+}
+
+provider "proxmox" {
+  alias        = "automation"
+  endpoint     = "https://proxmox.example.com:8006"
+  token_id     = ephemeral.proxmox_api_token.automation.id
+  token_secret = ephemeral.proxmox_api_token.automation.value
+}
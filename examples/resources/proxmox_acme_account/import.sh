@@ -0,0 +1,2 @@
+# ACME accounts can be imported using the account name.
+terraform import proxmox_acme_account.letsencrypt default
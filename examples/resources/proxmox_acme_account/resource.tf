@@ -0,0 +1,27 @@
+resource "proxmox_acme_account" "letsencrypt" {
+  name       = "default"
+  contact    = "ops@example.com"
+  accept_tos = true
+}
+
+# Directories requiring External Account Binding, e.g. ZeroSSL.
+resource "proxmox_acme_account" "zerossl" {
+  name         = "zerossl"
+  contact      = "ops@example.com"
+  directory    = "https://acme.zerossl.com/v2/DV90"
+  accept_tos   = true
+  eab_kid      = var.zerossl_eab_kid
+  eab_hmac_key = var.zerossl_eab_hmac_key
+}
+
+variable "zerossl_eab_kid" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "zerossl_eab_hmac_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
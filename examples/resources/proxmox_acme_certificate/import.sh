@@ -0,0 +1,2 @@
+# ACME certificates can be imported using the node name.
+terraform import proxmox_acme_certificate.pve pve
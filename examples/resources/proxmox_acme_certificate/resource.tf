@@ -0,0 +1,9 @@
+resource "proxmox_acme_certificate" "pve" {
+  node    = "pve"
+  account = proxmox_acme_account.letsencrypt.name
+
+  domain = [{
+    domain = "pve.example.com"
+    plugin = proxmox_acme_plugin.cloudflare.plugin
+  }]
+}
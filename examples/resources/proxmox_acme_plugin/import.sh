@@ -0,0 +1,2 @@
+# ACME plugins can be imported using the plugin ID.
+terraform import proxmox_acme_plugin.cloudflare cloudflare
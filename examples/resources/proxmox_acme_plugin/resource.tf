@@ -0,0 +1,15 @@
+resource "proxmox_acme_plugin" "cloudflare" {
+  plugin           = "cloudflare"
+  api              = "cf"
+  validation_delay = 60
+
+  data = {
+    CF_Token      = var.cloudflare_token
+    CF_Account_ID = "0123456789abcdef0123456789abcdef"
+  }
+}
+
+variable "cloudflare_token" {
+  type      = string
+  sensitive = true
+}
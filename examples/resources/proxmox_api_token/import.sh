@@ -0,0 +1,3 @@
+# API tokens can be imported using the full token ID. The secret of an imported
+# token is not available until it is rotated.
+terraform import proxmox_api_token.terraform 'terraform@pve!automation'
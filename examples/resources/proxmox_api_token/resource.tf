@@ -0,0 +1,19 @@
+# Rotate the token secret every 90 days together with the time provider
+resource "time_rotating" "terraform" {
+  rotation_days = 90
+}
+
+resource "proxmox_api_token" "terraform" {
+  user_id  = "terraform@pve"
+  token_id = "automation"
+  comment  = "Used by CI pipelines"
+
+  rotate_when = {
+    rotation = time_rotating.terraform.id
+  }
+}
+
+output "token_secret" {
+  value     = proxmox_api_token.terraform.value
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Backup jobs can be imported using the job ID.
+terraform import proxmox_backup_job.nightly nightly
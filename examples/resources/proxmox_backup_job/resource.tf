@@ -0,0 +1,19 @@
+resource "proxmox_backup_job" "nightly" {
+  job_id         = "nightly"
+  schedule       = "*-*-* 02:00"
+  pool           = "production"
+  storage        = "backup-nfs"
+  mode           = "snapshot"
+  compress       = "zstd"
+  notes_template = "{{guestname}} on {{node}}"
+
+  retention = {
+    keep_daily   = 7
+    keep_weekly  = 4
+    keep_monthly = 6
+  }
+
+  notification_mode = "legacy-sendmail"
+  mail_to           = ["ops@example.com"]
+  mail_notification = "failure"
+}
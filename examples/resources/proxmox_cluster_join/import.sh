@@ -0,0 +1,2 @@
+# Cluster members can be imported using the node name.
+terraform import proxmox_cluster_join.pve4 pve4
@@ -0,0 +1,20 @@
+# The provider connects to the existing cluster, the node_* attributes to the
+# freshly installed node.
+resource "proxmox_cluster_join" "pve4" {
+  node              = "pve4"
+  node_endpoint     = "https://pve4.example.com:8006"
+  node_token_id     = "root@pam!join"
+  node_token_secret = var.pve4_token_secret
+  node_skip_verify  = true
+
+  hostname    = "pve1.example.com"
+  fingerprint = "AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89"
+  password    = var.root_password
+
+  links = [
+    { address = "10.0.0.14" },
+    { address = "10.1.0.14", priority = 10 },
+  ]
+
+  acknowledge_irreversible = true
+}
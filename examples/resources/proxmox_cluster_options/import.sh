@@ -0,0 +1,2 @@
+# The cluster options can be imported using the fixed ID "cluster".
+terraform import proxmox_cluster_options.cluster cluster
@@ -0,0 +1,22 @@
+resource "proxmox_cluster_options" "cluster" {
+  keyboard           = "en-us"
+  email_from         = "pve@example.com"
+  ha_shutdown_policy = "migrate"
+  registered_tags    = ["production"]
+
+  migration = {
+    type    = "insecure"
+    network = "10.10.0.0/24"
+  }
+
+  bandwidth_limits = {
+    default = 204800
+    restore = 102400
+  }
+
+  tag_style = {
+    shape     = "circle"
+    ordering  = "alphabetical"
+    color_map = "production:ff0000"
+  }
+}
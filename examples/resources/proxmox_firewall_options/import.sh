@@ -0,0 +1,2 @@
+# The cluster firewall options can be imported using the fixed ID "cluster".
+terraform import proxmox_firewall_options.cluster cluster
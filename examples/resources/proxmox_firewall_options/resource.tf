@@ -0,0 +1,12 @@
+resource "proxmox_firewall_options" "cluster" {
+  enabled    = true
+  ebtables   = true
+  policy_in  = "DROP"
+  policy_out = "ACCEPT"
+
+  log_ratelimit = {
+    enabled = true
+    burst   = 5
+    rate    = "1/second"
+  }
+}
@@ -0,0 +1,2 @@
+# The cluster firewall rules can be imported using the fixed ID "cluster".
+terraform import proxmox_firewall_rules.cluster cluster
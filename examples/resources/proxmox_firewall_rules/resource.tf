@@ -0,0 +1,23 @@
+resource "proxmox_firewall_rules" "cluster" {
+  rule {
+    type    = "in"
+    action  = "ACCEPT"
+    macro   = "SSH"
+    source  = "10.0.0.0/8"
+    comment = "Management access"
+  }
+
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    proto  = "tcp"
+    dport  = "8006"
+    log    = "info"
+  }
+
+  # Apply the rules of an existing security group
+  rule {
+    type   = "group"
+    action = "webservers"
+  }
+}
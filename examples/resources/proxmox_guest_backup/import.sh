@@ -0,0 +1,2 @@
+# Existing backups can be imported using the node name and volume ID.
+terraform import proxmox_guest_backup.db pve1/backup-nfs:backup/vzdump-qemu-100-2024_01_01-02_00_00.vma.zst
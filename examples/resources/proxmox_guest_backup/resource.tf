@@ -0,0 +1,27 @@
+# Take a fresh backup of the database VM whenever its image changes.
+resource "proxmox_guest_backup" "db" {
+  node           = "pve1"
+  vm_id          = 100
+  storage        = "backup-nfs"
+  mode           = "snapshot"
+  compress       = "zstd"
+  notes_template = "{{guestname}} before replacement"
+  protected      = true
+
+  # Large disks take longer than the default hour to back up.
+  timeouts {
+    create = "3h"
+  }
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.db_image]
+  }
+}
+
+resource "terraform_data" "db_image" {
+  input = var.db_image
+}
+
+variable "db_image" {
+  type = string
+}
@@ -0,0 +1,2 @@
+# HA groups can be imported using the group name.
+terraform import proxmox_ha_group.database database
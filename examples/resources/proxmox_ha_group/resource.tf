@@ -0,0 +1,16 @@
+resource "proxmox_ha_group" "database" {
+  group      = "database"
+  restricted = true
+  nofailback = true
+  comment    = "Nodes with local NVMe storage"
+
+  nodes = {
+    pve1 = 2
+    pve2 = 1
+  }
+}
+
+resource "proxmox_ha_resource" "db" {
+  vm_id = 100
+  group = proxmox_ha_group.database.group
+}
@@ -0,0 +1,2 @@
+# HA resources can be imported using the HA resource ID.
+terraform import proxmox_ha_resource.db vm:100
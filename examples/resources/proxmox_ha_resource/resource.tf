@@ -0,0 +1,7 @@
+resource "proxmox_ha_resource" "db" {
+  vm_id        = 100
+  state        = "started"
+  max_restart  = 2
+  max_relocate = 1
+  comment      = "Primary database"
+}
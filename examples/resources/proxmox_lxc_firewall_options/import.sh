@@ -0,0 +1,2 @@
+# Container firewall options can be imported using the node name and container ID.
+terraform import proxmox_lxc_firewall_options.dns pve1/200
@@ -0,0 +1,8 @@
+resource "proxmox_lxc_firewall_options" "dns" {
+  node       = "pve1"
+  vm_id      = 200
+  enabled    = true
+  ipfilter   = true
+  policy_in  = "DROP"
+  policy_out = "ACCEPT"
+}
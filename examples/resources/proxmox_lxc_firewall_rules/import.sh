@@ -0,0 +1,2 @@
+# Container firewall rules can be imported using the node name and container ID.
+terraform import proxmox_lxc_firewall_rules.dns pve1/200
@@ -0,0 +1,17 @@
+resource "proxmox_lxc_firewall_rules" "dns" {
+  node  = "pve1"
+  vm_id = 200
+
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "DNS"
+  }
+
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "SSH"
+    source = "+management"
+  }
+}
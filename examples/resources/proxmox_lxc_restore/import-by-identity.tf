@@ -0,0 +1,9 @@
+# Restored containers can be imported by their container ID, wherever the
+# container runs. The cluster is optional and checked if given.
+import {
+  to = proxmox_lxc_restore.web_rehearsal
+  identity = {
+    cluster = "lab"
+    vm_id   = 9200
+  }
+}
@@ -0,0 +1,2 @@
+# Restored containers can be imported using the node name and container ID.
+terraform import proxmox_lxc_restore.web_rehearsal pve2/9200
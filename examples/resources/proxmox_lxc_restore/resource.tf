@@ -0,0 +1,7 @@
+resource "proxmox_lxc_restore" "web_rehearsal" {
+  node    = "pve2"
+  vm_id   = 9200
+  archive = "backup-nfs:backup/vzdump-lxc-200-2024_01_01-02_00_00.tar.zst"
+  storage = "local-lvm"
+  unique  = true
+}
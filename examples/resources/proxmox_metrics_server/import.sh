@@ -0,0 +1,2 @@
+# Metrics servers can be imported using the server name.
+terraform import proxmox_metrics_server.influxdb influxdb
@@ -0,0 +1,28 @@
+resource "proxmox_metrics_server" "influxdb" {
+  name         = "influxdb"
+  type         = "influxdb"
+  server       = "influxdb.example.com"
+  port         = 8086
+  protocol     = "https"
+  organization = "example"
+  bucket       = "proxmox"
+
+  # Increment token_version after rotating the token to send it again.
+  token         = var.influxdb_token
+  token_version = 1
+}
+
+resource "proxmox_metrics_server" "graphite" {
+  name     = "graphite"
+  type     = "graphite"
+  server   = "graphite.example.com"
+  port     = 2003
+  protocol = "tcp"
+  path     = "proxmox"
+}
+
+variable "influxdb_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
@@ -0,0 +1,2 @@
+# The network apply resource can be imported using the node name.
+terraform import proxmox_network_apply.pve1 pve1
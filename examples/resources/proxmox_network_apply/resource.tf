@@ -0,0 +1,22 @@
+resource "proxmox_network_bond" "uplink" {
+  node   = "pve1"
+  iface  = "bond0"
+  slaves = ["eno1", "eno2"]
+  mode   = "802.3ad"
+}
+
+resource "proxmox_network_ovs_bridge" "vmbr1" {
+  node  = "pve1"
+  iface = "vmbr1"
+  ports = [proxmox_network_bond.uplink.iface]
+}
+
+# Apply the staged configuration whenever one of the interfaces changes
+resource "proxmox_network_apply" "pve1" {
+  node = "pve1"
+
+  triggers = {
+    bond   = jsonencode(proxmox_network_bond.uplink)
+    bridge = jsonencode(proxmox_network_ovs_bridge.vmbr1)
+  }
+}
@@ -0,0 +1,2 @@
+# Network bonds can be imported using the node name and interface name.
+terraform import proxmox_network_bond.uplink pve1/bond0
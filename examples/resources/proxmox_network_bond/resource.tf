@@ -0,0 +1,9 @@
+resource "proxmox_network_bond" "uplink" {
+  node        = "pve1"
+  iface       = "bond0"
+  slaves      = ["eno1", "eno2"]
+  mode        = "802.3ad"
+  hash_policy = "layer3+4"
+  mtu         = 9000
+  comments    = "LACP uplink"
+}
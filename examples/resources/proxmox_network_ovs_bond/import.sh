@@ -0,0 +1,2 @@
+# OVS bonds can be imported using the node name and interface name.
+terraform import proxmox_network_ovs_bond.uplink pve1/bond1
@@ -0,0 +1,8 @@
+resource "proxmox_network_ovs_bond" "uplink" {
+  node        = "pve1"
+  iface       = "bond1"
+  slaves      = ["eno3", "eno4"]
+  mode        = "lacp-balance-tcp"
+  bridge      = proxmox_network_ovs_bridge.vmbr1.iface
+  ovs_options = "other_config:lacp-time=fast"
+}
@@ -0,0 +1,2 @@
+# OVS bridges can be imported using the node name and interface name.
+terraform import proxmox_network_ovs_bridge.vmbr1 pve1/vmbr1
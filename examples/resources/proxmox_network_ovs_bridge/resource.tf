@@ -0,0 +1,6 @@
+resource "proxmox_network_ovs_bridge" "vmbr1" {
+  node        = "pve1"
+  iface       = "vmbr1"
+  ovs_options = "other_config:stp-enable=true"
+  comments    = "Guest traffic"
+}
@@ -0,0 +1,2 @@
+# OVS internal ports can be imported using the node name and interface name.
+terraform import proxmox_network_ovs_int_port.mgmt pve1/mgmt50
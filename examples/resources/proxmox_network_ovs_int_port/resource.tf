@@ -0,0 +1,8 @@
+# Management address of the node in VLAN 50 of the OVS bridge
+resource "proxmox_network_ovs_int_port" "mgmt" {
+  node     = "pve1"
+  iface    = "mgmt50"
+  bridge   = proxmox_network_ovs_bridge.vmbr1.iface
+  vlan_tag = 50
+  cidr     = "10.0.50.11/24"
+}
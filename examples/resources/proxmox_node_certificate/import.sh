@@ -0,0 +1,4 @@
+# The custom certificate of a node can be imported using the node name. The
+# certificate and private key have to be configured, the first apply uploads
+# them again.
+terraform import proxmox_node_certificate.pve1 pve1
@@ -0,0 +1,5 @@
+resource "proxmox_node_certificate" "pve1" {
+  node        = "pve1"
+  certificate = file("${path.module}/pve1.example.com.fullchain.pem")
+  private_key = file("${path.module}/pve1.example.com.key")
+}
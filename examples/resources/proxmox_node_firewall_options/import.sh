@@ -0,0 +1,2 @@
+# Node firewall options can be imported using the node name.
+terraform import proxmox_node_firewall_options.pve1 pve1
@@ -0,0 +1,10 @@
+resource "proxmox_node_firewall_options" "pve1" {
+  node                = "pve1"
+  enabled             = true
+  nosmurfs            = true
+  smurf_log_level     = "warning"
+  tcpflags            = true
+  tcp_flags_log_level = "warning"
+  protection_synflood = true
+  log_level_in        = "info"
+}
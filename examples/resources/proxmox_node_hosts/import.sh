@@ -0,0 +1,3 @@
+# Hosts file entries can be imported using the node name. All entries of the
+# file are adopted on import.
+terraform import proxmox_node_hosts.pve1 pve1
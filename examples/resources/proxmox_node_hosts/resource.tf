@@ -0,0 +1,14 @@
+# Static name resolution for the Ceph public network
+resource "proxmox_node_hosts" "pve1" {
+  node = "pve1"
+
+  entry {
+    address   = "10.10.0.11"
+    hostnames = ["pve1-ceph.example.com", "pve1-ceph"]
+  }
+
+  entry {
+    address   = "10.10.0.12"
+    hostnames = ["pve2-ceph.example.com", "pve2-ceph"]
+  }
+}
@@ -0,0 +1,2 @@
+# Node options can be imported using the node name.
+terraform import proxmox_node_options.pve1 pve1
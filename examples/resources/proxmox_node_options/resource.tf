@@ -0,0 +1,10 @@
+resource "proxmox_node_options" "pve1" {
+  node                    = "pve1"
+  description             = "Rack 3, unit 12"
+  start_all_on_boot_delay = 30
+
+  wake_on_lan = {
+    mac            = "a0:36:9f:12:34:56"
+    bind_interface = "vmbr0"
+  }
+}
@@ -0,0 +1,2 @@
+# Node services can be imported using the node name and service name.
+terraform import proxmox_node_service.chrony pve1/chrony
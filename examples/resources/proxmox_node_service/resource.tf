@@ -0,0 +1,16 @@
+# Restart chrony whenever its configuration changes.
+resource "proxmox_node_service" "chrony" {
+  node    = "pve1"
+  service = "chrony"
+
+  triggers = {
+    config = sha256(file("${path.module}/chrony.conf"))
+  }
+}
+
+# Keep SPICE proxy stopped on a node without SPICE clients.
+resource "proxmox_node_service" "spiceproxy" {
+  node    = "pve1"
+  service = "spiceproxy"
+  state   = "stopped"
+}
@@ -0,0 +1,2 @@
+# The node timezone can be imported using the node name.
+terraform import proxmox_node_time.pve1 pve1
@@ -0,0 +1,2 @@
+# Gotify notification targets can be imported using the target name.
+terraform import proxmox_notification_gotify.gotify gotify
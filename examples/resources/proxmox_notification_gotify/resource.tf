@@ -0,0 +1,12 @@
+resource "proxmox_notification_gotify" "gotify" {
+  name          = "gotify"
+  server        = "https://gotify.example.com"
+  token         = var.gotify_token
+  token_version = 1
+}
+
+variable "gotify_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
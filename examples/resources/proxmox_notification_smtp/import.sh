@@ -0,0 +1,2 @@
+# SMTP notification targets can be imported using the target name.
+terraform import proxmox_notification_smtp.mail mail
@@ -0,0 +1,18 @@
+resource "proxmox_notification_smtp" "mail" {
+  name             = "mail"
+  server           = "smtp.example.com"
+  port             = 587
+  mode             = "starttls"
+  username         = "pve@example.com"
+  password         = var.smtp_password
+  password_version = 1
+  from_address     = "pve@example.com"
+  mail_to          = ["ops@example.com"]
+  mail_to_user     = ["root@pam"]
+}
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
@@ -0,0 +1,2 @@
+# Webhook notification targets can be imported using the target name.
+terraform import proxmox_notification_webhook.slack slack
@@ -0,0 +1,23 @@
+resource "proxmox_notification_webhook" "slack" {
+  name = "slack"
+  url  = "https://hooks.slack.com/services/{{ secrets.path }}"
+
+  headers = {
+    Content-Type = "application/json"
+  }
+
+  body = jsonencode({
+    text = "*{{ title }}*\n{{ message }}"
+  })
+
+  secrets = {
+    path = var.slack_webhook_path
+  }
+  secrets_version = 1
+}
+
+variable "slack_webhook_path" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
@@ -0,0 +1,3 @@
+# Pool memberships can be imported using the pool ID. All current members of
+# the pool are adopted.
+terraform import proxmox_pool_membership.dev dev
@@ -0,0 +1,6 @@
+# Attach two guests and a storage to an existing pool
+resource "proxmox_pool_membership" "dev" {
+  pool_id  = "dev"
+  vm_ids   = [100, 101]
+  storages = ["local-lvm"]
+}
@@ -0,0 +1,2 @@
+# Realm sync jobs can be imported using the job ID.
+terraform import proxmox_realm_sync_job.ldap ldap-nightly
@@ -0,0 +1,8 @@
+resource "proxmox_realm_sync_job" "ldap" {
+  job_id          = "ldap-nightly"
+  realm           = "ldap"
+  schedule        = "*-*-* 02:00"
+  scope           = "both"
+  enable_new      = true
+  remove_vanished = ["acl", "entry", "properties"]
+}
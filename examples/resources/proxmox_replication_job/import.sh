@@ -0,0 +1,3 @@
+# Replication jobs can be imported using the job ID, made of the guest ID and
+# the job number.
+terraform import proxmox_replication_job.db 100-0
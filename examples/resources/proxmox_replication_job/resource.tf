@@ -0,0 +1,7 @@
+resource "proxmox_replication_job" "db" {
+  vm_id    = 100
+  target   = "pve2"
+  schedule = "*/5"
+  rate     = 100
+  comment  = "Replicate the database to the standby node"
+}
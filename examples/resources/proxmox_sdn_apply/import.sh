@@ -0,0 +1,2 @@
+# The SDN apply resource can be imported using the fixed identifier sdn.
+terraform import proxmox_sdn_apply.this sdn
@@ -0,0 +1,21 @@
+resource "proxmox_sdn_zone" "lab" {
+  zone = "labzone"
+  type = "simple"
+  dhcp = "dnsmasq"
+  ipam = "pve"
+}
+
+resource "proxmox_sdn_subnet" "lab" {
+  vnet    = "labnet"
+  cidr    = "10.20.0.0/24"
+  gateway = "10.20.0.1"
+  snat    = true
+}
+
+# Apply the pending SDN configuration whenever one of the objects changes
+resource "proxmox_sdn_apply" "this" {
+  triggers = {
+    zone   = jsonencode(proxmox_sdn_zone.lab)
+    subnet = jsonencode(proxmox_sdn_subnet.lab)
+  }
+}
@@ -0,0 +1,3 @@
+# SDN DNS plugins can be imported using the plugin name. The key is not
+# imported.
+terraform import proxmox_sdn_dns.powerdns powerdns
@@ -0,0 +1,14 @@
+variable "powerdns_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "proxmox_sdn_dns" "powerdns" {
+  dns         = "powerdns"
+  type        = "powerdns"
+  url         = "https://dns.example.com:8081/api/v1/servers/localhost"
+  key         = var.powerdns_key
+  key_version = 1
+  ttl         = 3600
+}
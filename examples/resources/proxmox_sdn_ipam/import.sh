@@ -0,0 +1,3 @@
+# SDN IPAM plugins can be imported using the plugin name. The token is not
+# imported.
+terraform import proxmox_sdn_ipam.netbox netbox
@@ -0,0 +1,13 @@
+variable "netbox_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "proxmox_sdn_ipam" "netbox" {
+  ipam          = "netbox"
+  type          = "netbox"
+  url           = "https://netbox.example.com/api"
+  token         = var.netbox_token
+  token_version = 1
+}
@@ -0,0 +1,2 @@
+# SDN subnets can be imported using the VNet name and the subnet identifier.
+terraform import proxmox_sdn_subnet.lab labnet/labzone-10.20.0.0-24
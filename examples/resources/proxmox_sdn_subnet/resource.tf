@@ -0,0 +1,12 @@
+resource "proxmox_sdn_subnet" "lab" {
+  vnet            = "labnet"
+  cidr            = "10.20.0.0/24"
+  gateway         = "10.20.0.1"
+  snat            = true
+  dhcp_dns_server = "10.20.0.1"
+
+  dhcp_range = [{
+    start_address = "10.20.0.100"
+    end_address   = "10.20.0.199"
+  }]
+}
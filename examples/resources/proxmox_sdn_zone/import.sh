@@ -0,0 +1,2 @@
+# SDN zones can be imported using the zone name.
+terraform import proxmox_sdn_zone.vlan vlanzone
@@ -0,0 +1,16 @@
+# VLAN zone on top of an existing bridge
+resource "proxmox_sdn_zone" "vlan" {
+  zone   = "vlanzone"
+  type   = "vlan"
+  bridge = "vmbr0"
+}
+
+# EVPN zone routed through two exit nodes
+resource "proxmox_sdn_zone" "evpn" {
+  zone       = "evpn1"
+  type       = "evpn"
+  controller = "evpnctl"
+  vrf_vxlan  = 10000
+  mtu        = 1450
+  exit_nodes = ["pve1", "pve2"]
+}
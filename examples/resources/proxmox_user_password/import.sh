@@ -0,0 +1,2 @@
+# User passwords can be imported using the user ID.
+terraform import proxmox_user_password.alice alice@pve
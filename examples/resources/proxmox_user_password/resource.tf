@@ -0,0 +1,21 @@
+variable "password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "token_owner_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Set the password of an existing user without storing it in state. Bump
+# password_version whenever the password should be rotated. Proxmox VE 8
+# confirms the change with the password of the user owning the API token.
+resource "proxmox_user_password" "alice" {
+  user_id               = "alice@pve"
+  password              = var.password
+  password_version      = 1
+  confirmation_password = var.token_owner_password
+}
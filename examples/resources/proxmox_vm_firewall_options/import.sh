@@ -0,0 +1,2 @@
+# VM firewall options can be imported using the node name and VM ID.
+terraform import proxmox_vm_firewall_options.web pve1/100
@@ -0,0 +1,10 @@
+resource "proxmox_vm_firewall_options" "web" {
+  node       = "pve1"
+  vm_id      = 100
+  enabled    = true
+  dhcp       = true
+  ipfilter   = true
+  macfilter  = true
+  policy_in  = "DROP"
+  policy_out = "ACCEPT"
+}
@@ -0,0 +1,2 @@
+# VM firewall rules can be imported using the node name and VM ID.
+terraform import proxmox_vm_firewall_rules.web pve1/100
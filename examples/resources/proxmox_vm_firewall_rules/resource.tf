@@ -0,0 +1,24 @@
+resource "proxmox_vm_firewall_rules" "web" {
+  node  = "pve1"
+  vm_id = 100
+
+  # Rules shared by all web servers
+  rule {
+    type   = "group"
+    action = "webservers"
+  }
+
+  rule {
+    type    = "in"
+    action  = "ACCEPT"
+    macro   = "SSH"
+    source  = "+management"
+    comment = "SSH from the management IP set"
+  }
+
+  rule {
+    type   = "in"
+    action = "DROP"
+    log    = "info"
+  }
+}
@@ -0,0 +1,9 @@
+# Restored virtual machines can be imported by their VM ID, wherever the
+# virtual machine runs. The cluster is optional and checked if given.
+import {
+  to = proxmox_vm_restore.db_rehearsal
+  identity = {
+    cluster = "lab"
+    vm_id   = 9100
+  }
+}
@@ -0,0 +1,2 @@
+# Restored virtual machines can be imported using the node name and VM ID.
+terraform import proxmox_vm_restore.db_rehearsal pve2/9100
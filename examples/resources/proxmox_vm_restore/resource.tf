@@ -0,0 +1,28 @@
+# Disaster recovery rehearsal: restore the latest backup of the database VM
+# as a separate VM, regenerating its MAC addresses.
+resource "proxmox_vm_restore" "db_rehearsal" {
+  node    = "pve2"
+  vm_id   = 9100
+  archive = "backup-nfs:backup/vzdump-qemu-100-2024_01_01-02_00_00.vma.zst"
+  storage = "local-lvm"
+  unique  = true
+  start   = true
+
+  triggers = {
+    rehearsal = "2024-01"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
+
+# A restored VM that is kept as a pet: destroying it, also by replacing it,
+# fails until deletion_protection is set to false and applied.
+resource "proxmox_vm_restore" "legacy_app" {
+  node    = "pve1"
+  vm_id   = 200
+  archive = "backup-nfs:backup/vzdump-qemu-200-2024_01_01-02_00_00.vma.zst"
+
+  deletion_protection = true
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeproxmox
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// AddUser adds an enabled user without password.
+func (s *Server) AddUser(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[userID] = map[string]interface{}{"userid": userID, "enable": 1, "expire": 0}
+	s.tokens[userID] = map[string]map[string]interface{}{}
+}
+
+// Password returns the password of a user, or false if none was set.
+func (s *Server) Password(userID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	password, ok := s.password[userID]
+	return password, ok
+}
+
+// Token returns the settings of an API token, or false if it does not exist.
+func (s *Server) Token(userID, tokenID string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[userID][tokenID]
+	if !ok {
+		return nil, false
+	}
+	return copyConfig(token), true
+}
+
+// AddACL grants role on path to a user, group or token, with propagation.
+func (s *Server) AddACL(path, aclType, ugid, role string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acl = append(s.acl, map[string]interface{}{"path": path, "type": aclType, "ugid": ugid, "roleid": role, "propagate": 1})
+}
+
+// ACL returns the access control list entries.
+func (s *Server) ACL() []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := []map[string]interface{}{}
+	for _, entry := range s.acl {
+		entries = append(entries, copyConfig(entry))
+	}
+	return entries
+}
+
+func (s *Server) registerAccess(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/access/users", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		entries := []map[string]interface{}{}
+		for _, userID := range sortedKeys(s.users) {
+			entry := copyConfig(s.users[userID])
+			if _, realm, _ := strings.Cut(userID, "@"); realm != "" {
+				entry["realm-type"] = realm
+			}
+			entries = append(entries, entry)
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("POST "+apiPrefix+"/access/users", func(w http.ResponseWriter, r *http.Request) {
+		p := params(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		userID, _ := stringParam(p, "userid")
+		if !strings.Contains(userID, "@") {
+			writeParameterErrors(w, map[string]string{"userid": "value does not match the regex pattern"})
+			return
+		}
+		if s.users[userID] != nil {
+			writeError(w, http.StatusInternalServerError, "create user failed: user '%s' already exists", userID)
+			return
+		}
+
+		user := map[string]interface{}{"userid": userID, "enable": 1, "expire": 0}
+		update(user, p, "password")
+		s.users[userID] = user
+		s.tokens[userID] = map[string]map[string]interface{}{}
+		if password, ok := stringParam(p, "password"); ok {
+			s.password[userID] = password
+		}
+		writeData(w, nil)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/access/users/{userid}", s.withUser(func(w http.ResponseWriter, r *http.Request, userID string) {
+		user := copyConfig(s.users[userID])
+		tokens := []map[string]interface{}{}
+		for _, tokenID := range sortedKeys(s.tokens[userID]) {
+			tokens = append(tokens, tokenEntry(tokenID, s.tokens[userID][tokenID]))
+		}
+		user["tokens"] = tokens
+		writeData(w, user)
+	}))
+
+	mux.HandleFunc("PUT "+apiPrefix+"/access/users/{userid}", s.withUser(func(w http.ResponseWriter, r *http.Request, userID string) {
+		update(s.users[userID], params(r), "userid")
+		writeData(w, nil)
+	}))
+
+	mux.HandleFunc("DELETE "+apiPrefix+"/access/users/{userid}", s.withUser(func(w http.ResponseWriter, r *http.Request, userID string) {
+		delete(s.users, userID)
+		delete(s.tokens, userID)
+		delete(s.password, userID)
+		writeData(w, nil)
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/access/users/{userid}/token", s.withUser(func(w http.ResponseWriter, r *http.Request, userID string) {
+		tokens := []map[string]interface{}{}
+		for _, tokenID := range sortedKeys(s.tokens[userID]) {
+			tokens = append(tokens, tokenEntry(tokenID, s.tokens[userID][tokenID]))
+		}
+		writeData(w, tokens)
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/access/users/{userid}/token/{tokenid}", s.withToken(func(w http.ResponseWriter, r *http.Request, userID, tokenID string, token map[string]interface{}) {
+		writeData(w, copyConfig(token))
+	}))
+
+	mux.HandleFunc("POST "+apiPrefix+"/access/users/{userid}/token/{tokenid}", s.withUser(func(w http.ResponseWriter, r *http.Request, userID string) {
+		tokenID := r.PathValue("tokenid")
+		if s.tokens[userID][tokenID] != nil {
+			writeError(w, http.StatusInternalServerError, "Token already exists.")
+			return
+		}
+
+		token := map[string]interface{}{"privsep": 1, "expire": 0}
+		update(token, params(r))
+		s.tokens[userID][tokenID] = token
+
+		s.counter++
+		writeData(w, map[string]interface{}{
+			"full-tokenid": userID + "!" + tokenID,
+			"info":         copyConfig(token),
+			"value":        fmt.Sprintf("%08x-0000-4000-8000-%012x", s.counter, s.counter),
+		})
+	}))
+
+	mux.HandleFunc("PUT "+apiPrefix+"/access/users/{userid}/token/{tokenid}", s.withToken(func(w http.ResponseWriter, r *http.Request, userID, tokenID string, token map[string]interface{}) {
+		update(token, params(r))
+		writeData(w, copyConfig(token))
+	}))
+
+	mux.HandleFunc("DELETE "+apiPrefix+"/access/users/{userid}/token/{tokenid}", s.withToken(func(w http.ResponseWriter, r *http.Request, userID, tokenID string, token map[string]interface{}) {
+		delete(s.tokens[userID], tokenID)
+		// Like Proxmox VE, drop the permissions of the token.
+		var kept []map[string]interface{}
+		for _, entry := range s.acl {
+			if entry["type"] != "token" || entry["ugid"] != userID+"!"+tokenID {
+				kept = append(kept, entry)
+			}
+		}
+		s.acl = kept
+		writeData(w, nil)
+	}))
+
+	mux.HandleFunc("PUT "+apiPrefix+"/access/password", func(w http.ResponseWriter, r *http.Request) {
+		p := params(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		userID, _ := stringParam(p, "userid")
+		password, _ := stringParam(p, "password")
+		if len(password) < 5 {
+			writeParameterErrors(w, map[string]string{"password": "value must have a minimum length of 5"})
+			return
+		}
+		// Proxmox VE 8 requires the password of the authenticated user from
+		// anyone but root@pam, which API tokens never are.
+		confirmation, _ := stringParam(p, "confirmation-password")
+		if confirmation == "" {
+			writeParameterErrors(w, map[string]string{"confirmation-password": "a password is required"})
+			return
+		}
+		if owner, _, _ := strings.Cut(TokenID, "!"); confirmation != s.password[owner] {
+			writeError(w, http.StatusInternalServerError, "authentication failure")
+			return
+		}
+		if s.users[userID] == nil {
+			writeError(w, http.StatusInternalServerError, "no such user ('%s')", userID)
+			return
+		}
+		s.password[userID] = password
+		writeData(w, nil)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/access/roles", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		entries := []map[string]interface{}{}
+		for _, role := range sortedKeys(s.roles) {
+			entries = append(entries, map[string]interface{}{"roleid": role, "privs": s.roles[role], "special": 1})
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/access/acl", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		entries := []map[string]interface{}{}
+		for _, entry := range s.acl {
+			entries = append(entries, copyConfig(entry))
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("PUT "+apiPrefix+"/access/acl", func(w http.ResponseWriter, r *http.Request) {
+		p := params(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		path, _ := stringParam(p, "path")
+		roles, _ := stringParam(p, "roles")
+		if path == "" || roles == "" {
+			writeParameterErrors(w, map[string]string{"path": "property is missing and it is not optional"})
+			return
+		}
+
+		var subjects []map[string]interface{}
+		for _, subject := range []struct{ param, aclType string }{{"users", "user"}, {"groups", "group"}, {"tokens", "token"}} {
+			value, _ := stringParam(p, subject.param)
+			for _, id := range strings.Split(value, ",") {
+				if id != "" {
+					subjects = append(subjects, map[string]interface{}{"type": subject.aclType, "ugid": id})
+				}
+			}
+		}
+
+		for _, role := range strings.Split(roles, ",") {
+			if _, ok := s.roles[role]; !ok {
+				writeError(w, http.StatusInternalServerError, "role '%s' does not exist", role)
+				return
+			}
+			for _, subject := range subjects {
+				var kept []map[string]interface{}
+				for _, entry := range s.acl {
+					if entry["path"] != path || entry["roleid"] != role || entry["ugid"] != subject["ugid"] {
+						kept = append(kept, entry)
+					}
+				}
+				s.acl = kept
+				if !boolParam(p, "delete") {
+					entry := copyConfig(subject)
+					entry["path"] = path
+					entry["roleid"] = role
+					entry["propagate"] = 1
+					if _, ok := p["propagate"]; ok && !boolParam(p, "propagate") {
+						entry["propagate"] = 0
+					}
+					s.acl = append(s.acl, entry)
+				}
+			}
+		}
+		writeData(w, nil)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/access/domains", func(w http.ResponseWriter, r *http.Request) {
+		writeData(w, []map[string]interface{}{
+			{"realm": "pam", "type": "pam", "comment": "Linux PAM standard authentication"},
+			{"realm": "pve", "type": "pve", "comment": "Proxmox VE authentication server"},
+		})
+	})
+}
+
+// tokenEntry returns the entry of a token in token lists.
+func tokenEntry(tokenID string, token map[string]interface{}) map[string]interface{} {
+	entry := copyConfig(token)
+	entry["tokenid"] = tokenID
+	return entry
+}
+
+// withUser calls handler with the user ID of the request, holding the lock.
+func (s *Server) withUser(handler func(w http.ResponseWriter, r *http.Request, userID string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		userID := r.PathValue("userid")
+		if s.users[userID] == nil {
+			writeError(w, http.StatusInternalServerError, "no such user ('%s')", userID)
+			return
+		}
+		handler(w, r, userID)
+	}
+}
+
+// withToken calls handler with the token of the request, holding the lock.
+func (s *Server) withToken(handler func(w http.ResponseWriter, r *http.Request, userID, tokenID string, token map[string]interface{})) http.HandlerFunc {
+	return s.withUser(func(w http.ResponseWriter, r *http.Request, userID string) {
+		tokenID := r.PathValue("tokenid")
+		token, ok := s.tokens[userID][tokenID]
+		if !ok {
+			writeError(w, http.StatusInternalServerError, "no such token '%s' for user '%s'", tokenID, userID)
+			return
+		}
+		handler(w, r, userID, tokenID, token)
+	})
+}
@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeproxmox
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// Guest types as used in API paths.
+const (
+	QEMU = "qemu"
+	LXC  = "lxc"
+)
+
+// guest is a virtual machine or container.
+type guest struct {
+	guestType string
+	node      string
+	vmID      int64
+	running   bool
+	config    map[string]interface{}
+	digest    int
+}
+
+// name returns the name of the guest, which containers call hostname.
+func (g *guest) name() string {
+	key := "name"
+	if g.guestType == LXC {
+		key = "hostname"
+	}
+	name, _ := stringParam(g.config, key)
+	return name
+}
+
+// status returns the power state of the guest.
+func (g *guest) status() string {
+	if g.running {
+		return "running"
+	}
+	return "stopped"
+}
+
+// entry returns the entry of the guest in guest and resource lists.
+func (g *guest) entry() map[string]interface{} {
+	memory, _ := intParam(g.config, "memory")
+	if memory == 0 {
+		memory = 512
+	}
+	cores, _ := intParam(g.config, "cores")
+	if cores == 0 {
+		cores = 1
+	}
+	entry := map[string]interface{}{
+		"id":       fmt.Sprintf("%s/%d", g.guestType, g.vmID),
+		"type":     g.guestType,
+		"vmid":     g.vmID,
+		"name":     g.name(),
+		"node":     g.node,
+		"status":   g.status(),
+		"template": 0,
+		"maxcpu":   cores,
+		"maxmem":   memory * 1024 * 1024,
+		"maxdisk":  8589934592,
+		"cpu":      0,
+		"mem":      0,
+		"disk":     0,
+		"uptime":   0,
+	}
+	if boolParam(g.config, "template") {
+		entry["template"] = 1
+	}
+	if tags, ok := stringParam(g.config, "tags"); ok {
+		entry["tags"] = tags
+	}
+	if g.running {
+		entry["mem"] = memory * 1024 * 1024 / 4
+		entry["uptime"] = 3600
+	}
+	return entry
+}
+
+// configPath returns the path of the configuration file of the guest, as
+// named in error messages.
+func configPath(guestType, node string, vmID int64) string {
+	dir := "qemu-server"
+	if guestType == LXC {
+		dir = "lxc"
+	}
+	return fmt.Sprintf("nodes/%s/%s/%d.conf", node, dir, vmID)
+}
+
+// label returns how Proxmox VE refers to guests of a type in messages.
+func label(guestType string) string {
+	if guestType == LXC {
+		return "CT"
+	}
+	return "VM"
+}
+
+// AddGuest adds a stopped guest of the given type with the configuration.
+func (s *Server) AddGuest(guestType, node string, vmID int64, config map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.guests[vmID] = &guest{guestType: guestType, node: node, vmID: vmID, config: copyConfig(config)}
+}
+
+// Guest returns the configuration and power state of a guest, or false if it
+// does not exist.
+func (s *Server) Guest(vmID int64) (map[string]interface{}, bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, ok := s.guests[vmID]
+	if !ok {
+		return nil, false, false
+	}
+	return copyConfig(g.config), g.running, true
+}
+
+// MoveGuest moves a guest to another node, like a migration or a relocation
+// by HA does.
+func (s *Server) MoveGuest(vmID int64, node string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.guests[vmID].node = node
+}
+
+func copyConfig(config map[string]interface{}) map[string]interface{} {
+	copied := map[string]interface{}{}
+	for key, value := range config {
+		copied[key] = value
+	}
+	return copied
+}
+
+// lockError is the message of changes to a locked guest.
+func lockError(guestType string, vmID int64) string {
+	dir := "qemu-server"
+	if guestType == LXC {
+		dir = "lxc"
+	}
+	return fmt.Sprintf("can't lock file '/var/lock/%s/lock-%d.conf' - got timeout", dir, vmID)
+}
+
+func (s *Server) registerGuests(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/cluster/resources", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		resourceType := r.URL.Query().Get("type")
+		entries := []map[string]interface{}{}
+		if resourceType == "" || resourceType == "node" {
+			for _, node := range s.nodes {
+				entries = append(entries, s.nodeEntry(node))
+			}
+		}
+		if resourceType == "" || resourceType == "vm" {
+			for _, g := range s.sortedGuests("") {
+				entries = append(entries, g.entry())
+			}
+		}
+		if resourceType == "" || resourceType == "storage" {
+			for _, node := range s.nodes {
+				for _, id := range s.sortedStorages() {
+					entry := s.storageEntry(id)
+					entry["id"] = "storage/" + node + "/" + id
+					entry["type"] = "storage"
+					entry["node"] = node
+					entry["status"] = "available"
+					entry["plugintype"] = s.storages[id]["type"]
+					entry["disk"] = entry["used"]
+					entry["maxdisk"] = entry["total"]
+					entries = append(entries, entry)
+				}
+			}
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/cluster/nextid", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if requested := r.URL.Query().Get("vmid"); requested != "" {
+			vmID, err := strconv.ParseInt(requested, 10, 64)
+			if err != nil || vmID < 100 || vmID > 999999999 {
+				writeParameterErrors(w, map[string]string{"vmid": "value must be between 100 and 999999999"})
+				return
+			}
+			if _, taken := s.guests[vmID]; taken {
+				writeError(w, http.StatusBadRequest, "VM %d already exists", vmID)
+				return
+			}
+			writeData(w, requested)
+			return
+		}
+
+		vmID := int64(100)
+		for s.guests[vmID] != nil {
+			vmID++
+		}
+		writeData(w, strconv.FormatInt(vmID, 10))
+	})
+
+	for _, guestType := range []string{QEMU, LXC} {
+		prefix := apiPrefix + "/nodes/{node}/" + guestType
+
+		mux.HandleFunc("GET "+prefix, s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			entries := []map[string]interface{}{}
+			for _, g := range s.sortedGuests(guestType) {
+				if g.node == node {
+					entries = append(entries, g.entry())
+				}
+			}
+			writeData(w, entries)
+		}))
+
+		mux.HandleFunc("POST "+prefix, s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+			s.createGuest(w, params(r), guestType, node)
+		}))
+
+		mux.HandleFunc("GET "+prefix+"/{vmid}/config", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			config := copyConfig(g.config)
+			config["digest"] = fmt.Sprintf("%040x", g.digest)
+			writeData(w, config)
+		}))
+
+		mux.HandleFunc("GET "+prefix+"/{vmid}/pending", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			entries := []map[string]interface{}{}
+			for _, key := range sortedKeys(g.config) {
+				entries = append(entries, map[string]interface{}{"key": key, "value": g.config[key]})
+			}
+			writeData(w, entries)
+		}))
+
+		updateConfig := func(w http.ResponseWriter, r *http.Request, g *guest, async bool) {
+			p := params(r)
+			if s.guestLocked(g.vmID) {
+				writeError(w, http.StatusInternalServerError, "%s", lockError(guestType, g.vmID))
+				return
+			}
+			if digest, ok := stringParam(p, "digest"); ok && digest != fmt.Sprintf("%040x", g.digest) {
+				writeError(w, http.StatusInternalServerError, "detected modified configuration - file changed by other user? Try again.")
+				return
+			}
+			apply := func() {
+				update(g.config, p)
+				g.digest++
+			}
+			if async {
+				writeData(w, s.startTask(g.node, "qmconfig", strconv.FormatInt(g.vmID, 10), g.vmID, apply))
+				return
+			}
+			apply()
+			writeData(w, nil)
+		}
+		mux.HandleFunc("PUT "+prefix+"/{vmid}/config", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			updateConfig(w, r, g, false)
+		}))
+		if guestType == QEMU {
+			mux.HandleFunc("POST "+prefix+"/{vmid}/config", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+				updateConfig(w, r, g, true)
+			}))
+		}
+
+		mux.HandleFunc("GET "+prefix+"/{vmid}/status/current", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			status := g.entry()
+			delete(status, "id")
+			delete(status, "type")
+			delete(status, "node")
+			status["ha"] = map[string]interface{}{"managed": 0}
+			if guestType == QEMU {
+				status["qmpstatus"] = g.status()
+				if boolParam(g.config, "agent") && g.running {
+					status["agent"] = 1
+				}
+			}
+			writeData(w, status)
+		}))
+
+		mux.HandleFunc("POST "+prefix+"/{vmid}/status/{command}", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			s.powerGuest(w, g, r.PathValue("command"))
+		}))
+
+		mux.HandleFunc("GET "+prefix+"/{vmid}/snapshot", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			writeData(w, []map[string]interface{}{{"name": "current", "description": "You are here!", "running": boolToInt(g.running)}})
+		}))
+
+		mux.HandleFunc("DELETE "+prefix+"/{vmid}", s.withGuest(guestType, func(w http.ResponseWriter, r *http.Request, g *guest) {
+			if s.guestLocked(g.vmID) {
+				writeError(w, http.StatusInternalServerError, "%s", lockError(guestType, g.vmID))
+				return
+			}
+			if g.running {
+				writeError(w, http.StatusInternalServerError, "%s %d is running - destroy failed", label(guestType), g.vmID)
+				return
+			}
+			taskType := "qmdestroy"
+			if guestType == LXC {
+				taskType = "vzdestroy"
+			}
+			writeData(w, s.startTask(g.node, taskType, strconv.FormatInt(g.vmID, 10), g.vmID, func() {
+				delete(s.guests, g.vmID)
+				if pool := s.poolOf(g.vmID); pool != "" {
+					delete(s.pools[pool].vmIDs, g.vmID)
+				}
+			}))
+		}))
+	}
+}
+
+// createGuest creates or restores a guest in a task. The lock must not be
+// held.
+func (s *Server) createGuest(w http.ResponseWriter, p map[string]interface{}, guestType, node string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vmID, ok := intParam(p, "vmid")
+	if !ok {
+		writeParameterErrors(w, map[string]string{"vmid": "property is missing and it is not optional"})
+		return
+	}
+	if vmID < 100 || vmID > 999999999 {
+		writeParameterErrors(w, map[string]string{"vmid": "value must be between 100 and 999999999"})
+		return
+	}
+
+	archive, restore := stringParam(p, "archive")
+	if guestType == LXC {
+		archive, _ = stringParam(p, "ostemplate")
+		restore = boolParam(p, "restore")
+	}
+	if !restore && guestType == LXC && archive == "" {
+		writeParameterErrors(w, map[string]string{"ostemplate": "property is missing and it is not optional"})
+		return
+	}
+
+	existing, exists := s.guests[vmID]
+	if exists && !(restore && boolParam(p, "force")) {
+		writeError(w, http.StatusInternalServerError, "unable to create %s %d - %s %d already exists on node '%s'",
+			label(guestType), vmID, label(guestType), vmID, existing.node)
+		return
+	}
+	if exists && existing.running {
+		writeError(w, http.StatusInternalServerError, "unable to restore %s %d - %s is running", label(guestType), vmID, label(guestType))
+		return
+	}
+	if storage, ok := stringParam(p, "storage"); ok && s.storages[storage] == nil {
+		writeError(w, http.StatusInternalServerError, "storage '%s' does not exist", storage)
+		return
+	}
+
+	config := map[string]interface{}{}
+	if restore {
+		source, ok := s.backups[archive]
+		if !ok {
+			writeError(w, http.StatusInternalServerError, "volume '%s' does not exist", archive)
+			return
+		}
+		if source.guestType != guestType {
+			writeError(w, http.StatusInternalServerError, "unable to restore %s %d - archive is not a %s backup", label(guestType), vmID, guestType)
+			return
+		}
+		config = copyConfig(source.config)
+	} else if guestType == LXC {
+		config["ostemplate"] = archive
+	}
+	update(config, p, "vmid", "archive", "ostemplate", "restore", "storage", "pool", "unique", "force", "start", "bwlimit", "password")
+	if !restore && guestType == LXC {
+		delete(config, "ostemplate")
+	}
+
+	taskType := map[bool]string{false: "qmcreate", true: "qmrestore"}[restore]
+	if guestType == LXC {
+		taskType = map[bool]string{false: "vzcreate", true: "vzrestore"}[restore]
+	}
+	start := boolParam(p, "start")
+	writeData(w, s.startTask(node, taskType, strconv.FormatInt(vmID, 10), vmID, func() {
+		s.guests[vmID] = &guest{guestType: guestType, node: node, vmID: vmID, config: config, running: start}
+	}))
+}
+
+// powerGuest runs a power command on a guest. The lock must be held.
+func (s *Server) powerGuest(w http.ResponseWriter, g *guest, command string) {
+	if s.guestLocked(g.vmID) {
+		writeError(w, http.StatusInternalServerError, "%s", lockError(g.guestType, g.vmID))
+		return
+	}
+
+	var running bool
+	switch command {
+	case "start":
+		if g.running {
+			writeError(w, http.StatusInternalServerError, "%s %d already running", label(g.guestType), g.vmID)
+			return
+		}
+		running = true
+	case "reboot", "reset", "resume":
+		if !g.running {
+			writeError(w, http.StatusInternalServerError, "%s %d not running", label(g.guestType), g.vmID)
+			return
+		}
+		running = true
+	case "stop", "shutdown", "suspend":
+	default:
+		writeError(w, http.StatusNotImplemented, "Method 'POST /nodes/%s/%s/%d/status/%s' not implemented", g.node, g.guestType, g.vmID, command)
+		return
+	}
+
+	prefix := "qm"
+	if g.guestType == LXC {
+		prefix = "vz"
+	}
+	writeData(w, s.startTask(g.node, prefix+command, strconv.FormatInt(g.vmID, 10), g.vmID, func() {
+		g.running = running
+	}))
+}
+
+// withGuest calls handler with the guest of the request, holding the lock.
+// Guests of another type or on another node do not exist at the path.
+func (s *Server) withGuest(guestType string, handler func(w http.ResponseWriter, r *http.Request, g *guest)) http.HandlerFunc {
+	return s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		vmID, err := strconv.ParseInt(r.PathValue("vmid"), 10, 64)
+		if err != nil {
+			writeParameterErrors(w, map[string]string{"vmid": "type check ('integer') failed - got '" + r.PathValue("vmid") + "'"})
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		g, ok := s.guests[vmID]
+		if !ok || g.node != node || g.guestType != guestType {
+			writeError(w, http.StatusInternalServerError, "Configuration file '%s' does not exist", configPath(guestType, node, vmID))
+			return
+		}
+		handler(w, r, g)
+	})
+}
+
+// sortedGuests returns the guests of a type, or all guests if guestType is
+// empty, ordered by ID. The lock must be held.
+func (s *Server) sortedGuests(guestType string) []*guest {
+	var guests []*guest
+	for _, g := range s.guests {
+		if guestType == "" || g.guestType == guestType {
+			guests = append(guests, g)
+		}
+	}
+	sort.Slice(guests, func(i, j int) bool { return guests[i].vmID < guests[j].vmID })
+	return guests
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeproxmox
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// pool is a resource pool with its guest and storage members.
+type pool struct {
+	comment  string
+	vmIDs    map[int64]bool
+	storages map[string]bool
+}
+
+// AddPool adds an empty pool.
+func (s *Server) AddPool(id, comment string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pools[id] = &pool{comment: comment, vmIDs: map[int64]bool{}, storages: map[string]bool{}}
+}
+
+// SetPoolMembers replaces the members of a pool, like changes made outside
+// of the provider.
+func (s *Server) SetPoolMembers(id string, vmIDs []int64, storages []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pools[id]
+	p.vmIDs = map[int64]bool{}
+	for _, vmID := range vmIDs {
+		p.vmIDs[vmID] = true
+	}
+	p.storages = map[string]bool{}
+	for _, storage := range storages {
+		p.storages[storage] = true
+	}
+}
+
+// PoolMembers returns the guest IDs and storages of a pool in order, or
+// false if it does not exist.
+func (s *Server) PoolMembers(id string) ([]int64, []string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.pools[id]
+	if !ok {
+		return nil, nil, false
+	}
+	return sortedKeys(p.vmIDs), sortedKeys(p.storages), true
+}
+
+// poolOf returns the pool a guest belongs to. The lock must be held.
+func (s *Server) poolOf(vmID int64) string {
+	for id, p := range s.pools {
+		if p.vmIDs[vmID] {
+			return id
+		}
+	}
+	return ""
+}
+
+func (s *Server) registerPools(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/pools", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		entries := []map[string]interface{}{}
+		for _, id := range sortedKeys(s.pools) {
+			entries = append(entries, map[string]interface{}{"poolid": id, "comment": s.pools[id].comment})
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("POST "+apiPrefix+"/pools", func(w http.ResponseWriter, r *http.Request) {
+		p := params(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, _ := stringParam(p, "poolid")
+		if id == "" {
+			writeParameterErrors(w, map[string]string{"poolid": "property is missing and it is not optional"})
+			return
+		}
+		if s.pools[id] != nil {
+			writeError(w, http.StatusInternalServerError, "create pool failed: pool '%s' already exists", id)
+			return
+		}
+		comment, _ := stringParam(p, "comment")
+		s.pools[id] = &pool{comment: comment, vmIDs: map[int64]bool{}, storages: map[string]bool{}}
+		writeData(w, nil)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/pools/{poolid}", s.withPool(func(w http.ResponseWriter, r *http.Request, id string, p *pool) {
+		// Like Proxmox VE, storages are listed once per node.
+		members := []map[string]interface{}{}
+		for _, vmID := range sortedKeys(p.vmIDs) {
+			if g := s.guests[vmID]; g != nil {
+				members = append(members, g.entry())
+			}
+		}
+		for _, node := range s.nodes {
+			for _, storage := range sortedKeys(p.storages) {
+				members = append(members, map[string]interface{}{
+					"id":         "storage/" + node + "/" + storage,
+					"type":       "storage",
+					"storage":    storage,
+					"node":       node,
+					"status":     "available",
+					"plugintype": s.storages[storage]["type"],
+				})
+			}
+		}
+		writeData(w, map[string]interface{}{"comment": p.comment, "members": members})
+	}))
+
+	mux.HandleFunc("PUT "+apiPrefix+"/pools/{poolid}", s.withPool(func(w http.ResponseWriter, r *http.Request, id string, p *pool) {
+		params := params(r)
+		remove := boolParam(params, "delete")
+
+		// Proxmox VE checks all members before changing any.
+		vms, _ := stringParam(params, "vms")
+		var vmIDs []int64
+		for _, value := range strings.Split(vms, ",") {
+			if value == "" {
+				continue
+			}
+			vmID, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				writeParameterErrors(w, map[string]string{"vms": "invalid format - value does not look like a valid VM ID"})
+				return
+			}
+			switch {
+			case remove && !p.vmIDs[vmID]:
+				writeError(w, http.StatusInternalServerError, "VM %d is not a pool member", vmID)
+				return
+			case !remove && p.vmIDs[vmID]:
+				writeError(w, http.StatusInternalServerError, "VM %d is already a pool member", vmID)
+				return
+			case !remove && s.guests[vmID] == nil:
+				writeError(w, http.StatusInternalServerError, "VM %d does not exist", vmID)
+				return
+			case !remove && s.poolOf(vmID) != "":
+				writeError(w, http.StatusInternalServerError, "VM %d belongs already to pool '%s'", vmID, s.poolOf(vmID))
+				return
+			}
+			vmIDs = append(vmIDs, vmID)
+		}
+
+		storage, _ := stringParam(params, "storage")
+		var storages []string
+		for _, value := range strings.Split(storage, ",") {
+			if value == "" {
+				continue
+			}
+			switch {
+			case remove && !p.storages[value]:
+				writeError(w, http.StatusInternalServerError, "Storage '%s' is not a pool member", value)
+				return
+			case !remove && p.storages[value]:
+				writeError(w, http.StatusInternalServerError, "Storage '%s' is already a pool member", value)
+				return
+			case !remove && s.storages[value] == nil:
+				writeError(w, http.StatusInternalServerError, "storage '%s' does not exist", value)
+				return
+			}
+			storages = append(storages, value)
+		}
+
+		for _, vmID := range vmIDs {
+			if remove {
+				delete(p.vmIDs, vmID)
+			} else {
+				p.vmIDs[vmID] = true
+			}
+		}
+		for _, storage := range storages {
+			if remove {
+				delete(p.storages, storage)
+			} else {
+				p.storages[storage] = true
+			}
+		}
+		if comment, ok := stringParam(params, "comment"); ok {
+			p.comment = comment
+		}
+		writeData(w, nil)
+	}))
+
+	mux.HandleFunc("DELETE "+apiPrefix+"/pools/{poolid}", s.withPool(func(w http.ResponseWriter, r *http.Request, id string, p *pool) {
+		if len(p.vmIDs) > 0 || len(p.storages) > 0 {
+			writeError(w, http.StatusInternalServerError, "delete pool failed: pool '%s' is not empty", id)
+			return
+		}
+		delete(s.pools, id)
+		writeData(w, nil)
+	}))
+}
+
+// withPool calls handler with the pool of the request, holding the lock.
+func (s *Server) withPool(handler func(w http.ResponseWriter, r *http.Request, id string, p *pool)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("poolid")
+		p, ok := s.pools[id]
+		if !ok {
+			writeError(w, http.StatusInternalServerError, "pool '%s' does not exist", id)
+			return
+		}
+		handler(w, r, id, p)
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeproxmox implements an in-memory Proxmox VE API server for unit
+// tests of the provider. It covers nodes, storages and their content, virtual
+// machines, containers, pools, users, API tokens, roles and ACLs, and answers with
+// the JSON and errors of a real cluster. Changes that Proxmox VE makes in
+// tasks only take effect once the task finished, and guests are locked while
+// their tasks run, so clients have to wait for tasks like against a real
+// cluster.
+package fakeproxmox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Credentials accepted by the server.
+const (
+	TokenID     = "root@pam!test"
+	TokenSecret = "00000000-0000-0000-0000-000000000000"
+
+	// RootPassword is the password of root@pam, the owner of the token.
+	RootPassword = "Passw0rd-root"
+)
+
+// Node is the name of the node the server starts with.
+const Node = "pve"
+
+// Server is a fake Proxmox VE API server. The exported methods prepare
+// objects and failures and inspect the state of the server.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	nodes        []string
+	storages     map[string]map[string]interface{}
+	volumes      map[string][]map[string]interface{}
+	backups      map[string]*guest
+	guests       map[int64]*guest
+	users        map[string]map[string]interface{}
+	tokens       map[string]map[string]map[string]interface{}
+	password     map[string]string
+	roles        map[string]string
+	acl          []map[string]interface{}
+	pools        map[string]*pool
+	tasks        map[string]*task
+	failures     []*failure
+	taskFailures []string
+	locks        map[int64]int
+	requests     []string
+	counter      int64
+
+	// TaskPolls is the number of status requests for which tasks are
+	// reported as running before they finish.
+	TaskPolls int
+
+	// ClusterName is the name of the cluster the nodes belong to. The nodes
+	// are standalone if it is empty.
+	ClusterName string
+
+	// NextID is the next-id datacenter option, the range of IDs suggested
+	// for new guests (e.g., "lower=1000,upper=1999"). It is unset if empty.
+	NextID string
+}
+
+// failure is an error returned for the next request matching method and
+// path prefix.
+type failure struct {
+	method, path string
+	status       int
+	message      string
+}
+
+// New starts a server with a single node named Node, a `local` directory
+// storage and the root@pam user. The server is closed at the end of the test.
+func New(t testing.TB) *Server {
+	s := &Server{
+		nodes:    []string{Node},
+		storages: map[string]map[string]interface{}{},
+		volumes:  map[string][]map[string]interface{}{},
+		backups:  map[string]*guest{},
+		guests:   map[int64]*guest{},
+		users:    map[string]map[string]interface{}{},
+		tokens:   map[string]map[string]map[string]interface{}{},
+		password: map[string]string{},
+		roles: map[string]string{
+			"Administrator": "Sys.Audit,Sys.Modify,VM.Allocate,VM.Audit,VM.Config.Disk,VM.PowerMgmt,Datastore.Allocate,Datastore.Audit,User.Modify,Permissions.Modify",
+			"NoAccess":      "",
+			"PVEAuditor":    "Datastore.Audit,Sys.Audit,VM.Audit",
+			"PVEVMAdmin":    "VM.Allocate,VM.Audit,VM.Config.Disk,VM.PowerMgmt",
+		},
+		pools:     map[string]*pool{},
+		tasks:     map[string]*task{},
+		locks:     map[int64]int{},
+		TaskPolls: 1,
+	}
+	s.AddStorage("local", "dir", map[string]interface{}{"path": "/var/lib/vz", "content": "iso,vztmpl,backup"})
+	s.AddUser("root@pam")
+	s.password["root@pam"] = RootPassword
+
+	mux := http.NewServeMux()
+	s.registerNodes(mux)
+	s.registerTasks(mux)
+	s.registerStorage(mux)
+	s.registerGuests(mux)
+	s.registerAccess(mux)
+	s.registerPools(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotImplemented, "Method '%s %s' not implemented", r.Method, strings.TrimPrefix(r.URL.Path, apiPrefix))
+	})
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// apiPrefix is the path prefix of all API requests.
+const apiPrefix = "/api2/json"
+
+// authenticate wraps handler with the token check, the request log and the
+// injected failures.
+func (s *Server) authenticate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "PVEAPIToken="+TokenID+"="+TokenSecret {
+			writeError(w, http.StatusUnauthorized, "authentication failure")
+			return
+		}
+
+		path := strings.TrimPrefix(r.URL.RequestURI(), apiPrefix)
+
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+path)
+		for i, f := range s.failures {
+			if f.method == r.Method && strings.HasPrefix(path, f.path) {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+				s.mu.Unlock()
+				writeError(w, f.status, "%s", f.message)
+				return
+			}
+		}
+		s.mu.Unlock()
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Fail makes the next request with the given method and a path starting
+// with path, which excludes the /api2/json prefix, fail with status and
+// message.
+func (s *Server) Fail(method, path string, status int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &failure{method: method, path: path, status: status, message: message})
+}
+
+// Requests returns the requests received so far as "METHOD path" strings,
+// with the query string but without the /api2/json prefix.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// AddNode adds a cluster node.
+func (s *Server) AddNode(node string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes = append(s.nodes, node)
+}
+
+// hasNode reports whether node is a cluster node. The lock must be held.
+func (s *Server) hasNode(node string) bool {
+	for _, n := range s.nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) registerNodes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/version", func(w http.ResponseWriter, r *http.Request) {
+		writeData(w, map[string]interface{}{"version": "8.2.4", "release": "8.2", "repoid": "faa83925c9641325"})
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		nodes := []map[string]interface{}{}
+		for _, node := range s.nodes {
+			nodes = append(nodes, s.nodeEntry(node))
+		}
+		writeData(w, nodes)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/cluster/status", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		entries := []map[string]interface{}{}
+		if s.ClusterName != "" {
+			entries = append(entries, map[string]interface{}{
+				"id": "cluster", "type": "cluster", "name": s.ClusterName,
+				"nodes": len(s.nodes), "quorate": 1, "version": 3,
+			})
+		}
+		for i, node := range s.nodes {
+			entries = append(entries, map[string]interface{}{
+				"id": "node/" + node, "type": "node", "name": node, "nodeid": i + 1,
+				"ip": fmt.Sprintf("10.0.0.%d", i+1), "online": 1, "local": boolToInt(i == 0), "level": "",
+			})
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/cluster/options", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// Like Proxmox VE, return property strings parsed into objects.
+		options := map[string]interface{}{}
+		if s.NextID != "" {
+			nextID := map[string]interface{}{}
+			for _, part := range strings.Split(s.NextID, ",") {
+				key, value, _ := strings.Cut(part, "=")
+				nextID[key] = value
+			}
+			options["next-id"] = nextID
+		}
+		writeData(w, options)
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/version", s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		writeData(w, map[string]interface{}{"version": "8.2.4", "release": "8.2", "repoid": "faa83925c9641325"})
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/status", s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		writeData(w, map[string]interface{}{
+			"uptime":     86400,
+			"cpu":        0.05,
+			"cpuinfo":    map[string]interface{}{"cpus": 8, "sockets": 1, "model": "QEMU Virtual CPU"},
+			"memory":     map[string]interface{}{"total": 17179869184, "used": 4294967296, "free": 12884901888},
+			"rootfs":     map[string]interface{}{"total": 107374182400, "used": 10737418240, "avail": 96636764160},
+			"pveversion": "pve-manager/8.2.4/faa83925c9641325",
+			"kversion":   "Linux 6.8.8-2-pve",
+		})
+	}))
+}
+
+// nodeEntry returns the entry of node in node and resource lists.
+func (s *Server) nodeEntry(node string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              "node/" + node,
+		"type":            "node",
+		"node":            node,
+		"status":          "online",
+		"level":           "",
+		"cpu":             0.05,
+		"maxcpu":          8,
+		"mem":             4294967296,
+		"maxmem":          17179869184,
+		"disk":            10737418240,
+		"maxdisk":         107374182400,
+		"uptime":          86400,
+		"ssl_fingerprint": "AA:BB:CC:DD:EE:FF:00:11:22:33:44:55:66:77:88:99:AA:BB:CC:DD:EE:FF:00:11:22:33:44:55:66:77:88:99",
+	}
+}
+
+// withNode calls handler for requests whose node path value is a cluster
+// node, and fails others like pveproxy does for unknown nodes.
+func (s *Server) withNode(handler func(w http.ResponseWriter, r *http.Request, node string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		node := r.PathValue("node")
+		s.mu.Lock()
+		known := s.hasNode(node)
+		s.mu.Unlock()
+		if !known {
+			writeError(w, http.StatusInternalServerError, "hostname lookup '%s' failed - failed to get address info for: %s: Name or service not known", node, node)
+			return
+		}
+		handler(w, r, node)
+	}
+}
+
+// writeData writes a successful response.
+func writeData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+// writeError writes an error response with the message in the body, like
+// pveproxy does for API clients.
+func writeError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": nil, "message": fmt.Sprintf(format, args...) + "\n"})
+}
+
+// writeParameterErrors writes the response of requests with invalid
+// parameters.
+func writeParameterErrors(w http.ResponseWriter, errors map[string]string) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": nil, "errors": errors})
+}
+
+// params returns the parameters of a request from the JSON body and the
+// query string.
+func params(r *http.Request) map[string]interface{} {
+	params := map[string]interface{}{}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&params)
+	}
+	for key, values := range r.URL.Query() {
+		params[key] = values[0]
+	}
+	return params
+}
+
+// stringParam returns a parameter as string, with numbers formatted like
+// Proxmox VE does.
+func stringParam(params map[string]interface{}, key string) (string, bool) {
+	switch v := params[key].(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		if v {
+			return "1", true
+		}
+		return "0", true
+	}
+	return "", false
+}
+
+// intParam returns a numeric parameter.
+func intParam(params map[string]interface{}, key string) (int64, bool) {
+	value, ok := stringParam(params, key)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	return n, err == nil
+}
+
+// boolParam returns a boolean parameter, which Proxmox VE accepts as 0 or 1.
+func boolParam(params map[string]interface{}, key string) bool {
+	value, _ := stringParam(params, key)
+	return value == "1" || value == "true"
+}
+
+// update sets the parameters in config, except the given control
+// parameters, and removes the keys listed in the delete parameter.
+func update(config, params map[string]interface{}, control ...string) {
+	for key, value := range params {
+		if key == "delete" || key == "digest" || contains(control, key) {
+			continue
+		}
+		if s, ok := stringParam(params, key); ok {
+			config[key] = s
+		} else {
+			config[key] = value
+		}
+	}
+	if deleted, ok := stringParam(params, "delete"); ok {
+		for _, key := range strings.FieldsFunc(deleted, func(r rune) bool { return r == ',' || r == ';' || r == ' ' }) {
+			delete(config, key)
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeproxmox
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// request performs an authenticated request and decodes the data of the
+// response into out.
+func request(t *testing.T, s *Server, method, path, body string, out interface{}) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.URL+apiPrefix+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "PVEAPIToken="+TokenID+"="+TokenSecret)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		var envelope struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(envelope.Data, out); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestTasks(t *testing.T) {
+	s := New(t)
+	s.TaskPolls = 2
+	s.AddGuest(QEMU, Node, 100, map[string]interface{}{"name": "web"})
+
+	var upid string
+	if status := request(t, s, http.MethodPost, "/nodes/pve/qemu/100/status/start", "", &upid); status != http.StatusOK {
+		t.Fatalf("expected start to succeed, got status %d", status)
+	}
+
+	// The guest is locked and unchanged until the task finished.
+	if status := request(t, s, http.MethodPut, "/nodes/pve/qemu/100/config", `{"cores":2}`, nil); status != http.StatusInternalServerError {
+		t.Errorf("expected a lock error while the task runs, got status %d", status)
+	}
+	for poll, want := range []string{"running", "running", "stopped"} {
+		var task map[string]interface{}
+		request(t, s, http.MethodGet, "/nodes/pve/tasks/"+upid+"/status", "", &task)
+		if task["status"] != want {
+			t.Errorf("poll %d: expected task status %s, got %v", poll, want, task["status"])
+		}
+		if _, running, _ := s.Guest(100); running != (want == "stopped") {
+			t.Errorf("poll %d: expected the guest to run only after the task finished", poll)
+		}
+	}
+
+	if status := request(t, s, http.MethodPut, "/nodes/pve/qemu/100/config", `{"cores":2}`, nil); status != http.StatusOK {
+		t.Errorf("expected the configuration to change after the task, got status %d", status)
+	}
+	if status := request(t, s, http.MethodDelete, "/nodes/pve/qemu/100", "", nil); status != http.StatusInternalServerError {
+		t.Errorf("expected destroying a running guest to fail, got status %d", status)
+	}
+}
+
+func TestFailures(t *testing.T) {
+	s := New(t)
+
+	s.Fail(http.MethodGet, "/version", 596, "")
+	if status := request(t, s, http.MethodGet, "/version", "", nil); status != 596 {
+		t.Errorf("expected the injected failure, got status %d", status)
+	}
+	if status := request(t, s, http.MethodGet, "/version", "", nil); status != http.StatusOK {
+		t.Errorf("expected the failure to apply once, got status %d", status)
+	}
+
+	s.FailNextTask("command failed")
+	s.AddGuest(LXC, Node, 100, nil)
+	var upid string
+	request(t, s, http.MethodPost, "/nodes/pve/lxc/100/status/start", "", &upid)
+	var task map[string]interface{}
+	request(t, s, http.MethodGet, "/nodes/pve/tasks/"+upid+"/status", "", &task)
+	request(t, s, http.MethodGet, "/nodes/pve/tasks/"+upid+"/status", "", &task)
+	if task["exitstatus"] != "command failed" {
+		t.Errorf("expected the task to fail, got %v", task)
+	}
+	if _, running, _ := s.Guest(100); running {
+		t.Error("expected the failed task not to start the guest")
+	}
+
+	if status := request(t, s, http.MethodGet, "/nodes/other/qemu", "", nil); status != http.StatusInternalServerError {
+		t.Errorf("expected unknown nodes to fail, got status %d", status)
+	}
+	if got := s.Requests(); len(got) != 6 || got[0] != "GET /version" {
+		t.Errorf("unexpected requests %v", got)
+	}
+}
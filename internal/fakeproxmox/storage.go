@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeproxmox
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Storage sizes reported for every storage.
+const (
+	storageTotal = 107374182400
+	storageUsed  = 10737418240
+)
+
+// AddStorage adds a storage of the given type with the configuration, which
+// is returned as is by GET /storage.
+func (s *Server) AddStorage(id, storageType string, config map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addStorage(id, storageType, config)
+}
+
+// addStorage adds a storage. The lock must be held.
+func (s *Server) addStorage(id, storageType string, config map[string]interface{}) {
+	storage := copyConfig(config)
+	storage["storage"] = id
+	storage["type"] = storageType
+	if _, ok := storage["content"]; !ok {
+		storage["content"] = "images,rootdir"
+	}
+	s.storages[id] = storage
+	s.volumes[id] = []map[string]interface{}{}
+}
+
+// AddVolume adds a volume with the given content type, e.g. iso, vztmpl or
+// images, to a storage and returns its volume ID.
+func (s *Server) AddVolume(storage, content, name string, size int64) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := map[string]string{"iso": "iso/", "vztmpl": "vztmpl/", "backup": "backup/"}[content]
+	volumeID := storage + ":" + dir + name
+	s.volumes[storage] = append(s.volumes[storage], map[string]interface{}{
+		"volid":   volumeID,
+		"content": content,
+		"format":  formatOf(name),
+		"size":    size,
+		"ctime":   s.nextCTime(),
+	})
+	return volumeID
+}
+
+// Volumes returns the IDs of the volumes on a storage.
+func (s *Server) Volumes(storage string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var volumes []string
+	for _, volume := range s.volumes[storage] {
+		volumes = append(volumes, volume["volid"].(string))
+	}
+	return volumes
+}
+
+// nextCTime returns a creation time that is later than the ones of all
+// volumes, so that the most recent backup is well defined even within the
+// same second. The lock must be held.
+func (s *Server) nextCTime() int64 {
+	s.counter++
+	return time.Now().Unix() + s.counter
+}
+
+func formatOf(name string) string {
+	switch {
+	case strings.HasSuffix(name, ".iso"):
+		return "iso"
+	case strings.HasSuffix(name, ".qcow2"):
+		return "qcow2"
+	case strings.Contains(name, ".tar"), strings.HasSuffix(name, ".vma.zst"):
+		return strings.TrimPrefix(name[strings.Index(name, "."):], ".")
+	}
+	return "raw"
+}
+
+// storageEntry returns the status of a storage as listed by the nodes. The
+// lock must be held.
+func (s *Server) storageEntry(id string) map[string]interface{} {
+	storage := s.storages[id]
+	entry := map[string]interface{}{
+		"storage": id,
+		"type":    storage["type"],
+		"content": storage["content"],
+		"active":  1,
+		"enabled": 1,
+		"shared":  0,
+		"total":   storageTotal,
+		"used":    storageUsed,
+		"avail":   storageTotal - storageUsed,
+	}
+	if boolParam(storage, "disable") {
+		entry["active"] = 0
+		entry["enabled"] = 0
+	}
+	if boolParam(storage, "shared") {
+		entry["shared"] = 1
+	}
+	entry["used_fraction"] = float64(storageUsed) / float64(storageTotal)
+	return entry
+}
+
+// sortedStorages returns the storage IDs in order. The lock must be held.
+func (s *Server) sortedStorages() []string {
+	ids := make([]string, 0, len(s.storages))
+	for id := range s.storages {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// storageOnNode reports whether a storage is available on a node, which
+// storages restricted with the nodes option are not on other nodes. The lock
+// must be held.
+func (s *Server) storageOnNode(id, node string) bool {
+	nodes, ok := stringParam(s.storages[id], "nodes")
+	return !ok || contains(strings.Split(nodes, ","), node)
+}
+
+func (s *Server) registerStorage(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/storage", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		storageType := r.URL.Query().Get("type")
+		entries := []map[string]interface{}{}
+		for _, id := range s.sortedStorages() {
+			if storageType == "" || s.storages[id]["type"] == storageType {
+				entry := copyConfig(s.storages[id])
+				entry["digest"] = storageDigest
+				entries = append(entries, entry)
+			}
+		}
+		writeData(w, entries)
+	})
+
+	mux.HandleFunc("POST "+apiPrefix+"/storage", func(w http.ResponseWriter, r *http.Request) {
+		p := params(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, _ := stringParam(p, "storage")
+		storageType, _ := stringParam(p, "type")
+		switch {
+		case id == "":
+			writeParameterErrors(w, map[string]string{"storage": "property is missing and it is not optional"})
+			return
+		case storageType == "":
+			writeParameterErrors(w, map[string]string{"type": "property is missing and it is not optional"})
+			return
+		case s.storages[id] != nil:
+			writeError(w, http.StatusInternalServerError, "create storage failed: storage ID '%s' already defined", id)
+			return
+		}
+
+		config := map[string]interface{}{}
+		update(config, p, "storage", "type")
+		s.addStorage(id, storageType, config)
+		writeData(w, map[string]interface{}{"storage": id, "type": storageType})
+	})
+
+	mux.HandleFunc("GET "+apiPrefix+"/storage/{storage}", s.withStorage(func(w http.ResponseWriter, r *http.Request, id string) {
+		entry := copyConfig(s.storages[id])
+		entry["digest"] = storageDigest
+		writeData(w, entry)
+	}))
+
+	mux.HandleFunc("PUT "+apiPrefix+"/storage/{storage}", s.withStorage(func(w http.ResponseWriter, r *http.Request, id string) {
+		p := params(r)
+		for _, key := range []string{"type", "path", "server", "export", "pool"} {
+			if _, ok := p[key]; ok {
+				writeParameterErrors(w, map[string]string{key: "can't change value of fixed parameter '" + key + "'"})
+				return
+			}
+		}
+		update(s.storages[id], p)
+		writeData(w, map[string]interface{}{"storage": id, "type": s.storages[id]["type"]})
+	}))
+
+	mux.HandleFunc("DELETE "+apiPrefix+"/storage/{storage}", s.withStorage(func(w http.ResponseWriter, r *http.Request, id string) {
+		delete(s.storages, id)
+		delete(s.volumes, id)
+		writeData(w, nil)
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/storage", s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		query := r.URL.Query()
+		entries := []map[string]interface{}{}
+		for _, id := range s.sortedStorages() {
+			if !s.storageOnNode(id, node) {
+				continue
+			}
+			entry := s.storageEntry(id)
+			if content := query.Get("content"); content != "" && !contains(strings.Split(entry["content"].(string), ","), content) {
+				continue
+			}
+			if query.Get("enabled") == "1" && entry["enabled"] == 0 {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		writeData(w, entries)
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/storage/{storage}/status", s.withNodeStorage(func(w http.ResponseWriter, r *http.Request, node, id string) {
+		writeData(w, s.storageEntry(id))
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/storage/{storage}/content", s.withNodeStorage(func(w http.ResponseWriter, r *http.Request, node, id string) {
+		query := r.URL.Query()
+		entries := []map[string]interface{}{}
+		for _, volume := range s.volumes[id] {
+			if content := query.Get("content"); content != "" && volume["content"] != content {
+				continue
+			}
+			if vmID := query.Get("vmid"); vmID != "" && fmt.Sprint(volume["vmid"]) != vmID {
+				continue
+			}
+			entries = append(entries, copyConfig(volume))
+		}
+		writeData(w, entries)
+	}))
+
+	mux.HandleFunc("DELETE "+apiPrefix+"/nodes/{node}/storage/{storage}/content/{volume...}", s.withNodeStorage(func(w http.ResponseWriter, r *http.Request, node, id string) {
+		volumeID := r.PathValue("volume")
+		for i, volume := range s.volumes[id] {
+			if volume["volid"] != volumeID {
+				continue
+			}
+			if boolParam(volume, "protected") {
+				writeError(w, http.StatusInternalServerError, "backup '%s' is protected", volumeID)
+				return
+			}
+			s.volumes[id] = append(s.volumes[id][:i], s.volumes[id][i+1:]...)
+			delete(s.backups, volumeID)
+			writeData(w, s.startTask(node, "imgdel", volumeID, 0, nil))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "volume '%s' does not exist", volumeID)
+	}))
+
+	mux.HandleFunc("POST "+apiPrefix+"/nodes/{node}/vzdump", s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		p := params(r)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		vmID, ok := intParam(p, "vmid")
+		if !ok {
+			writeParameterErrors(w, map[string]string{"vmid": "property is missing and it is not optional"})
+			return
+		}
+		storage, _ := stringParam(p, "storage")
+		if storage == "" {
+			storage = "local"
+		}
+		if s.storages[storage] == nil {
+			writeError(w, http.StatusInternalServerError, "storage '%s' does not exist", storage)
+			return
+		}
+		if !contains(strings.Split(s.storages[storage]["content"].(string), ","), "backup") {
+			writeError(w, http.StatusInternalServerError, "storage '%s' does not support backups", storage)
+			return
+		}
+
+		g, ok := s.guests[vmID]
+		if !ok || g.node != node {
+			writeError(w, http.StatusInternalServerError, "guest %d does not exist on node '%s'", vmID, node)
+			return
+		}
+		if s.guestLocked(vmID) {
+			writeError(w, http.StatusInternalServerError, "%s", lockError(g.guestType, vmID))
+			return
+		}
+
+		source := &guest{guestType: g.guestType, node: node, vmID: vmID, config: copyConfig(g.config)}
+		writeData(w, s.startTask(node, "vzdump", strconv.FormatInt(vmID, 10), vmID, func() {
+			ctime := s.nextCTime()
+			extension := "vma.zst"
+			if g.guestType == LXC {
+				extension = "tar.zst"
+			}
+			name := fmt.Sprintf("vzdump-%s-%d-%s.%s", g.guestType, vmID, time.Unix(ctime, 0).UTC().Format("2006_01_02-15_04_05"), extension)
+			volumeID := storage + ":backup/" + name
+			volume := map[string]interface{}{
+				"volid":   volumeID,
+				"content": "backup",
+				"format":  extension,
+				"size":    1073741824,
+				"ctime":   ctime,
+				"vmid":    vmID,
+				"subtype": g.guestType,
+			}
+			if boolParam(p, "protected") {
+				volume["protected"] = 1
+			}
+			if notes, ok := stringParam(p, "notes-template"); ok {
+				volume["notes"] = strings.ReplaceAll(notes, "{{vmid}}", strconv.FormatInt(vmID, 10))
+			}
+			s.volumes[storage] = append(s.volumes[storage], volume)
+			s.backups[volumeID] = source
+		}))
+	}))
+}
+
+// AddBackup adds a backup of a guest with the configuration to a storage and
+// returns its volume ID. The guest does not need to exist.
+func (s *Server) AddBackup(storage, guestType string, vmID int64, config map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctime := s.nextCTime()
+	volumeID := fmt.Sprintf("%s:backup/vzdump-%s-%d-%s.vma.zst", storage, guestType, vmID, time.Unix(ctime, 0).UTC().Format("2006_01_02-15_04_05"))
+	s.volumes[storage] = append(s.volumes[storage], map[string]interface{}{
+		"volid":   volumeID,
+		"content": "backup",
+		"format":  "vma.zst",
+		"size":    1073741824,
+		"ctime":   ctime,
+		"vmid":    vmID,
+		"subtype": guestType,
+	})
+	s.backups[volumeID] = &guest{guestType: guestType, vmID: vmID, config: copyConfig(config)}
+	return volumeID
+}
+
+// storageDigest is the digest of the storage configuration, which the server
+// does not check.
+const storageDigest = "9d4c4fbc6f4e1b0e4ad3cc0a3f7ebd1f30f0d6b5"
+
+// withStorage calls handler with the storage ID of the request, holding the
+// lock.
+func (s *Server) withStorage(handler func(w http.ResponseWriter, r *http.Request, id string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("storage")
+		if s.storages[id] == nil {
+			writeError(w, http.StatusInternalServerError, "storage '%s' does not exist", id)
+			return
+		}
+		handler(w, r, id)
+	}
+}
+
+// withNodeStorage calls handler with the node and storage ID of the request,
+// holding the lock.
+func (s *Server) withNodeStorage(handler func(w http.ResponseWriter, r *http.Request, node, id string)) http.HandlerFunc {
+	return s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("storage")
+		if s.storages[id] == nil || !s.storageOnNode(id, node) {
+			writeError(w, http.StatusInternalServerError, "storage '%s' does not exist", id)
+			return
+		}
+		handler(w, r, node, id)
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeproxmox
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// task is a worker task. Its changes are applied when it finishes, which
+// happens after TaskPolls status requests.
+type task struct {
+	upid     string
+	node     string
+	taskType string
+	id       string
+	start    int64
+	polls    int
+	stopped  bool
+	exit     string
+	log      []string
+	guest    int64
+	apply    func()
+	failWith string
+}
+
+// FailNextTask makes the next task fail with the given exit status instead of
+// applying its changes.
+func (s *Server) FailNextTask(exitStatus string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.taskFailures = append(s.taskFailures, exitStatus)
+}
+
+// startTask starts a task that applies its changes when it finishes. Tasks
+// with a guest lock the guest while they run. The lock must be held.
+func (s *Server) startTask(node, taskType, id string, guest int64, apply func()) string {
+	s.counter++
+	start := time.Now().Unix()
+	t := &task{
+		upid:     fmt.Sprintf("UPID:%s:%08X:%08X:%08X:%s:%s:%s:", node, 1000+s.counter, s.counter, start, taskType, id, TokenID),
+		node:     node,
+		taskType: taskType,
+		id:       id,
+		start:    start,
+		polls:    s.TaskPolls,
+		guest:    guest,
+		apply:    apply,
+		log:      []string{fmt.Sprintf("starting task %s %s", taskType, id)},
+	}
+	if len(s.taskFailures) > 0 {
+		t.failWith, s.taskFailures = s.taskFailures[0], s.taskFailures[1:]
+	}
+	s.tasks[t.upid] = t
+	if t.polls <= 0 {
+		s.finish(t)
+	}
+	return t.upid
+}
+
+// finish stops a task and applies its changes. The lock must be held.
+func (s *Server) finish(t *task) {
+	t.stopped = true
+	if t.failWith != "" {
+		t.exit = t.failWith
+		t.log = append(t.log, "TASK ERROR: "+t.failWith)
+		return
+	}
+	if t.apply != nil {
+		t.apply()
+	}
+	t.exit = "OK"
+	t.log = append(t.log, "TASK OK")
+}
+
+// guestLocked reports whether a running task holds the lock of a guest, or
+// the guest was locked with HoldLock. The lock must be held.
+func (s *Server) guestLocked(vmID int64) bool {
+	if s.locks[vmID] > 0 {
+		s.locks[vmID]--
+		return true
+	}
+	for _, t := range s.tasks {
+		if !t.stopped && t.guest == vmID {
+			return true
+		}
+	}
+	return false
+}
+
+// HoldLock makes the given number of requests changing a guest fail with a
+// lock timeout, as if the web interface or a backup held the lock of the
+// guest.
+func (s *Server) HoldLock(vmID int64, requests int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.locks[vmID] = requests
+}
+
+func (s *Server) registerTasks(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/tasks/{upid}/status", s.withTask(func(w http.ResponseWriter, r *http.Request, t *task) {
+		if !t.stopped {
+			t.polls--
+			if t.polls < 0 {
+				s.finish(t)
+			}
+		}
+
+		status := map[string]interface{}{
+			"upid":      t.upid,
+			"node":      t.node,
+			"type":      t.taskType,
+			"id":        t.id,
+			"user":      TokenID,
+			"starttime": t.start,
+			"status":    "running",
+		}
+		if t.stopped {
+			status["status"] = "stopped"
+			status["exitstatus"] = t.exit
+		}
+		writeData(w, status)
+	}))
+
+	mux.HandleFunc("GET "+apiPrefix+"/nodes/{node}/tasks/{upid}/log", s.withTask(func(w http.ResponseWriter, r *http.Request, t *task) {
+		lines := []map[string]interface{}{}
+		for i, line := range t.log {
+			lines = append(lines, map[string]interface{}{"n": i + 1, "t": line})
+		}
+		writeData(w, lines)
+	}))
+}
+
+// withTask calls handler with the task of the request, holding the lock.
+func (s *Server) withTask(handler func(w http.ResponseWriter, r *http.Request, t *task)) http.HandlerFunc {
+	return s.withNode(func(w http.ResponseWriter, r *http.Request, node string) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		t, ok := s.tasks[r.PathValue("upid")]
+		if !ok || t.node != node {
+			writeError(w, http.StatusInternalServerError, "no such task")
+			return
+		}
+		handler(w, r, t)
+	})
+}
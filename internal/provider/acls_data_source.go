@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ACLsDataSource{}
+
+func NewACLsDataSource() datasource.DataSource {
+	return &ACLsDataSource{}
+}
+
+// ACLsDataSource defines the data source implementation.
+type ACLsDataSource struct {
+	client *ProxmoxClient
+}
+
+// ACLsDataSourceModel describes the data source data model.
+type ACLsDataSourceModel struct {
+	ID   types.String    `tfsdk:"id"`
+	Path types.String    `tfsdk:"path"`
+	ACLs []ACLEntryModel `tfsdk:"acls"`
+}
+
+// ACLEntryModel describes an access control list entry.
+type ACLEntryModel struct {
+	Path      types.String `tfsdk:"path"`
+	RoleID    types.String `tfsdk:"role_id"`
+	Type      types.String `tfsdk:"type"`
+	UGID      types.String `tfsdk:"ugid"`
+	Propagate types.Bool   `tfsdk:"propagate"`
+}
+
+func (d *ACLsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acls"
+}
+
+func (d *ACLsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the access control list entries of the cluster, e.g. to audit permissions that are " +
+			"not managed by Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Only list the entries of this path (e.g., `/vms/100`)",
+				Optional:            true,
+			},
+			"acls": schema.ListNestedAttribute{
+				MarkdownDescription: "Access control list entries, sorted by path, type, user or group and role",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path the entry applies to",
+							Computed:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "Role granted by the entry",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the entry, `user`, `group` or `token`",
+							Computed:            true,
+						},
+						"ugid": schema.StringAttribute{
+							MarkdownDescription: "User, group or API token the role is granted to",
+							Computed:            true,
+						},
+						"propagate": schema.BoolAttribute{
+							MarkdownDescription: "Whether the entry applies to the paths below `path` as well",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ACLsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ACLsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ACLsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox access control list")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/access/acl", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access control list, got error: %s", err))
+		return
+	}
+
+	acls := []ACLEntryModel{}
+	for _, entry := range entries {
+		acl := ACLEntryModel{
+			Path:      stringValue(entry, "path"),
+			RoleID:    stringValue(entry, "roleid"),
+			Type:      stringValue(entry, "type"),
+			UGID:      stringValue(entry, "ugid"),
+			Propagate: types.BoolValue(boolValue(entry, "propagate").ValueBool()),
+		}
+		if data.Path.IsNull() || data.Path.Equal(acl.Path) {
+			acls = append(acls, acl)
+		}
+	}
+	sort.Slice(acls, func(i, j int) bool {
+		a, b := acls[i], acls[j]
+		for _, pair := range [][2]string{
+			{a.Path.ValueString(), b.Path.ValueString()},
+			{a.Type.ValueString(), b.Type.ValueString()},
+			{a.UGID.ValueString(), b.UGID.ValueString()},
+		} {
+			if pair[0] != pair[1] {
+				return pair[0] < pair[1]
+			}
+		}
+		return a.RoleID.ValueString() < b.RoleID.ValueString()
+	})
+
+	data.ACLs = acls
+	data.ID = types.StringValue("acls")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d access control list entries", len(acls)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
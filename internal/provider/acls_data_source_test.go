@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccACLsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_acls" "test" {}
+
+data "proxmox_acls" "root" {
+  path = "/"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_acls.test", "id", "acls"),
+					resource.TestCheckResourceAttrSet("data.proxmox_acls.test", "acls.#"),
+					resource.TestCheckResourceAttrSet("data.proxmox_acls.root", "acls.#"),
+				),
+			},
+		},
+	})
+}
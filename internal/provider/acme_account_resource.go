@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// acmeDefaultDirectory is the directory Proxmox VE registers accounts with by
+// default, the Let's Encrypt production environment.
+const acmeDefaultDirectory = "https://acme-v02.api.letsencrypt.org/directory"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ACMEAccountResource{}
+var _ resource.ResourceWithImportState = &ACMEAccountResource{}
+var _ resource.ResourceWithValidateConfig = &ACMEAccountResource{}
+
+func NewACMEAccountResource() resource.Resource {
+	return &ACMEAccountResource{}
+}
+
+// ACMEAccountResource defines the resource implementation.
+type ACMEAccountResource struct {
+	client *ProxmoxClient
+}
+
+// ACMEAccountResourceModel describes the resource data model.
+type ACMEAccountResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Contact    types.String `tfsdk:"contact"`
+	Directory  types.String `tfsdk:"directory"`
+	AcceptTOS  types.Bool   `tfsdk:"accept_tos"`
+	TOSURL     types.String `tfsdk:"tos_url"`
+	EABKID     types.String `tfsdk:"eab_kid"`
+	EABHMACKey types.String `tfsdk:"eab_hmac_key"`
+	Location   types.String `tfsdk:"location"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (r *ACMEAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_account"
+}
+
+func (r *ACMEAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers an ACME account used by Proxmox VE to order certificates. Destroying the " +
+			"resource deactivates the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the account name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the account",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact": schema.StringAttribute{
+				MarkdownDescription: "Contact email address of the account",
+				Required:            true,
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "URL of the ACME directory. Defaults to the Let's Encrypt production directory",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(acmeDefaultDirectory),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accept_tos": schema.BoolAttribute{
+				MarkdownDescription: "Accept the terms of service of the ACME directory, which most directories require",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"tos_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the accepted terms of service",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"eab_kid": schema.StringAttribute{
+				MarkdownDescription: "Key identifier for External Account Binding, required by some ACME directories. " +
+					"Only used to register the account",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"eab_hmac_key": schema.StringAttribute{
+				MarkdownDescription: "Base64url encoded HMAC key for External Account Binding. Only used to register " +
+					"the account",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "URL of the account at the ACME directory",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the account reported by the ACME directory",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ACMEAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ACMEAccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ACMEAccountResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.EABKID.IsUnknown() || data.EABHMACKey.IsUnknown() {
+		return
+	}
+
+	if data.EABKID.IsNull() != data.EABHMACKey.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			"The eab_kid and eab_hmac_key attributes must be set together.",
+		)
+	}
+}
+
+func (r *ACMEAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ACMEAccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var eabKID, eabHMACKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("eab_kid"), &eabKID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("eab_hmac_key"), &eabHMACKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := apiParams{}
+	params.setString("name", data.Name)
+	params.setString("contact", data.Contact)
+	params.setString("directory", data.Directory)
+	params.setString("eab-kid", eabKID)
+	params.setString("eab-hmac-key", eabHMACKey)
+
+	data.TOSURL = types.StringNull()
+	if data.AcceptTOS.ValueBool() {
+		var meta struct {
+			TermsOfService string `json:"termsOfService"`
+		}
+		err := r.client.Get(ctx, "/cluster/acme/meta?directory="+url.QueryEscape(data.Directory.ValueString()), &meta)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read terms of service of ACME directory %s, got error: %s", data.Directory.ValueString(), err))
+			return
+		}
+		if meta.TermsOfService != "" {
+			params["tos_url"] = meta.TermsOfService
+			data.TOSURL = types.StringValue(meta.TermsOfService)
+		}
+	}
+
+	var upid string
+	err := r.client.Post(ctx, "/cluster/acme/account", params, &upid)
+	if err == nil {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to register ACME account %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Name
+
+	account, err := r.account(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACME account %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+	data.read(account)
+
+	tflog.Trace(ctx, "registered ACME account", map[string]interface{}{"name": data.Name.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMEAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ACMEAccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	account, err := r.account(ctx, data.ID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACME account %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Name = data.ID
+	data.read(account)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMEAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ACMEAccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := apiParams{}
+	params.setString("contact", data.Contact)
+
+	var upid string
+	err := r.client.Put(ctx, r.path(data.Name.ValueString()), params, &upid)
+	if err == nil {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ACME account %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMEAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ACMEAccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var upid string
+	err := r.client.Delete(ctx, r.path(data.Name.ValueString()), &upid)
+	if err == nil {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate ACME account %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+}
+
+func (r *ACMEAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ACMEAccountResource) path(name string) string {
+	return "/cluster/acme/account/" + url.PathEscape(name)
+}
+
+func (r *ACMEAccountResource) account(ctx context.Context, name string) (map[string]interface{}, error) {
+	var account map[string]interface{}
+	err := r.client.Get(ctx, r.path(name), &account)
+	return account, err
+}
+
+// read updates the model from an account returned by the API. The account
+// details reported by the directory are nested in the "account" member.
+func (m *ACMEAccountResourceModel) read(account map[string]interface{}) {
+	m.Directory = stringValue(account, "directory")
+	m.Location = stringValue(account, "location")
+	if tos := stringValue(account, "tos"); !tos.IsNull() {
+		m.TOSURL = tos
+	}
+
+	details, _ := account["account"].(map[string]interface{})
+	m.Status = stringValue(details, "status")
+	if contacts, ok := details["contact"].([]interface{}); ok && len(contacts) > 0 {
+		if contact, ok := contacts[0].(string); ok {
+			m.Contact = types.StringValue(strings.TrimPrefix(contact, "mailto:"))
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccACMEAccountResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccACMEAccountResourceConfig("tfacc@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_acme_account.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_acme_account.test", "status", "valid"),
+					resource.TestCheckResourceAttrSet("proxmox_acme_account.test", "tos_url"),
+					resource.TestCheckResourceAttrSet("proxmox_acme_account.test", "location"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_acme_account.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"accept_tos"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccACMEAccountResourceConfig("tfacc-updated@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_acme_account.test", "contact", "tfacc-updated@example.com"),
+				),
+			},
+		},
+	})
+}
+
+// The account is registered with the Let's Encrypt staging environment.
+func testAccACMEAccountResourceConfig(contact string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_acme_account" "test" {
+  name       = "tfacc"
+  contact    = %[1]q
+  directory  = "https://acme-staging-v02.api.letsencrypt.org/directory"
+  accept_tos = true
+}
+`, contact)
+}
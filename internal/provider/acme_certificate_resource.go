@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// acmeMaxDomains is the number of acmedomainN options of the node
+// configuration.
+const acmeMaxDomains = 5
+
+// acmeCertificateTimeouts are the default timeouts of ordering and revoking
+// certificates, which include the validation of all domains and restarting
+// pveproxy.
+var acmeCertificateTimeouts = operationTimeouts{Create: 10 * time.Minute, Update: 10 * time.Minute, Delete: 5 * time.Minute}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ACMECertificateResource{}
+var _ resource.ResourceWithImportState = &ACMECertificateResource{}
+var _ resource.ResourceWithModifyPlan = &ACMECertificateResource{}
+var _ resource.ResourceWithValidateConfig = &ACMECertificateResource{}
+
+func NewACMECertificateResource() resource.Resource {
+	return &ACMECertificateResource{}
+}
+
+// ACMECertificateResource defines the resource implementation.
+type ACMECertificateResource struct {
+	client *ProxmoxClient
+}
+
+// ACMECertificateResourceModel describes the resource data model.
+type ACMECertificateResourceModel struct {
+	ID              types.String      `tfsdk:"id"`
+	Node            types.String      `tfsdk:"node"`
+	Account         types.String      `tfsdk:"account"`
+	Domains         []ACMEDomainModel `tfsdk:"domain"`
+	RenewBeforeDays types.Int64       `tfsdk:"renew_before_days"`
+	Fingerprint     types.String      `tfsdk:"fingerprint"`
+	NotAfter        types.Int64       `tfsdk:"not_after"`
+	Timeouts        timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// ACMEDomainModel describes a domain of the certificate.
+type ACMEDomainModel struct {
+	Domain types.String `tfsdk:"domain"`
+	Plugin types.String `tfsdk:"plugin"`
+	Alias  types.String `tfsdk:"alias"`
+}
+
+func (r *ACMECertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_certificate"
+}
+
+func (r *ACMECertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Configures the ACME domains of a Proxmox VE node and orders a certificate for them. The " +
+			"certificate is renewed on apply once it expires within `renew_before_days`. Destroying the resource " +
+			"revokes the certificate and removes the ACME domains from the node.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account": schema.StringAttribute{
+				MarkdownDescription: "ACME account the certificate is ordered with. Defaults to `default`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("default"),
+			},
+			"domain": schema.ListNestedAttribute{
+				MarkdownDescription: fmt.Sprintf("Domains of the certificate, at most %d", acmeMaxDomains),
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain name",
+							Required:            true,
+							Validators:          []validator.String{validators.DNSName()},
+						},
+						"plugin": schema.StringAttribute{
+							MarkdownDescription: "DNS plugin validating the domain. Without plugin, the HTTP challenge " +
+								"is used, which requires port 80 of the node to be reachable",
+							Optional:   true,
+							Validators: []validator.String{validators.PropertyValue()},
+						},
+						"alias": schema.StringAttribute{
+							MarkdownDescription: "Domain the DNS challenge is delegated to",
+							Optional:            true,
+							Validators:          []validator.String{validators.DNSName()},
+						},
+					},
+				},
+			},
+			"renew_before_days": schema.Int64Attribute{
+				MarkdownDescription: "Renew the certificate when it expires within this many days. Defaults to `30`",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 fingerprint of the certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.Int64Attribute{
+				MarkdownDescription: "Expiry of the certificate as Unix timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, acmeCertificateTimeouts.opts()),
+		},
+	}
+}
+
+func (r *ACMECertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ACMECertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ACMECertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Domains) == 0 || len(data.Domains) > acmeMaxDomains {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Between 1 and %d domains must be configured, got %d.", acmeMaxDomains, len(data.Domains)),
+		)
+	}
+}
+
+func (r *ACMECertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ACMECertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || state.NotAfter.IsNull() || plan.RenewBeforeDays.IsUnknown() {
+		return
+	}
+
+	// Changed domains as well as a certificate about to expire require a new
+	// order, which is planned as an update of the computed attributes.
+	renewAt := time.Unix(state.NotAfter.ValueInt64(), 0).AddDate(0, 0, -int(plan.RenewBeforeDays.ValueInt64()))
+	if time.Now().After(renewAt) || !plan.Account.Equal(state.Account) || !acmeDomainsEqual(plan.Domains, state.Domains) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("not_after"), types.Int64Unknown())...)
+	}
+}
+
+func (r *ACMECertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ACMECertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, acmeCertificateTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.configure(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure ACME domains of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	info, err := r.order(ctx, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to order certificate for node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Node
+	data.Fingerprint = stringValue(info, "fingerprint")
+	data.NotAfter = int64Value(info, "notafter")
+
+	tflog.Trace(ctx, "ordered ACME certificate", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMECertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ACMECertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	node := data.ID.ValueString()
+
+	var config map[string]interface{}
+	err := r.client.Get(ctx, nodeConfigPath(node), &config)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration of node %s, got error: %s", node, err))
+		return
+	}
+
+	info, err := nodeCustomCertificate(ctx, r.client, node)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificate of node %s, got error: %s", node, err))
+		return
+	}
+	if info == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Node = data.ID
+	data.Account = propertyStringValue(parsePropertyString(stringValue(config, "acme").ValueString(), "domains"), "account")
+	if data.Account.IsNull() {
+		data.Account = types.StringValue("default")
+	}
+	data.Domains = nil
+	for i := 0; i < acmeMaxDomains; i++ {
+		value := stringValue(config, fmt.Sprintf("acmedomain%d", i))
+		if value.IsNull() {
+			continue
+		}
+		props := parsePropertyString(value.ValueString(), "domain")
+		data.Domains = append(data.Domains, ACMEDomainModel{
+			Domain: propertyStringValue(props, "domain"),
+			Plugin: propertyStringValue(props, "plugin"),
+			Alias:  propertyStringValue(props, "alias"),
+		})
+	}
+	if data.RenewBeforeDays.IsNull() {
+		data.RenewBeforeDays = types.Int64Value(30)
+	}
+	data.Fingerprint = stringValue(info, "fingerprint")
+	data.NotAfter = int64Value(info, "notafter")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMECertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ACMECertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, acmeCertificateTimeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if err := r.configure(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure ACME domains of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// A changed renewal window alone does not require a new order.
+	if !data.Fingerprint.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	info, err := r.order(ctx, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to order certificate for node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.Fingerprint = stringValue(info, "fingerprint")
+	data.NotAfter = int64Value(info, "notafter")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMECertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ACMECertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, acmeCertificateTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	node := data.Node.ValueString()
+
+	var upid string
+	err := r.client.Delete(ctx, r.path(node), &upid)
+	if err == nil {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err == nil {
+		_, err = waitForProxyRestart(ctx, r.client, node, "")
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke certificate of node %s, got error: %s", node, err))
+		return
+	}
+
+	params := apiParams{}
+	params.remove("acme")
+	for i := 0; i < acmeMaxDomains; i++ {
+		params.remove(fmt.Sprintf("acmedomain%d", i))
+	}
+	err = r.client.Put(ctx, nodeConfigPath(node), params, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove ACME domains of node %s, got error: %s", node, err))
+		return
+	}
+}
+
+func (r *ACMECertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ACMECertificateResource) path(node string) string {
+	return "/nodes/" + url.PathEscape(node) + "/certificates/acme/certificate"
+}
+
+// configure writes the ACME account and domains to the node configuration,
+// removing domains that are no longer configured.
+func (r *ACMECertificateResource) configure(ctx context.Context, data ACMECertificateResourceModel) error {
+	var acme propertyString
+	acme.addString("account", data.Account)
+
+	params := apiParams{}
+	params["acme"] = acme.String()
+	for i := 0; i < acmeMaxDomains; i++ {
+		key := fmt.Sprintf("acmedomain%d", i)
+		if i >= len(data.Domains) {
+			params.remove(key)
+			continue
+		}
+
+		var domain propertyString
+		domain.addString("domain", data.Domains[i].Domain)
+		domain.addString("plugin", data.Domains[i].Plugin)
+		domain.addString("alias", data.Domains[i].Alias)
+		params[key] = domain.String()
+	}
+
+	return r.client.Put(ctx, nodeConfigPath(data.Node.ValueString()), params, nil)
+}
+
+// order orders a new certificate, replacing the current one, and returns the
+// information about it once pveproxy has loaded it.
+func (r *ACMECertificateResource) order(ctx context.Context, node string) (map[string]interface{}, error) {
+	var upid string
+	if err := r.client.Post(ctx, r.path(node), map[string]interface{}{"force": 1}, &upid); err != nil {
+		return nil, err
+	}
+	if err := r.client.waitForTask(ctx, upid); err != nil {
+		return nil, err
+	}
+
+	info, err := waitForProxyRestart(ctx, r.client, node, "")
+	if err == nil && info == nil {
+		err = fmt.Errorf("node %s does not report the ordered certificate", node)
+	}
+	return info, err
+}
+
+// acmeDomainsEqual reports whether two domain lists are identical.
+func acmeDomainsEqual(a, b []ACMEDomainModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Domain.Equal(b[i].Domain) || !a[i].Plugin.Equal(b[i].Plugin) || !a[i].Alias.Equal(b[i].Alias) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccACMECertificateResource(t *testing.T) {
+	// Ordering a certificate requires a domain of the node that the ACME
+	// directory can validate, and an account registered with it.
+	domain := testAccRequireEnv(t, "PROXMOX_ACME_DOMAIN")
+	account := testAccRequireEnv(t, "PROXMOX_ACME_ACCOUNT")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccACMECertificateResourceConfig(account, domain, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_acme_certificate.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_acme_certificate.test", "domain.0.domain", domain),
+					resource.TestCheckResourceAttrSet("proxmox_acme_certificate.test", "fingerprint"),
+					resource.TestCheckResourceAttrSet("proxmox_acme_certificate.test", "not_after"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_acme_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccACMECertificateResourceConfig(account, domain, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_acme_certificate.test", "renew_before_days", "14"),
+				),
+			},
+		},
+	})
+}
+
+func testAccACMECertificateResourceConfig(account, domain string, renewBeforeDays int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_acme_certificate" "test" {
+  node              = %[1]q
+  account           = %[2]q
+  renew_before_days = %[4]d
+
+  domain = [{
+    domain = %[3]q
+  }]
+}
+`, testNode(), account, domain, renewBeforeDays)
+}
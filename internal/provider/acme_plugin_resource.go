@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ACMEPluginResource{}
+var _ resource.ResourceWithImportState = &ACMEPluginResource{}
+
+func NewACMEPluginResource() resource.Resource {
+	return &ACMEPluginResource{}
+}
+
+// ACMEPluginResource defines the resource implementation.
+type ACMEPluginResource struct {
+	client *ProxmoxClient
+}
+
+// ACMEPluginResourceModel describes the resource data model.
+type ACMEPluginResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Plugin          types.String `tfsdk:"plugin"`
+	API             types.String `tfsdk:"api"`
+	Data            types.Map    `tfsdk:"data"`
+	ValidationDelay types.Int64  `tfsdk:"validation_delay"`
+	Disable         types.Bool   `tfsdk:"disable"`
+	Nodes           types.Set    `tfsdk:"nodes"`
+}
+
+func (r *ACMEPluginResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_plugin"
+}
+
+func (r *ACMEPluginResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an ACME DNS plugin used to solve DNS-01 challenges when ordering certificates.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the plugin ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plugin": schema.StringAttribute{
+				MarkdownDescription: "ID of the plugin",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api": schema.StringAttribute{
+				MarkdownDescription: "DNS API of the plugin as named by acme.sh (e.g., `cf` for Cloudflare or `aws` for Route 53)",
+				Required:            true,
+			},
+			"data": schema.MapAttribute{
+				MarkdownDescription: "Credentials and settings of the DNS API, passed to acme.sh as environment variables " +
+					"(e.g., `CF_Token`)",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"validation_delay": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait before requesting validation, allowing DNS records to propagate. " +
+					"Defaults to `30`",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(30),
+			},
+			"disable": schema.BoolAttribute{
+				MarkdownDescription: "Disable the plugin. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"nodes": schema.SetAttribute{
+				MarkdownDescription: "Nodes the plugin is used on. Defaults to all nodes",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ACMEPluginResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ACMEPluginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ACMEPluginResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("id", data.Plugin)
+	params["type"] = "dns"
+
+	if err := r.client.Post(ctx, "/cluster/acme/plugins", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACME plugin %s, got error: %s", data.Plugin.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Plugin
+
+	tflog.Trace(ctx, "created ACME plugin", map[string]interface{}{"plugin": data.Plugin.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMEPluginResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ACMEPluginResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var plugin map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &plugin)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACME plugin %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Plugin = data.ID
+	data.API = stringValue(plugin, "api")
+	data.Data = acmePluginDataValue(stringValue(plugin, "data").ValueString())
+	data.ValidationDelay = int64Value(plugin, "validation-delay")
+	if data.ValidationDelay.IsNull() {
+		data.ValidationDelay = types.Int64Value(30)
+	}
+	data.Disable = boolValue(plugin, "disable")
+	if data.Disable.IsNull() {
+		data.Disable = types.BoolValue(false)
+	}
+	data.Nodes = stringSetValue(plugin, "nodes")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMEPluginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ACMEPluginResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.Plugin.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ACME plugin %s, got error: %s", data.Plugin.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMEPluginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ACMEPluginResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.Plugin.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACME plugin %s, got error: %s", data.Plugin.ValueString(), err))
+		return
+	}
+}
+
+func (r *ACMEPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ACMEPluginResource) path(plugin string) string {
+	return "/cluster/acme/plugins/" + url.PathEscape(plugin)
+}
+
+// params returns the API parameters of the plugin. When update is set, unset
+// optional fields are removed from the existing plugin.
+func (m ACMEPluginResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("api", m.API)
+	params.setInt64("validation-delay", m.ValidationDelay)
+	params.setBool("disable", m.Disable)
+
+	setString, setStringSet := params.setString, params.setStringSet
+	if update {
+		setString, setStringSet = params.updateString, params.updateStringSet
+	}
+	setString("data", acmePluginData(m.Data))
+	setStringSet("nodes", m.Nodes)
+
+	return params
+}
+
+// acmePluginData encodes the plugin data as expected by the API, base64
+// encoded KEY=value lines sorted by key.
+func acmePluginData(data types.Map) types.String {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return types.StringNull()
+	}
+
+	var lines []string
+	for key, elem := range data.Elements() {
+		if value, ok := elem.(types.String); ok {
+			lines = append(lines, key+"="+value.ValueString())
+		}
+	}
+	sort.Strings(lines)
+	return types.StringValue(base64.StdEncoding.EncodeToString([]byte(strings.Join(lines, "\n") + "\n")))
+}
+
+// acmePluginDataValue decodes the plugin data returned by the API. Empty
+// data is returned as a null map.
+func acmePluginDataValue(s string) types.Map {
+	if decoded, err := base64.StdEncoding.DecodeString(s); err == nil {
+		s = string(decoded)
+	}
+
+	elems := map[string]attr.Value{}
+	for _, line := range strings.Split(s, "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok && key != "" {
+			elems[key] = types.StringValue(value)
+		}
+	}
+	if len(elems) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccACMEPluginResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccACMEPluginResourceConfig("token-1", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_acme_plugin.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_acme_plugin.test", "data.CF_Token", "token-1"),
+					resource.TestCheckResourceAttr("proxmox_acme_plugin.test", "validation_delay", "30"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_acme_plugin.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccACMEPluginResourceConfig("token-2", 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_acme_plugin.test", "data.CF_Token", "token-2"),
+					resource.TestCheckResourceAttr("proxmox_acme_plugin.test", "validation_delay", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccACMEPluginResourceConfig(token string, delay int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_acme_plugin" "test" {
+  plugin           = "tfacc"
+  api              = "cf"
+  validation_delay = %[2]d
+
+  data = {
+    CF_Token      = %[1]q
+    CF_Account_ID = "0123456789abcdef"
+  }
+}
+`, token, delay)
+}
+
+func TestACMEPluginData(t *testing.T) {
+	data := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"CF_Token":      types.StringValue("secret=value"),
+		"CF_Account_ID": types.StringValue("1234"),
+	})
+
+	encoded := acmePluginData(data)
+	if got := acmePluginDataValue(encoded.ValueString()); !got.Equal(data) {
+		t.Errorf("got %s, want %s", got, data)
+	}
+	if got := acmePluginDataValue(""); !got.IsNull() {
+		t.Errorf("expected null map, got %s", got)
+	}
+}
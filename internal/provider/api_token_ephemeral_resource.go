@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiTokenDefaultLifetime is the lifetime of ephemeral API tokens without a
+// configured lifetime.
+const apiTokenDefaultLifetime = time.Hour
+
+// apiTokenPrivateKey is the private data key holding the token to delete when
+// the ephemeral resource is closed.
+const apiTokenPrivateKey = "token"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &APITokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &APITokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &APITokenEphemeralResource{}
+
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &APITokenEphemeralResource{}
+}
+
+// APITokenEphemeralResource defines the ephemeral resource implementation.
+type APITokenEphemeralResource struct {
+	client *ProxmoxClient
+}
+
+// APITokenEphemeralResourceModel describes the ephemeral resource data model.
+type APITokenEphemeralResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	UserID              types.String `tfsdk:"user_id"`
+	TokenID             types.String `tfsdk:"token_id"`
+	Comment             types.String `tfsdk:"comment"`
+	PrivilegeSeparation types.Bool   `tfsdk:"privilege_separation"`
+	Lifetime            types.String `tfsdk:"lifetime"`
+	Expire              types.Int64  `tfsdk:"expire"`
+	Value               types.String `tfsdk:"value"`
+}
+
+// apiTokenPrivateData identifies the token created by the ephemeral
+// resource in its private data.
+type apiTokenPrivateData struct {
+	UserID  string `json:"user_id"`
+	TokenID string `json:"token_id"`
+}
+
+func (r *APITokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *APITokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived Proxmox VE API token whose secret is never stored in the Terraform " +
+			"plan or state, e.g. to configure another provider at apply time. The token is deleted when Terraform " +
+			"no longer needs it and expires after `lifetime` in case it cannot be deleted. Tokens managed by " +
+			"`proxmox_api_token` keep their secret in the state instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Full token identifier in the `user@realm!token` format",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user owning the token, in the `user@realm` format",
+				Required:            true,
+			},
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "Name of the token. Defaults to a random name starting with `terraform-`, so " +
+					"tokens opened concurrently do not collide",
+				Optional: true,
+				Computed: true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Token comment",
+				Optional:            true,
+			},
+			"privilege_separation": schema.BoolAttribute{
+				MarkdownDescription: "Restrict the token to the privileges granted to it through ACLs instead of " +
+					"inheriting all privileges of the user. Defaults to `true`",
+				Optional: true,
+				Computed: true,
+			},
+			"lifetime": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Duration after which the token expires (e.g., `30m`). Defaults to `%s`",
+					formatTimeout(apiTokenDefaultLifetime)),
+				Optional: true,
+			},
+			"expire": schema.Int64Attribute{
+				MarkdownDescription: "Expiration date of the token as a Unix timestamp",
+				Computed:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Token secret",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *APITokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APITokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data APITokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lifetime := apiTokenDefaultLifetime
+	if !data.Lifetime.IsNull() {
+		var err error
+		lifetime, err = parseTimeout(data.Lifetime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("lifetime"), "Invalid Attribute Value", err.Error())
+			return
+		}
+	}
+
+	if data.TokenID.IsNull() {
+		suffix := make([]byte, 4)
+		if _, err := rand.Read(suffix); err != nil {
+			resp.Diagnostics.AddError("Unable to Generate Token Name", err.Error())
+			return
+		}
+		data.TokenID = types.StringValue("terraform-" + hex.EncodeToString(suffix))
+	}
+	if data.PrivilegeSeparation.IsNull() {
+		data.PrivilegeSeparation = types.BoolValue(true)
+	}
+	data.ID = types.StringValue(data.UserID.ValueString() + "!" + data.TokenID.ValueString())
+	data.Expire = types.Int64Value(time.Now().Add(lifetime).Unix())
+
+	params := apiParams{}
+	params.setString("comment", data.Comment)
+	params.setInt64("expire", data.Expire)
+	params.setBool("privsep", data.PrivilegeSeparation)
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	if err := r.client.Post(ctx, apiTokenPath(data.UserID.ValueString(), data.TokenID.ValueString()), params, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+	data.Value = types.StringValue(result.Value)
+
+	private, err := json.Marshal(apiTokenPrivateData{UserID: data.UserID.ValueString(), TokenID: data.TokenID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Store Token Identifier", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiTokenPrivateKey, private)...)
+
+	tflog.Trace(ctx, "created ephemeral API token", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *APITokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var token apiTokenPrivateData
+	if err := json.Unmarshal(private, &token); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Token Identifier", err.Error())
+		return
+	}
+
+	err := r.client.Delete(ctx, apiTokenPath(token.UserID, token.TokenID), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API token %s!%s, got error: %s", token.UserID, token.TokenID, err))
+		return
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccAPITokenEphemeralResource(t *testing.T) {
+	user := testAccRequireEnv(t, "PROXMOX_USER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		// The echo provider copies the ephemeral values into its state, so
+		// they can be checked.
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"proxmox": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPITokenEphemeralResourceConfig(user),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("id"),
+						knownvalue.StringRegexp(regexp.MustCompile("^"+regexp.QuoteMeta(user)+"!terraform-[0-9a-f]{8}$"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("privilege_separation"),
+						knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("value"),
+						knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+				},
+			},
+		},
+	})
+}
+
+func testAccAPITokenEphemeralResourceConfig(user string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+ephemeral "proxmox_api_token" "test" {
+  user_id  = %[1]q
+  comment  = "managed by terraform"
+  lifetime = "10m"
+}
+
+provider "echo" {
+  data = ephemeral.proxmox_api_token.test
+}
+
+resource "echo" "test" {}
+`, user)
+}
@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/pveapi"
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APITokenResource{}
+var _ resource.ResourceWithImportState = &APITokenResource{}
+var _ resource.ResourceWithModifyPlan = &APITokenResource{}
+
+func NewAPITokenResource() resource.Resource {
+	return &APITokenResource{}
+}
+
+// APITokenResource defines the resource implementation.
+type APITokenResource struct {
+	client *ProxmoxClient
+}
+
+// APITokenResourceModel describes the resource data model.
+type APITokenResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	UserID              types.String `tfsdk:"user_id"`
+	TokenID             types.String `tfsdk:"token_id"`
+	Comment             types.String `tfsdk:"comment"`
+	Expire              types.Int64  `tfsdk:"expire"`
+	PrivilegeSeparation types.Bool   `tfsdk:"privilege_separation"`
+	RotateWhen          types.Map    `tfsdk:"rotate_when"`
+	Value               types.String `tfsdk:"value"`
+}
+
+func (r *APITokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *APITokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Proxmox VE API token. The token secret is only returned by the API when the " +
+			"token is created, so it is regenerated whenever any value in `rotate_when` changes. Rotation recreates " +
+			"the token and grants its permissions again. The secret is " +
+			"stored in the state; tokens only needed during a Terraform run are better created with the " +
+			"`proxmox_api_token` ephemeral resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Full token identifier in the `user@realm!token` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user owning the token, in the `user@realm` format",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "Name of the token",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Token comment",
+				Optional:            true,
+			},
+			"expire": schema.Int64Attribute{
+				MarkdownDescription: "Expiration date as a Unix timestamp, `0` means the token never expires",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{validators.APIParameter("POST /access/users/{userid}/token/{tokenid}", "expire")},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"privilege_separation": schema.BoolAttribute{
+				MarkdownDescription: "Restrict the token to the privileges granted to it through ACLs instead of inheriting all privileges of the user",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_when": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, regenerates the token secret",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Token secret. It is not available for imported tokens until they are rotated",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APITokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APITokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state APITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotateWhen.Equal(state.RotateWhen) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), types.StringUnknown())...)
+	}
+}
+
+func (r *APITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.create(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(data.UserID.ValueString() + "!" + data.TokenID.ValueString())
+	data.Value = types.StringValue(value)
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created API token", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APITokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.read(ctx, &data)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state APITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if !data.RotateWhen.Equal(state.RotateWhen) {
+		// Proxmox has no way of regenerating the secret of an existing token,
+		// so rotation recreates it under the same name. Deleting the token
+		// drops its permissions, which are granted again afterwards.
+		acls, err := r.acls(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions of API token %s, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+
+		if err := r.client.Delete(ctx, r.path(data), nil); err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate API token %s, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+
+		value, err := r.create(ctx, data)
+		if err != nil {
+			// Terraform keeps the prior state on errors, but the next refresh
+			// finds the token gone and plans to create it again.
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate API token %s, the token was deleted but "+
+				"could not be created again and is created by the next apply, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+		data.Value = types.StringValue(value)
+
+		if err := r.grant(ctx, data, acls); err != nil {
+			// Keep the new secret, since the old one is no longer valid.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore permissions of rotated API token %s, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+
+		tflog.Info(ctx, "rotated API token secret", map[string]interface{}{"id": data.ID.ValueString()})
+	} else {
+		// The comment is always sent, so that removing it clears it.
+		params := pveapi.UpdateTokenRequest{
+			Comment: pveapi.Ptr(data.Comment.ValueString()),
+			Expire:  optionalInt64(data.Expire),
+			Privsep: optionalBool(data.PrivilegeSeparation),
+		}
+
+		if err := r.client.Put(ctx, r.path(data), params, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API token %s, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APITokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API token %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *APITokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, tokenID, ok := strings.Cut(req.ID, "!")
+	if !ok || userID == "" || tokenID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user@realm!token. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token_id"), tokenID)...)
+}
+
+func (r *APITokenResource) path(data APITokenResourceModel) string {
+	return apiTokenPath(data.UserID.ValueString(), data.TokenID.ValueString())
+}
+
+// apiTokenPath returns the API path of a token of a user.
+func apiTokenPath(userID, tokenID string) string {
+	return "/access/users/" + url.PathEscape(userID) + "/token/" + url.PathEscape(tokenID)
+}
+
+// create creates the token and returns its secret.
+func (r *APITokenResource) create(ctx context.Context, data APITokenResourceModel) (string, error) {
+	params := pveapi.CreateTokenRequest{
+		Comment: optionalString(data.Comment),
+		Expire:  optionalInt64(data.Expire),
+		Privsep: optionalBool(data.PrivilegeSeparation),
+	}
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	if err := r.client.Post(ctx, r.path(data), params, &result); err != nil {
+		return "", err
+	}
+
+	return result.Value, nil
+}
+
+// acls returns the access control list entries of the token.
+func (r *APITokenResource) acls(ctx context.Context, data APITokenResourceModel) ([]map[string]interface{}, error) {
+	var entries []map[string]interface{}
+	if err := r.client.Get(ctx, "/access/acl", &entries); err != nil {
+		return nil, err
+	}
+
+	var acls []map[string]interface{}
+	for _, entry := range entries {
+		if stringValue(entry, "type").ValueString() == "token" && stringValue(entry, "ugid").Equal(data.ID) {
+			acls = append(acls, entry)
+		}
+	}
+	return acls, nil
+}
+
+// grant grants the access control list entries to the token.
+func (r *APITokenResource) grant(ctx context.Context, data APITokenResourceModel, acls []map[string]interface{}) error {
+	for _, entry := range acls {
+		params := map[string]interface{}{
+			"path":      stringValue(entry, "path").ValueString(),
+			"roles":     stringValue(entry, "roleid").ValueString(),
+			"tokens":    data.ID.ValueString(),
+			"propagate": pveapi.Bool(boolValue(entry, "propagate").ValueBool()),
+		}
+		if err := r.client.Put(ctx, "/access/acl", params, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read refreshes the token settings in data from the API.
+func (r *APITokenResource) read(ctx context.Context, data *APITokenResourceModel) error {
+	var token map[string]interface{}
+	if err := r.client.Get(ctx, r.path(*data), &token); err != nil {
+		return err
+	}
+
+	if comment := stringValue(token, "comment"); comment.ValueString() != "" {
+		data.Comment = comment
+	} else if !data.Comment.IsNull() {
+		data.Comment = types.StringValue("")
+	}
+
+	data.Expire = int64Value(token, "expire")
+	if data.Expire.IsNull() {
+		data.Expire = types.Int64Value(0)
+	}
+
+	data.PrivilegeSeparation = boolValue(token, "privsep")
+	if data.PrivilegeSeparation.IsNull() {
+		data.PrivilegeSeparation = types.BoolValue(true)
+	}
+
+	return nil
+}
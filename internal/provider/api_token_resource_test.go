@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAPITokenResource(t *testing.T) {
+	user := testAccRequireEnv(t, "PROXMOX_USER")
+	var secret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAPITokenResourceConfig(user, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_api_token.test", "id", user+"!tfacc"),
+					resource.TestCheckResourceAttr("proxmox_api_token.test", "privilege_separation", "true"),
+					resource.TestCheckResourceAttrSet("proxmox_api_token.test", "value"),
+					testAccCaptureAttr("proxmox_api_token.test", "value", &secret),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_when", "value"},
+			},
+			// Rotation testing
+			{
+				Config: testAccAPITokenResourceConfig(user, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_api_token.test", "rotate_when.generation", "two"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["proxmox_api_token.test"].Primary.Attributes["value"] == secret {
+							return fmt.Errorf("token secret was not rotated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccCaptureAttr stores the value of a resource attribute in target so
+// later steps can compare against it.
+func testAccCaptureAttr(name, key string, target *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+		*target = rs.Primary.Attributes[key]
+		return nil
+	}
+}
+
+func testAccAPITokenResourceConfig(user, generation string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_api_token" "test" {
+  user_id  = %[1]q
+  token_id = "tfacc"
+  comment  = "managed by terraform"
+
+  rotate_when = {
+    generation = %[2]q
+  }
+}
+`, user, generation)
+}
+
+func TestAPITokenResourceLifecycle(t *testing.T) {
+	h := newTestHarness(t)
+
+	config := map[string]interface{}{
+		"user_id":     "root@pam",
+		"token_id":    "ci",
+		"comment":     "managed by terraform",
+		"rotate_when": map[string]interface{}{"generation": "one"},
+	}
+	token, err := h.create("proxmox_api_token", config)
+	if err != nil {
+		t.Fatalf("unable to create token: %s", err)
+	}
+	attributes := token.attributes()
+	secret := attributes["value"]
+	if attributes["id"] != "root@pam!ci" || secret == "" || attributes["privilege_separation"] != true {
+		t.Errorf("unexpected state after create: %v", attributes)
+	}
+
+	config["comment"] = "updated"
+	if err := h.update(token, config); err != nil {
+		t.Fatalf("unable to update token: %s", err)
+	}
+	if settings, _ := h.Fake.Token("root@pam", "ci"); settings["comment"] != "updated" {
+		t.Errorf("expected the comment to be updated, got %v", settings)
+	}
+	if token.attributes()["value"] != secret {
+		t.Error("expected the secret to be kept when the comment changes")
+	}
+
+	config["rotate_when"] = map[string]interface{}{"generation": "two"}
+	if err := h.update(token, config); err != nil {
+		t.Fatalf("unable to rotate token: %s", err)
+	}
+	if token.attributes()["value"] == secret {
+		t.Error("expected the secret to be rotated")
+	}
+
+	imported, err := h.importResource("proxmox_api_token", "root@pam!ci")
+	if err != nil {
+		t.Fatalf("unable to import token: %s", err)
+	}
+	if got := imported.attributes()["comment"]; got != "updated" {
+		t.Errorf("expected the imported comment to be %q, got %v", "updated", got)
+	}
+
+	if err := h.destroy(token); err != nil {
+		t.Fatalf("unable to destroy token: %s", err)
+	}
+	if _, ok := h.Fake.Token("root@pam", "ci"); ok {
+		t.Error("expected the token to be deleted")
+	}
+	if ok, err := h.refresh(imported); err != nil || ok {
+		t.Errorf("expected the deleted token to be removed from the state, got %t, %v", ok, err)
+	}
+}
+
+func TestAPITokenResourceRotationPermissions(t *testing.T) {
+	h := newTestHarness(t)
+
+	config := map[string]interface{}{
+		"user_id":     "root@pam",
+		"token_id":    "ci",
+		"rotate_when": map[string]interface{}{"generation": "one"},
+	}
+	token, err := h.create("proxmox_api_token", config)
+	if err != nil {
+		t.Fatalf("unable to create token: %s", err)
+	}
+	h.Fake.AddACL("/", "user", "root@pam", "Administrator")
+	h.Fake.AddACL("/vms", "token", "root@pam!ci", "PVEVMAdmin")
+	want := h.Fake.ACL()
+
+	config["rotate_when"] = map[string]interface{}{"generation": "two"}
+	if err := h.update(token, config); err != nil {
+		t.Fatalf("unable to rotate token: %s", err)
+	}
+	if got := h.Fake.ACL(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the permissions %v to be kept, got %v", want, got)
+	}
+
+	// A token that was deleted but could not be created again is planned
+	// to be created after the next refresh.
+	h.Fake.Fail("POST", "/access/users/root@pam/token/ci", 500, "user locked")
+	config["rotate_when"] = map[string]interface{}{"generation": "three"}
+	if err := h.update(token, config); err == nil || !strings.Contains(err.Error(), "could not be created again") {
+		t.Fatalf("expected the failed rotation to be reported, got %v", err)
+	}
+	if ok, err := h.refresh(token); err != nil || ok {
+		t.Errorf("expected the deleted token to be removed from the state, got %t, %v", ok, err)
+	}
+}
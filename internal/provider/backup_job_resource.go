@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupJobResource{}
+var _ resource.ResourceWithImportState = &BackupJobResource{}
+var _ resource.ResourceWithValidateConfig = &BackupJobResource{}
+
+func NewBackupJobResource() resource.Resource {
+	return &BackupJobResource{}
+}
+
+// BackupJobResource defines the resource implementation.
+type BackupJobResource struct {
+	client *ProxmoxClient
+}
+
+// BackupJobResourceModel describes the resource data model.
+type BackupJobResourceModel struct {
+	ID               types.String          `tfsdk:"id"`
+	JobID            types.String          `tfsdk:"job_id"`
+	Schedule         types.String          `tfsdk:"schedule"`
+	Enabled          types.Bool            `tfsdk:"enabled"`
+	Comment          types.String          `tfsdk:"comment"`
+	Node             types.String          `tfsdk:"node"`
+	VMIDs            types.Set             `tfsdk:"vm_ids"`
+	Pool             types.String          `tfsdk:"pool"`
+	All              types.Bool            `tfsdk:"all"`
+	Exclude          types.Set             `tfsdk:"exclude"`
+	Storage          types.String          `tfsdk:"storage"`
+	Mode             types.String          `tfsdk:"mode"`
+	Compress         types.String          `tfsdk:"compress"`
+	Retention        *BackupRetentionModel `tfsdk:"retention"`
+	NotificationMode types.String          `tfsdk:"notification_mode"`
+	MailTo           types.Set             `tfsdk:"mail_to"`
+	MailNotification types.String          `tfsdk:"mail_notification"`
+	NotesTemplate    types.String          `tfsdk:"notes_template"`
+}
+
+// BackupRetentionModel describes the prune-backups property string.
+type BackupRetentionModel struct {
+	KeepAll     types.Bool  `tfsdk:"keep_all"`
+	KeepLast    types.Int64 `tfsdk:"keep_last"`
+	KeepHourly  types.Int64 `tfsdk:"keep_hourly"`
+	KeepDaily   types.Int64 `tfsdk:"keep_daily"`
+	KeepWeekly  types.Int64 `tfsdk:"keep_weekly"`
+	KeepMonthly types.Int64 `tfsdk:"keep_monthly"`
+	KeepYearly  types.Int64 `tfsdk:"keep_yearly"`
+}
+
+func (r *BackupJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_job"
+}
+
+func (r *BackupJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a scheduled Proxmox VE backup job. The guests are selected either by ID, by pool " +
+			"or by backing up all guests, optionally excluding some of them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the job ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the backup job",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Backup schedule in the Proxmox VE calendar event format (e.g., `sun 01:00`)",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the job. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the job",
+				Optional:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Only run the job on this node",
+				Optional:            true,
+			},
+			"vm_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the guests to back up. Conflicts with `pool` and `all`",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Validators:          []validator.Set{validators.VMID()},
+			},
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "Back up all guests of this pool. Conflicts with `vm_ids` and `all`",
+				Optional:            true,
+			},
+			"all": schema.BoolAttribute{
+				MarkdownDescription: "Back up all guests. Conflicts with `vm_ids` and `pool`. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"exclude": schema.SetAttribute{
+				MarkdownDescription: "IDs of guests excluded from the backup. Only valid together with `all`",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage the backups are written to",
+				Optional:            true,
+				Validators:          []validator.String{validators.StorageID()},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Backup mode, one of `snapshot`, `suspend` or `stop`. Defaults to `snapshot`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("snapshot"),
+			},
+			"compress": schema.StringAttribute{
+				MarkdownDescription: "Compression algorithm, one of `0`, `1`, `gzip`, `lzo` or `zstd`",
+				Optional:            true,
+			},
+			"retention": schema.SingleNestedAttribute{
+				MarkdownDescription: "Retention settings overriding the ones of the storage",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"keep_all": schema.BoolAttribute{
+						MarkdownDescription: "Keep all backups. Conflicts with the other options",
+						Optional:            true,
+					},
+					"keep_last": schema.Int64Attribute{
+						MarkdownDescription: "Number of most recent backups to keep",
+						Optional:            true,
+					},
+					"keep_hourly": schema.Int64Attribute{
+						MarkdownDescription: "Number of hourly backups to keep",
+						Optional:            true,
+					},
+					"keep_daily": schema.Int64Attribute{
+						MarkdownDescription: "Number of daily backups to keep",
+						Optional:            true,
+					},
+					"keep_weekly": schema.Int64Attribute{
+						MarkdownDescription: "Number of weekly backups to keep",
+						Optional:            true,
+					},
+					"keep_monthly": schema.Int64Attribute{
+						MarkdownDescription: "Number of monthly backups to keep",
+						Optional:            true,
+					},
+					"keep_yearly": schema.Int64Attribute{
+						MarkdownDescription: "Number of yearly backups to keep",
+						Optional:            true,
+					},
+				},
+			},
+			"notification_mode": schema.StringAttribute{
+				MarkdownDescription: "How notifications are sent, one of `auto`, `legacy-sendmail` or `notification-system`",
+				Optional:            true,
+			},
+			"mail_to": schema.SetAttribute{
+				MarkdownDescription: "Email addresses notified by the `legacy-sendmail` notification mode",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"mail_notification": schema.StringAttribute{
+				MarkdownDescription: "When to send emails in the `legacy-sendmail` notification mode, one of `always` or `failure`",
+				Optional:            true,
+			},
+			"notes_template": schema.StringAttribute{
+				MarkdownDescription: "Template for the notes of the backups, supporting the `{{guestname}}`, `{{node}}`, " +
+					"`{{vmid}}` and `{{cluster}}` variables",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *BackupJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BackupJobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BackupJobResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.VMIDs.IsUnknown() || data.Pool.IsUnknown() || data.All.IsUnknown() {
+		return
+	}
+
+	selections := 0
+	if !data.VMIDs.IsNull() {
+		selections++
+	}
+	if !data.Pool.IsNull() {
+		selections++
+	}
+	if data.All.ValueBool() {
+		selections++
+	}
+	if selections != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			"Exactly one of vm_ids, pool or all = true must be set to select the guests of the backup job.",
+		)
+	}
+
+	if !data.Exclude.IsNull() && !data.All.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("exclude"), "Invalid Attribute Combination", "The exclude attribute is only valid when all is true.")
+	}
+
+	if data.Retention != nil && data.Retention.KeepAll.ValueBool() {
+		retention := data.Retention
+		for _, keep := range []types.Int64{retention.KeepLast, retention.KeepHourly, retention.KeepDaily, retention.KeepWeekly, retention.KeepMonthly, retention.KeepYearly} {
+			if !keep.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retention").AtName("keep_all"),
+					"Invalid Attribute Combination",
+					"The keep_all retention option conflicts with the other retention options.",
+				)
+				break
+			}
+		}
+	}
+}
+
+func (r *BackupJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("id", data.JobID)
+
+	if err := r.client.Post(ctx, "/cluster/backup", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+
+	data.ID = data.JobID
+
+	tflog.Trace(ctx, "created backup job", map[string]interface{}{"job_id": data.JobID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var job map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &job)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup job %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.JobID = data.ID
+	data.Schedule = stringValue(job, "schedule")
+	data.Enabled = boolValue(job, "enabled")
+	if data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(true)
+	}
+	data.Comment = stringValue(job, "comment")
+	data.Node = stringValue(job, "node")
+	data.VMIDs = int64SetValue(job, "vmid")
+	data.Pool = stringValue(job, "pool")
+	data.All = boolValue(job, "all")
+	if data.All.IsNull() {
+		data.All = types.BoolValue(false)
+	}
+	data.Exclude = int64SetValue(job, "exclude")
+	data.Storage = stringValue(job, "storage")
+	data.Mode = stringValue(job, "mode")
+	if data.Mode.IsNull() {
+		data.Mode = types.StringValue("snapshot")
+	}
+	data.Compress = stringValue(job, "compress")
+	data.Retention = newBackupRetentionModel(job)
+	data.NotificationMode = stringValue(job, "notification-mode")
+	data.MailTo = stringSetValue(job, "mailto")
+	data.MailNotification = stringValue(job, "mailnotification")
+	data.NotesTemplate = stringValue(job, "notes-template")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BackupJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.JobID.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update backup job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BackupJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.JobID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+}
+
+func (r *BackupJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *BackupJobResource) path(jobID string) string {
+	return "/cluster/backup/" + url.PathEscape(jobID)
+}
+
+// params returns the API parameters of the job. When update is set, unset
+// optional fields are removed from the existing job.
+func (m BackupJobResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("schedule", m.Schedule)
+	params.setBool("enabled", m.Enabled)
+	params.setBool("all", m.All)
+	params.setString("mode", m.Mode)
+
+	setString, setInt64Set, setStringSet := params.setString, params.setInt64Set, params.setStringSet
+	if update {
+		setString, setInt64Set, setStringSet = params.updateString, params.updateInt64Set, params.updateStringSet
+	}
+	setString("comment", m.Comment)
+	setString("node", m.Node)
+	setInt64Set("vmid", m.VMIDs)
+	setString("pool", m.Pool)
+	setInt64Set("exclude", m.Exclude)
+	setString("storage", m.Storage)
+	setString("compress", m.Compress)
+	setString("prune-backups", m.Retention.value())
+	setString("notification-mode", m.NotificationMode)
+	setStringSet("mailto", m.MailTo)
+	setString("mailnotification", m.MailNotification)
+	setString("notes-template", m.NotesTemplate)
+
+	return params
+}
+
+// value returns the property string representation of the settings.
+func (m *BackupRetentionModel) value() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	var props propertyString
+	props.addBool("keep-all", m.KeepAll)
+	props.addInt64("keep-last", m.KeepLast)
+	props.addInt64("keep-hourly", m.KeepHourly)
+	props.addInt64("keep-daily", m.KeepDaily)
+	props.addInt64("keep-weekly", m.KeepWeekly)
+	props.addInt64("keep-monthly", m.KeepMonthly)
+	props.addInt64("keep-yearly", m.KeepYearly)
+	return types.StringValue(props.String())
+}
+
+// newBackupRetentionModel parses the prune-backups option of a job,
+// returning nil if it is not set. Depending on the Proxmox VE version the
+// option is returned as property string or as object.
+func newBackupRetentionModel(job map[string]interface{}) *BackupRetentionModel {
+	props := propertyStringValues(job, "prune-backups", "keep-all")
+	if len(props) == 0 {
+		return nil
+	}
+
+	return &BackupRetentionModel{
+		KeepAll:     propertyBoolValue(props, "keep-all"),
+		KeepLast:    propertyInt64Value(props, "keep-last"),
+		KeepHourly:  propertyInt64Value(props, "keep-hourly"),
+		KeepDaily:   propertyInt64Value(props, "keep-daily"),
+		KeepWeekly:  propertyInt64Value(props, "keep-weekly"),
+		KeepMonthly: propertyInt64Value(props, "keep-monthly"),
+		KeepYearly:  propertyInt64Value(props, "keep-yearly"),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupJobResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig() + `
+resource "proxmox_backup_job" "test" {
+  job_id   = "tf-acc-test"
+  schedule = "sun 01:00"
+  all      = true
+  storage  = "local"
+  compress = "zstd"
+
+  retention = {
+    keep_last = 3
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "id", "tf-acc-test"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "mode", "snapshot"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "retention.keep_last", "3"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_backup_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig() + `
+resource "proxmox_backup_job" "test" {
+  job_id   = "tf-acc-test"
+  schedule = "sat 02:30"
+  enabled  = false
+  comment  = "Managed by Terraform"
+  all      = true
+  exclude  = [999]
+  storage  = "local"
+  mode     = "stop"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "schedule", "sat 02:30"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("proxmox_backup_job.test", "exclude.#", "1"),
+					resource.TestCheckNoResourceAttr("proxmox_backup_job.test", "retention"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BackupsDataSource{}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// BackupsDataSource defines the data source implementation.
+type BackupsDataSource struct {
+	client *ProxmoxClient
+}
+
+// BackupsDataSourceModel describes the data source data model.
+type BackupsDataSourceModel struct {
+	ID      types.String  `tfsdk:"id"`
+	Node    types.String  `tfsdk:"node"`
+	Storage types.String  `tfsdk:"storage"`
+	VMID    types.Int64   `tfsdk:"vm_id"`
+	Filters []FilterModel `tfsdk:"filter"`
+	Backups []BackupModel `tfsdk:"backups"`
+}
+
+// BackupModel describes a backup archive on a storage.
+type BackupModel struct {
+	VolumeID     types.String `tfsdk:"volume_id"`
+	VMID         types.Int64  `tfsdk:"vm_id"`
+	GuestType    types.String `tfsdk:"guest_type"`
+	Format       types.String `tfsdk:"format"`
+	Size         types.Int64  `tfsdk:"size"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+	Notes        types.String `tfsdk:"notes"`
+	Protected    types.Bool   `tfsdk:"protected"`
+	Verification types.String `tfsdk:"verification"`
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the backups on a storage, such as a Proxmox Backup Server datastore, optionally of " +
+			"a single guest. The newest backup comes first, so `backups[0]` can be restored to get the latest state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the storage is accessed from",
+				Required:            true,
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Name of the backup storage",
+				Required:            true,
+				Validators:          []validator.String{validators.StorageID()},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list the backups of this guest",
+				Optional:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "Backups, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"volume_id": schema.StringAttribute{
+							MarkdownDescription: "Volume ID of the backup archive",
+							Computed:            true,
+						},
+						"vm_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the backed up guest",
+							Computed:            true,
+						},
+						"guest_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the backed up guest, `qemu` or `lxc`",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "Format of the archive (e.g., `pbs-vm` or `tar.zst`)",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the archive in bytes",
+							Computed:            true,
+						},
+						"creation_time": schema.Int64Attribute{
+							MarkdownDescription: "Creation time as Unix timestamp",
+							Computed:            true,
+						},
+						"notes": schema.StringAttribute{
+							MarkdownDescription: "Notes of the backup",
+							Computed:            true,
+						},
+						"protected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the backup is protected from pruning and removal",
+							Computed:            true,
+						},
+						"verification": schema.StringAttribute{
+							MarkdownDescription: "Result of the last verification, `ok` or `failed`, null if the backup " +
+								"was not verified",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("backups"),
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, storage := data.Node.ValueString(), data.Storage.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox backups", map[string]interface{}{"node": node, "storage": storage})
+
+	entries, err := listBackups(ctx, d.client, node, storage, data.VMID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups on storage %s, got error: %s", storage, err))
+		return
+	}
+
+	backups := make([]BackupModel, len(entries))
+	for i, entry := range entries {
+		verification, _ := entry["verification"].(map[string]interface{})
+		backups[i] = BackupModel{
+			VolumeID:     stringValue(entry, "volid"),
+			VMID:         int64Value(entry, "vmid"),
+			GuestType:    stringValue(entry, "subtype"),
+			Format:       stringValue(entry, "format"),
+			Size:         int64Value(entry, "size"),
+			CreationTime: int64Value(entry, "ctime"),
+			Notes:        stringValue(entry, "notes"),
+			Protected:    types.BoolValue(boolValue(entry, "protected").ValueBool()),
+			Verification: stringValue(verification, "state"),
+		}
+	}
+	backups, diags := applyFilters(data.Filters, backups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].CreationTime.ValueInt64() > backups[j].CreationTime.ValueInt64()
+	})
+
+	data.Backups = backups
+	data.ID = types.StringValue(formatID(node, storage))
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d backups", len(backups)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupsDataSource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_guest_backup" "test" {
+  node              = %[1]q
+  vm_id             = %[2]s
+  storage           = "local"
+  delete_on_destroy = true
+}
+
+data "proxmox_backups" "test" {
+  node    = %[1]q
+  storage = "local"
+  vm_id   = proxmox_guest_backup.test.vm_id
+}
+`, testNode(), vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_backups.test", "id", testNode()+"/local"),
+					resource.TestCheckResourceAttr("data.proxmox_backups.test", "backups.0.vm_id", vmID),
+					resource.TestCheckResourceAttr("data.proxmox_backups.test", "backups.0.guest_type", "qemu"),
+					resource.TestMatchResourceAttr("data.proxmox_backups.test", "backups.0.volume_id", regexp.MustCompile(`^local:backup/vzdump-qemu-`)),
+					resource.TestCheckResourceAttrSet("data.proxmox_backups.test", "backups.0.creation_time"),
+				),
+			},
+		},
+	})
+}
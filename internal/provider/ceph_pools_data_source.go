@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CephPoolsDataSource{}
+
+func NewCephPoolsDataSource() datasource.DataSource {
+	return &CephPoolsDataSource{}
+}
+
+// CephPoolsDataSource defines the data source implementation.
+type CephPoolsDataSource struct {
+	client *ProxmoxClient
+}
+
+// CephPoolsDataSourceModel describes the data source data model.
+type CephPoolsDataSourceModel struct {
+	ID    types.String    `tfsdk:"id"`
+	Node  types.String    `tfsdk:"node"`
+	Pools []CephPoolModel `tfsdk:"pools"`
+}
+
+// CephPoolModel describes a Ceph pool.
+type CephPoolModel struct {
+	Name            types.String  `tfsdk:"name"`
+	Type            types.String  `tfsdk:"type"`
+	Size            types.Int64   `tfsdk:"size"`
+	MinSize         types.Int64   `tfsdk:"min_size"`
+	PGNum           types.Int64   `tfsdk:"pg_num"`
+	PGAutoscaleMode types.String  `tfsdk:"pg_autoscale_mode"`
+	CrushRule       types.String  `tfsdk:"crush_rule"`
+	Applications    []string      `tfsdk:"applications"`
+	BytesUsed       types.Int64   `tfsdk:"bytes_used"`
+	UsedRatio       types.Float64 `tfsdk:"used_ratio"`
+}
+
+func (d *CephPoolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_pools"
+}
+
+func (d *CephPoolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the pools of the hyper-converged Ceph cluster of Proxmox VE.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of a node running Ceph to query",
+				Required:            true,
+			},
+			"pools": schema.ListNestedAttribute{
+				MarkdownDescription: "Ceph pools",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the pool",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the pool, `replicated` or `erasure`",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Number of replicas per object",
+							Computed:            true,
+						},
+						"min_size": schema.Int64Attribute{
+							MarkdownDescription: "Minimum number of replicas per object to allow I/O",
+							Computed:            true,
+						},
+						"pg_num": schema.Int64Attribute{
+							MarkdownDescription: "Number of placement groups",
+							Computed:            true,
+						},
+						"pg_autoscale_mode": schema.StringAttribute{
+							MarkdownDescription: "Placement group autoscale mode, `on`, `off` or `warn`",
+							Computed:            true,
+						},
+						"crush_rule": schema.StringAttribute{
+							MarkdownDescription: "Name of the CRUSH rule of the pool",
+							Computed:            true,
+						},
+						"applications": schema.ListAttribute{
+							MarkdownDescription: "Applications enabled on the pool (e.g., `rbd` or `cephfs`)",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"bytes_used": schema.Int64Attribute{
+							MarkdownDescription: "Stored data in bytes, including replicas",
+							Computed:            true,
+						},
+						"used_ratio": schema.Float64Attribute{
+							MarkdownDescription: "Used fraction of the available capacity, between 0 and 1",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephPoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephPoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephPoolsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox Ceph pools", map[string]interface{}{"node": node})
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/ceph/pool", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ceph pools, got error: %s", err))
+		return
+	}
+
+	pools := make([]CephPoolModel, len(entries))
+	for i, entry := range entries {
+		applications := []string{}
+		if metadata, ok := entry["application_metadata"].(map[string]interface{}); ok {
+			for application := range metadata {
+				applications = append(applications, application)
+			}
+			sort.Strings(applications)
+		}
+		pools[i] = CephPoolModel{
+			Name:            stringValue(entry, "pool_name"),
+			Type:            stringValue(entry, "type"),
+			Size:            int64Value(entry, "size"),
+			MinSize:         int64Value(entry, "min_size"),
+			PGNum:           int64Value(entry, "pg_num"),
+			PGAutoscaleMode: stringValue(entry, "pg_autoscale_mode"),
+			CrushRule:       stringValue(entry, "crush_rule_name"),
+			Applications:    applications,
+			BytesUsed:       int64Value(entry, "bytes_used"),
+			UsedRatio:       float64Value(entry, "percent_used"),
+		}
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return strings.Compare(pools[i].Name.ValueString(), pools[j].Name.ValueString()) < 0
+	})
+
+	data.ID = types.StringValue("ceph_pools")
+	data.Pools = pools
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d Ceph pools", len(pools)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCephPoolsDataSource(t *testing.T) {
+	pool := testAccRequireEnv(t, "PROXMOX_CEPH_POOL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_ceph_pools" "test" {
+  node = %[1]q
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_ceph_pools.test", "id", "ceph_pools"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_ceph_pools.test", "pools.*", map[string]string{
+						"name": pool,
+						"type": "replicated",
+					}),
+				),
+			},
+		},
+	})
+}
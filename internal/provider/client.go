@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APIError is returned by the client helpers when the Proxmox API responds
+// with a non-successful status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("got status %d: %s", e.StatusCode, e.Body)
+}
+
+// isNotFound reports whether err indicates that the requested object does
+// not exist. Proxmox answers most lookups of missing objects with a 500 and
+// a "does not exist" message rather than a 404.
+func isNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	body := strings.ToLower(apiErr.Body)
+	return strings.Contains(body, "does not exist") || strings.Contains(body, "no such")
+}
+
+// Get performs a GET request and decodes the "data" member of the response
+// into out, which may be nil if the caller is not interested in the result.
+func (c *ProxmoxClient) Get(ctx context.Context, path string, out interface{}) error {
+	return c.do(ctx, http.MethodGet, path, nil, out)
+}
+
+// Post performs a POST request with the given parameters.
+func (c *ProxmoxClient) Post(ctx context.Context, path string, body, out interface{}) error {
+	return c.do(ctx, http.MethodPost, path, body, out)
+}
+
+// Put performs a PUT request with the given parameters.
+func (c *ProxmoxClient) Put(ctx context.Context, path string, body, out interface{}) error {
+	return c.do(ctx, http.MethodPut, path, body, out)
+}
+
+// Delete performs a DELETE request. Proxmox expects parameters of DELETE
+// calls in the query string, so they must already be part of path.
+func (c *ProxmoxClient) Delete(ctx context.Context, path string, out interface{}) error {
+	return c.do(ctx, http.MethodDelete, path, nil, out)
+}
+
+// GetResult performs a GET request and decodes the complete response into
+// out. It is needed for the few API calls that return result attributes next
+// to the "data" member.
+func (c *ProxmoxClient) GetResult(ctx context.Context, path string, out interface{}) error {
+	respBody, err := c.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("unable to parse response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ProxmoxClient) do(ctx context.Context, method, path string, body, out interface{}) error {
+	respBody, err := c.request(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+
+	return decodeData(respBody, out)
+}
+
+// decodeData decodes the "data" member of a response into out, which may be
+// nil if the caller is not interested in the result.
+func decodeData(respBody []byte, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return fmt.Errorf("unable to parse response: %w", err)
+	}
+	if len(envelope.Data) == 0 || string(envelope.Data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return fmt.Errorf("unable to parse response: %w", err)
+	}
+
+	return nil
+}
+
+// request performs a request and returns the body of a successful response.
+// Requests failing for temporary reasons are retried.
+func (c *ProxmoxClient) request(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	ctx = withAPIRequestID(ctx)
+	return c.withRetries(ctx, method, path, func() ([]byte, error) {
+		return c.send(ctx, method, path, body)
+	})
+}
+
+// send performs a single request and returns the body of a successful
+// response.
+func (c *ProxmoxClient) send(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	start := time.Now()
+	httpResp, err := c.DoRequestWithContext(ctx, method, path, body)
+	if method != http.MethodGet {
+		// Even failed requests may have changed something.
+		c.responses.invalidate()
+	}
+	if err != nil {
+		tflog.Debug(ctx, "API request failed", map[string]interface{}{"method": method, "path": path, "error": err.Error()})
+		return nil, err
+	}
+	tflog.Debug(ctx, "API request", map[string]interface{}{
+		"method":      method,
+		"path":        path,
+		"status":      httpResp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return nil, &APIError{StatusCode: httpResp.StatusCode, Body: string(respBody)}
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterJoinInfoDataSource{}
+
+func NewClusterJoinInfoDataSource() datasource.DataSource {
+	return &ClusterJoinInfoDataSource{}
+}
+
+// ClusterJoinInfoDataSource defines the data source implementation.
+type ClusterJoinInfoDataSource struct {
+	client *ProxmoxClient
+}
+
+// ClusterJoinInfoDataSourceModel describes the data source data model.
+type ClusterJoinInfoDataSourceModel struct {
+	ID           types.String           `tfsdk:"id"`
+	Node         types.String           `tfsdk:"node"`
+	Address      types.String           `tfsdk:"address"`
+	Fingerprint  types.String           `tfsdk:"fingerprint"`
+	ClusterName  types.String           `tfsdk:"cluster_name"`
+	ConfigDigest types.String           `tfsdk:"config_digest"`
+	Totem        map[string]string      `tfsdk:"totem"`
+	Nodes        []ClusterJoinNodeModel `tfsdk:"nodes"`
+}
+
+// ClusterJoinNodeModel describes a cluster member as listed in the join
+// information.
+type ClusterJoinNodeModel struct {
+	Name        types.String `tfsdk:"name"`
+	NodeID      types.Int64  `tfsdk:"node_id"`
+	Address     types.String `tfsdk:"address"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Votes       types.Int64  `tfsdk:"votes"`
+	Links       []string     `tfsdk:"links"`
+}
+
+// clusterMaxLinks is the number of corosync links supported by Proxmox VE.
+const clusterMaxLinks = 8
+
+func (d *ClusterJoinInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_join_info"
+}
+
+func (d *ClusterJoinInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the information needed to join a node to the Proxmox VE cluster, e.g. for " +
+			"`proxmox_cluster_join`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Cluster node the joining node should connect to. Defaults to the node the " +
+					"provider is connected to",
+				Optional: true,
+				Computed: true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "API address of `node`",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 fingerprint of the API certificate of `node`",
+				Computed:            true,
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cluster",
+				Computed:            true,
+			},
+			"config_digest": schema.StringAttribute{
+				MarkdownDescription: "Digest of the corosync configuration",
+				Computed:            true,
+			},
+			"totem": schema.MapAttribute{
+				MarkdownDescription: "Totem settings of the corosync configuration. Nested settings, such as " +
+					"`interface`, are JSON encoded",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Cluster members, ordered by node ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node",
+							Computed:            true,
+						},
+						"node_id": schema.Int64Attribute{
+							MarkdownDescription: "Corosync node ID",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "API address of the node",
+							Computed:            true,
+						},
+						"fingerprint": schema.StringAttribute{
+							MarkdownDescription: "SHA-256 fingerprint of the API certificate of the node",
+							Computed:            true,
+						},
+						"votes": schema.Int64Attribute{
+							MarkdownDescription: "Quorum votes of the node",
+							Computed:            true,
+						},
+						"links": schema.ListAttribute{
+							MarkdownDescription: "Corosync link addresses of the node, in link number order",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterJoinInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterJoinInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterJoinInfoDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox cluster join information")
+
+	joinPath := "/cluster/config/join"
+	if !data.Node.IsNull() {
+		joinPath += "?node=" + url.QueryEscape(data.Node.ValueString())
+	}
+
+	var info struct {
+		ConfigDigest  string                   `json:"config_digest"`
+		PreferredNode string                   `json:"preferred_node"`
+		Totem         map[string]interface{}   `json:"totem"`
+		NodeList      []map[string]interface{} `json:"nodelist"`
+	}
+	if err := d.client.Get(ctx, joinPath, &info); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster join information, got error: %s", err))
+		return
+	}
+
+	data.Node = types.StringValue(info.PreferredNode)
+	data.ConfigDigest = types.StringValue(info.ConfigDigest)
+	data.ClusterName = stringValue(info.Totem, "cluster_name")
+
+	data.Totem = map[string]string{}
+	for key, value := range info.Totem {
+		if _, ok := value.(map[string]interface{}); ok {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode totem setting %s, got error: %s", key, err))
+				return
+			}
+			data.Totem[key] = string(encoded)
+			continue
+		}
+		data.Totem[key] = stringValue(info.Totem, key).ValueString()
+	}
+
+	nodes := make([]ClusterJoinNodeModel, len(info.NodeList))
+	for i, entry := range info.NodeList {
+		nodes[i] = ClusterJoinNodeModel{
+			Name:        stringValue(entry, "name"),
+			NodeID:      int64Value(entry, "nodeid"),
+			Address:     stringValue(entry, "pve_addr"),
+			Fingerprint: stringValue(entry, "pve_fp"),
+			Votes:       int64Value(entry, "quorum_votes"),
+			Links:       []string{},
+		}
+		for link := 0; link < clusterMaxLinks; link++ {
+			if address := stringValue(entry, fmt.Sprintf("ring%d_addr", link)).ValueString(); address != "" {
+				nodes[i].Links = append(nodes[i].Links, address)
+			}
+		}
+		if nodes[i].Name.ValueString() == info.PreferredNode {
+			data.Address = nodes[i].Address
+			data.Fingerprint = nodes[i].Fingerprint
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeID.ValueInt64() < nodes[j].NodeID.ValueInt64()
+	})
+
+	data.ID = types.StringValue("cluster_join_info")
+	data.Nodes = nodes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterJoinInfoDataSource(t *testing.T) {
+	// Standalone nodes have no join information.
+	clusterName := testAccRequireEnv(t, "PROXMOX_CLUSTER_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_cluster_join_info" "test" {
+  node = %[1]q
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_cluster_join_info.test", "node", testNode()),
+					resource.TestCheckResourceAttr("data.proxmox_cluster_join_info.test", "cluster_name", clusterName),
+					resource.TestMatchResourceAttr("data.proxmox_cluster_join_info.test", "fingerprint", regexp.MustCompile(`^([0-9A-F]{2}:){31}[0-9A-F]{2}$`)),
+					resource.TestCheckResourceAttrSet("data.proxmox_cluster_join_info.test", "totem.interface"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_cluster_join_info.test", "nodes.*", map[string]string{
+						"name": testNode(),
+					}),
+				),
+			},
+		},
+	})
+}
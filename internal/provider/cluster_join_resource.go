@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// clusterJoinTimeouts are the default timeouts of joining a cluster. The join
+// restarts the cluster filesystem and pveproxy of the joining node.
+var clusterJoinTimeouts = operationTimeouts{Create: 5 * time.Minute}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterJoinResource{}
+var _ resource.ResourceWithImportState = &ClusterJoinResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterJoinResource{}
+
+func NewClusterJoinResource() resource.Resource {
+	return &ClusterJoinResource{}
+}
+
+// ClusterJoinResource defines the resource implementation.
+type ClusterJoinResource struct {
+	client *ProxmoxClient
+}
+
+// ClusterJoinResourceModel describes the resource data model.
+type ClusterJoinResourceModel struct {
+	ID                      types.String           `tfsdk:"id"`
+	Node                    types.String           `tfsdk:"node"`
+	NodeEndpoint            types.String           `tfsdk:"node_endpoint"`
+	NodeTokenID             types.String           `tfsdk:"node_token_id"`
+	NodeTokenSecret         types.String           `tfsdk:"node_token_secret"`
+	NodeSkipVerify          types.Bool             `tfsdk:"node_skip_verify"`
+	Hostname                types.String           `tfsdk:"hostname"`
+	Fingerprint             types.String           `tfsdk:"fingerprint"`
+	Password                types.String           `tfsdk:"password"`
+	Links                   []ClusterJoinLinkModel `tfsdk:"links"`
+	Votes                   types.Int64            `tfsdk:"votes"`
+	NodeID                  types.Int64            `tfsdk:"node_id"`
+	Force                   types.Bool             `tfsdk:"force"`
+	AcknowledgeIrreversible types.Bool             `tfsdk:"acknowledge_irreversible"`
+	ClusterName             types.String           `tfsdk:"cluster_name"`
+	Timeouts                timeouts.Value         `tfsdk:"timeouts"`
+}
+
+// ClusterJoinLinkModel describes a corosync link of the joining node.
+type ClusterJoinLinkModel struct {
+	Address  types.String `tfsdk:"address"`
+	Priority types.Int64  `tfsdk:"priority"`
+}
+
+func (r *ClusterJoinResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_join"
+}
+
+func (r *ClusterJoinResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Joins a freshly installed Proxmox VE node to an existing cluster. The provider connects " +
+			"to the existing cluster, while the join request is sent to the joining node using the `node_*` " +
+			"credentials. These credentials are only valid until the join, as the node adopts the users and tokens " +
+			"of the cluster. Joining is irreversible: all attributes except `node` are only used when joining, " +
+			"and destroying the resource only removes it from the Terraform state. The node has to be removed from " +
+			"the cluster manually with `pvecm delnode` and reinstalled.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the name of the joining node",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the joining node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_endpoint": schema.StringAttribute{
+				MarkdownDescription: "API endpoint of the joining node (e.g., `https://pve4.example.com:8006`)",
+				Optional:            true,
+			},
+			"node_token_id": schema.StringAttribute{
+				MarkdownDescription: "API token ID on the joining node in the `user@realm!tokenname` format",
+				Optional:            true,
+			},
+			"node_token_secret": schema.StringAttribute{
+				MarkdownDescription: "API token secret on the joining node",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"node_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS verification of the joining node, whose certificate is usually still " +
+					"self-signed",
+				Optional: true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Address of the cluster node the joining node connects to",
+				Optional:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 fingerprint of the API certificate of the cluster node `hostname`",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of `root@pam` on the cluster node `hostname`",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"links": schema.ListNestedAttribute{
+				MarkdownDescription: "Corosync links of the joining node, in link number order. Defaults to the " +
+					"address of the node",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Address of the node on the link",
+							Required:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority of the link, the link with the highest priority is used",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"votes": schema.Int64Attribute{
+				MarkdownDescription: "Number of quorum votes of the node. Proxmox VE defaults to `1`",
+				Optional:            true,
+			},
+			"node_id": schema.Int64Attribute{
+				MarkdownDescription: "Corosync node ID of the node. Defaults to the next free ID",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Join even if the node already has a cluster configuration or guests",
+				Optional:            true,
+			},
+			"acknowledge_irreversible": schema.BoolAttribute{
+				MarkdownDescription: "Must be set to `true` to confirm that joining cannot be undone by Terraform",
+				Required:            true,
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the joined cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, clusterJoinTimeouts.opts()),
+		},
+	}
+}
+
+func (r *ClusterJoinResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterJoinResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ClusterJoinResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.AcknowledgeIrreversible.IsUnknown() {
+		return
+	}
+
+	if !data.AcknowledgeIrreversible.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("acknowledge_irreversible"),
+			"Invalid Attribute Value",
+			"Joining a cluster cannot be undone by Terraform. Set acknowledge_irreversible to true to confirm.",
+		)
+	}
+}
+
+func (r *ClusterJoinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterJoinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var tokenSecret, password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("node_token_secret"), &tokenSecret)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, clusterJoinTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	node := data.Node.ValueString()
+
+	// A node that is already a member, e.g. after a failed apply, is adopted
+	// instead of joined again.
+	joined, err := r.member(ctx, node)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster nodes, got error: %s", err))
+		return
+	}
+
+	if !joined {
+		for name, value := range map[string]types.String{
+			"node_endpoint":     data.NodeEndpoint,
+			"node_token_id":     data.NodeTokenID,
+			"node_token_secret": tokenSecret,
+			"hostname":          data.Hostname,
+			"fingerprint":       data.Fingerprint,
+			"password":          password,
+		} {
+			if value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Attribute Configuration",
+					fmt.Sprintf("The %s attribute is required to join node %s to the cluster.", name, node))
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		transport := &http.Transport{}
+		if data.NodeSkipVerify.ValueBool() {
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		}
+		nodeClient := &ProxmoxClient{
+			HTTPClient:  &http.Client{Transport: transport},
+			Endpoint:    data.NodeEndpoint.ValueString(),
+			TokenID:     data.NodeTokenID.ValueString(),
+			TokenSecret: tokenSecret.ValueString(),
+		}
+
+		params := apiParams{}
+		params.setString("hostname", data.Hostname)
+		params.setString("fingerprint", data.Fingerprint)
+		params.setString("password", password)
+		params.setInt64("votes", data.Votes)
+		params.setInt64("nodeid", data.NodeID)
+		params.setBool("force", data.Force)
+		for i, link := range data.Links {
+			var props propertyString
+			props.addString("address", link.Address)
+			props.addInt64("priority", link.Priority)
+			params[fmt.Sprintf("link%d", i)] = props.String()
+		}
+
+		var upid string
+		if err := nodeClient.Post(ctx, "/cluster/config/join", params, &upid); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to join node %s to the cluster, got error: %s", node, err))
+			return
+		}
+
+		if err := r.waitForJoin(ctx, nodeClient, node, upid); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to join node %s to the cluster, got error: %s", node, err))
+			return
+		}
+	}
+
+	data.ID = data.Node
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "joined cluster", map[string]interface{}{"node": node})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterJoinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterJoinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	joined, err := r.member(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster nodes, got error: %s", err))
+		return
+	}
+	if !joined {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Node = data.ID
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterJoinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterJoinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	// The join attributes only matter when joining, so changes are only
+	// recorded in the state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterJoinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterJoinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	// A node that left a cluster has to be reinstalled, so it is never
+	// removed automatically.
+	resp.Diagnostics.AddWarning(
+		"Node Remains Clustered",
+		fmt.Sprintf("Node %[1]s was removed from the Terraform state but is still a member of cluster %[2]s. Shut it "+
+			"down and run `pvecm delnode %[1]s` on a remaining cluster node if it should leave the cluster.",
+			data.ID.ValueString(), data.ClusterName.ValueString()),
+	)
+}
+
+func (r *ClusterJoinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// member reports whether the node is part of the cluster configuration.
+func (r *ClusterJoinResource) member(ctx context.Context, node string) (bool, error) {
+	var nodes []map[string]interface{}
+	if err := r.client.Get(ctx, "/cluster/config/nodes", &nodes); err != nil {
+		return false, err
+	}
+	for _, entry := range nodes {
+		if stringValue(entry, "name").ValueString() == node {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// read updates the computed attributes from the cluster configuration.
+func (r *ClusterJoinResource) read(ctx context.Context, data *ClusterJoinResourceModel) error {
+	var nodes []map[string]interface{}
+	if err := r.client.Get(ctx, "/cluster/config/nodes", &nodes); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if stringValue(node, "name").Equal(data.ID) {
+			data.NodeID = int64Value(node, "nodeid")
+		}
+	}
+
+	var entries []map[string]interface{}
+	if err := r.client.Get(ctx, "/cluster/status", &entries); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if stringValue(entry, "type").ValueString() == "cluster" {
+			data.ClusterName = stringValue(entry, "name")
+		}
+	}
+	return nil
+}
+
+// waitForJoin waits until the node is an online member of the cluster. The
+// join task on the node is checked for failures as long as the node still
+// accepts its old credentials, which stop working once the join succeeded.
+func (r *ClusterJoinResource) waitForJoin(ctx context.Context, nodeClient *ProxmoxClient, node, upid string) error {
+	taskNode, err := parseUPIDNode(upid)
+	if err != nil {
+		return err
+	}
+	taskPath := "/nodes/" + url.PathEscape(taskNode) + "/tasks/" + url.PathEscape(upid)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for node %s to join: %w", node, ctx.Err())
+		case <-time.After(r.client.pollInterval()):
+		}
+
+		var status struct {
+			Status     string `json:"status"`
+			ExitStatus string `json:"exitstatus"`
+		}
+		err := nodeClient.Get(ctx, taskPath+"/status", &status)
+		if err == nil && status.Status == "stopped" && status.ExitStatus != "OK" {
+			return fmt.Errorf("task %s failed: %s%s", upid, status.ExitStatus, nodeClient.taskLogTail(ctx, taskPath))
+		}
+
+		var entries []map[string]interface{}
+		if err := r.client.Get(ctx, "/cluster/status", &entries); err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if stringValue(entry, "type").ValueString() == "node" && stringValue(entry, "name").ValueString() == node &&
+				boolValue(entry, "online").ValueBool() {
+				return nil
+			}
+		}
+
+		tflog.Debug(ctx, "waiting for cluster join", map[string]interface{}{"node": node})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAccClusterJoinResource joins a freshly installed node to the cluster of
+// the test endpoint. The node cannot be removed again by the test, so it has
+// to be reinstalled before the test is run again.
+func TestAccClusterJoinResource(t *testing.T) {
+	node := testAccRequireEnv(t, "PROXMOX_JOIN_NODE")
+	endpoint := testAccRequireEnv(t, "PROXMOX_JOIN_ENDPOINT")
+	tokenID := testAccRequireEnv(t, "PROXMOX_JOIN_TOKEN_ID")
+	tokenSecret := testAccRequireEnv(t, "PROXMOX_JOIN_TOKEN_SECRET")
+	hostname := testAccRequireEnv(t, "PROXMOX_JOIN_HOSTNAME")
+	fingerprint := testAccRequireEnv(t, "PROXMOX_JOIN_FINGERPRINT")
+	password := testAccRequireEnv(t, "PROXMOX_JOIN_PASSWORD")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccClusterJoinResourceConfig(node, endpoint, tokenID, tokenSecret, hostname, fingerprint, password),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_cluster_join.test", "id", node),
+					resource.TestCheckResourceAttrSet("proxmox_cluster_join.test", "node_id"),
+					resource.TestCheckResourceAttrSet("proxmox_cluster_join.test", "cluster_name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_cluster_join.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"node_endpoint", "node_token_id", "node_skip_verify", "hostname", "fingerprint",
+					"acknowledge_irreversible",
+				},
+			},
+		},
+	})
+}
+
+func testAccClusterJoinResourceConfig(node, endpoint, tokenID, tokenSecret, hostname, fingerprint, password string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_cluster_join" "test" {
+  node              = %[1]q
+  node_endpoint     = %[2]q
+  node_token_id     = %[3]q
+  node_token_secret = %[4]q
+  node_skip_verify  = true
+
+  hostname    = %[5]q
+  fingerprint = %[6]q
+  password    = %[7]q
+
+  acknowledge_irreversible = true
+}
+`, node, endpoint, tokenID, tokenSecret, hostname, fingerprint, password)
+}
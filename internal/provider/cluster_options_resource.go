@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterOptionsResource{}
+var _ resource.ResourceWithImportState = &ClusterOptionsResource{}
+
+func NewClusterOptionsResource() resource.Resource {
+	return &ClusterOptionsResource{}
+}
+
+// ClusterOptionsResource defines the resource implementation.
+type ClusterOptionsResource struct {
+	client *ProxmoxClient
+}
+
+// ClusterOptionsResourceModel describes the resource data model.
+type ClusterOptionsResourceModel struct {
+	ID               types.String                 `tfsdk:"id"`
+	Keyboard         types.String                 `tfsdk:"keyboard"`
+	EmailFrom        types.String                 `tfsdk:"email_from"`
+	Migration        *ClusterMigrationModel       `tfsdk:"migration"`
+	BandwidthLimits  *ClusterBandwidthLimitsModel `tfsdk:"bandwidth_limits"`
+	HAShutdownPolicy types.String                 `tfsdk:"ha_shutdown_policy"`
+	TagStyle         *ClusterTagStyleModel        `tfsdk:"tag_style"`
+	RegisteredTags   types.Set                    `tfsdk:"registered_tags"`
+}
+
+// ClusterMigrationModel describes the migration property string.
+type ClusterMigrationModel struct {
+	Type    types.String `tfsdk:"type"`
+	Network types.String `tfsdk:"network"`
+}
+
+// ClusterBandwidthLimitsModel describes the bwlimit property string.
+type ClusterBandwidthLimitsModel struct {
+	Default   types.Int64 `tfsdk:"default"`
+	Clone     types.Int64 `tfsdk:"clone"`
+	Migration types.Int64 `tfsdk:"migration"`
+	Move      types.Int64 `tfsdk:"move"`
+	Restore   types.Int64 `tfsdk:"restore"`
+}
+
+// ClusterTagStyleModel describes the tag-style property string.
+type ClusterTagStyleModel struct {
+	Shape         types.String `tfsdk:"shape"`
+	Ordering      types.String `tfsdk:"ordering"`
+	CaseSensitive types.Bool   `tfsdk:"case_sensitive"`
+	ColorMap      types.String `tfsdk:"color_map"`
+}
+
+// clusterOptionsKeys lists the options managed by the resource, which are
+// removed again on destroy.
+var clusterOptionsKeys = []string{"keyboard", "email_from", "migration", "bwlimit", "ha", "tag-style", "registered-tags"}
+
+func (r *ClusterOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_options"
+}
+
+func (r *ClusterOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the datacenter-wide Proxmox VE options. Only one instance of this resource should " +
+			"exist per cluster. Destroying it resets the managed options to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, always `cluster`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keyboard": schema.StringAttribute{
+				MarkdownDescription: "Default keyboard layout of the VNC console (e.g., `en-us` or `de`)",
+				Optional:            true,
+			},
+			"email_from": schema.StringAttribute{
+				MarkdownDescription: "Sender address of notification emails",
+				Optional:            true,
+			},
+			"migration": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of guest migrations",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Migration traffic encryption, either `secure` or `insecure`",
+						Optional:            true,
+					},
+					"network": schema.StringAttribute{
+						MarkdownDescription: "CIDR of the network used for migrations",
+						Optional:            true,
+						Validators:          []validator.String{validators.CIDR()},
+					},
+				},
+			},
+			"bandwidth_limits": schema.SingleNestedAttribute{
+				MarkdownDescription: "Bandwidth limits of I/O intensive operations in KiB/s",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"default": schema.Int64Attribute{
+						MarkdownDescription: "Default limit of all operations",
+						Optional:            true,
+					},
+					"clone": schema.Int64Attribute{
+						MarkdownDescription: "Limit of cloning disks",
+						Optional:            true,
+					},
+					"migration": schema.Int64Attribute{
+						MarkdownDescription: "Limit of migrating guests",
+						Optional:            true,
+					},
+					"move": schema.Int64Attribute{
+						MarkdownDescription: "Limit of moving disks",
+						Optional:            true,
+					},
+					"restore": schema.Int64Attribute{
+						MarkdownDescription: "Limit of restoring guests from backups",
+						Optional:            true,
+					},
+				},
+			},
+			"ha_shutdown_policy": schema.StringAttribute{
+				MarkdownDescription: "Handling of HA guests on node shutdown, one of `freeze`, `failover`, `conditional` " +
+					"or `migrate`",
+				Optional: true,
+			},
+			"tag_style": schema.SingleNestedAttribute{
+				MarkdownDescription: "Display settings of guest tags",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"shape": schema.StringAttribute{
+						MarkdownDescription: "Shape of tags in the tree, one of `full`, `circle`, `dense` or `none`",
+						Optional:            true,
+					},
+					"ordering": schema.StringAttribute{
+						MarkdownDescription: "Ordering of tags, either `config` or `alphabetical`",
+						Optional:            true,
+					},
+					"case_sensitive": schema.BoolAttribute{
+						MarkdownDescription: "Treat tags differing in case as distinct",
+						Optional:            true,
+					},
+					"color_map": schema.StringAttribute{
+						MarkdownDescription: "Colors of tags as `tag:background[:text]` entries separated by `;`",
+						Optional:            true,
+						Validators:          []validator.String{validators.PropertyValue()},
+					},
+				},
+			},
+			"registered_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags only privileged users may set on guests",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ClusterOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, "/cluster/options", data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster options, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("cluster")
+
+	tflog.Trace(ctx, "created cluster options")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var options map[string]interface{}
+	if err := r.client.Get(ctx, "/cluster/options", &options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster options, got error: %s", err))
+		return
+	}
+
+	data.Keyboard = stringValue(options, "keyboard")
+	data.EmailFrom = stringValue(options, "email_from")
+	data.Migration = newClusterMigrationModel(options)
+	data.BandwidthLimits = newClusterBandwidthLimitsModel(options)
+	data.HAShutdownPolicy = propertyStringValue(propertyStringValues(options, "ha", "shutdown_policy"), "shutdown_policy")
+	data.TagStyle = newClusterTagStyleModel(options)
+	data.RegisteredTags = stringSetValue(options, "registered-tags")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, "/cluster/options", data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster options, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	params := apiParams{}
+	for _, key := range clusterOptionsKeys {
+		params.remove(key)
+	}
+
+	if err := r.client.Put(ctx, "/cluster/options", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset cluster options, got error: %s", err))
+		return
+	}
+}
+
+func (r *ClusterOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the options. Unset options are
+// removed, as the resource manages them all.
+func (m ClusterOptionsResourceModel) params() apiParams {
+	params := apiParams{}
+	params.updateString("keyboard", m.Keyboard)
+	params.updateString("email_from", m.EmailFrom)
+	params.updateString("migration", m.Migration.value())
+	params.updateString("bwlimit", m.BandwidthLimits.value())
+
+	ha := types.StringNull()
+	if !m.HAShutdownPolicy.IsNull() {
+		ha = types.StringValue("shutdown_policy=" + m.HAShutdownPolicy.ValueString())
+	}
+	params.updateString("ha", ha)
+	params.updateString("tag-style", m.TagStyle.value())
+
+	// Tag lists are separated by semicolons.
+	tags := types.StringNull()
+	if !m.RegisteredTags.IsNull() && !m.RegisteredTags.IsUnknown() {
+		var items []string
+		for _, elem := range m.RegisteredTags.Elements() {
+			if s, ok := elem.(types.String); ok {
+				items = append(items, s.ValueString())
+			}
+		}
+		sort.Strings(items)
+		tags = types.StringValue(strings.Join(items, ";"))
+	}
+	params.updateString("registered-tags", tags)
+
+	return params
+}
+
+func (m *ClusterMigrationModel) value() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	var props propertyString
+	props.addString("type", m.Type)
+	props.addString("network", m.Network)
+	return types.StringValue(props.String())
+}
+
+func newClusterMigrationModel(options map[string]interface{}) *ClusterMigrationModel {
+	props := propertyStringValues(options, "migration", "type")
+	if len(props) == 0 {
+		return nil
+	}
+
+	return &ClusterMigrationModel{
+		Type:    propertyStringValue(props, "type"),
+		Network: propertyStringValue(props, "network"),
+	}
+}
+
+func (m *ClusterBandwidthLimitsModel) value() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	var props propertyString
+	props.addInt64("default", m.Default)
+	props.addInt64("clone", m.Clone)
+	props.addInt64("migration", m.Migration)
+	props.addInt64("move", m.Move)
+	props.addInt64("restore", m.Restore)
+	return types.StringValue(props.String())
+}
+
+func newClusterBandwidthLimitsModel(options map[string]interface{}) *ClusterBandwidthLimitsModel {
+	props := propertyStringValues(options, "bwlimit", "default")
+	if len(props) == 0 {
+		return nil
+	}
+
+	return &ClusterBandwidthLimitsModel{
+		Default:   propertyInt64Value(props, "default"),
+		Clone:     propertyInt64Value(props, "clone"),
+		Migration: propertyInt64Value(props, "migration"),
+		Move:      propertyInt64Value(props, "move"),
+		Restore:   propertyInt64Value(props, "restore"),
+	}
+}
+
+func (m *ClusterTagStyleModel) value() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	var props propertyString
+	props.addString("shape", m.Shape)
+	props.addString("ordering", m.Ordering)
+	props.addBool("case-sensitive", m.CaseSensitive)
+	props.addString("color-map", m.ColorMap)
+	return types.StringValue(props.String())
+}
+
+func newClusterTagStyleModel(options map[string]interface{}) *ClusterTagStyleModel {
+	props := propertyStringValues(options, "tag-style", "shape")
+	if len(props) == 0 {
+		return nil
+	}
+
+	return &ClusterTagStyleModel{
+		Shape:         propertyStringValue(props, "shape"),
+		Ordering:      propertyStringValue(props, "ordering"),
+		CaseSensitive: propertyBoolValue(props, "case-sensitive"),
+		ColorMap:      propertyStringValue(props, "color-map"),
+	}
+}
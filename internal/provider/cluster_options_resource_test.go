@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterOptionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccClusterOptionsResourceConfig("en-us", 102400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "id", "cluster"),
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "keyboard", "en-us"),
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "migration.type", "secure"),
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "bandwidth_limits.migration", "102400"),
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "ha_shutdown_policy", "conditional"),
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "registered_tags.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_cluster_options.test",
+				ImportState:       true,
+				ImportStateId:     "cluster",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccClusterOptionsResourceConfig("de", 51200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "keyboard", "de"),
+					resource.TestCheckResourceAttr("proxmox_cluster_options.test", "bandwidth_limits.migration", "51200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterOptionsResourceConfig(keyboard string, migrationLimit int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_cluster_options" "test" {
+  keyboard           = %[1]q
+  email_from         = "pve@example.com"
+  ha_shutdown_policy = "conditional"
+  registered_tags    = ["production", "staging"]
+
+  migration = {
+    type = "secure"
+  }
+
+  bandwidth_limits = {
+    migration = %[2]d
+  }
+
+  tag_style = {
+    shape          = "circle"
+    ordering       = "alphabetical"
+    case_sensitive = false
+  }
+}
+`, keyboard, migrationLimit)
+}
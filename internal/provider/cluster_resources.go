@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+)
+
+// clusterResources returns all entries of /cluster/resources. The list is
+// cached, so that refreshing many guests costs a single request instead of
+// one or more requests per guest. The status of guests in the list may be
+// outdated while no write or task of the provider happened in between.
+func (c *ProxmoxClient) clusterResources(ctx context.Context) ([]map[string]interface{}, error) {
+	var entries []map[string]interface{}
+	if err := c.GetCached(ctx, "/cluster/resources", &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// clusterResourcesOfType returns the entries of /cluster/resources with the
+// given type, e.g. "qemu", "lxc", "storage" or "node".
+func (c *ProxmoxClient) clusterResourcesOfType(ctx context.Context, resourceType string) ([]map[string]interface{}, error) {
+	entries, err := c.clusterResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []map[string]interface{}
+	for _, entry := range entries {
+		if stringValue(entry, "type").ValueString() == resourceType {
+			matching = append(matching, entry)
+		}
+	}
+	return matching, nil
+}
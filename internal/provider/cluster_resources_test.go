@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClusterResourcesCache(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api2/json/cluster/resources" {
+			requests.Add(1)
+		}
+		fmt.Fprint(w, `{"data":[{"type":"qemu","vmid":100,"node":"pve1"},{"type":"lxc","vmid":101,"node":"pve1"},{"type":"storage","storage":"local","node":"pve1"}]}`)
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, responses: &responseCache{}}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.clusterResources(ctx); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request for concurrent reads, got %d", got)
+	}
+
+	containers, err := client.clusterResourcesOfType(ctx, guestTypeLXC)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(containers) != 1 || int64Value(containers[0], "vmid").ValueInt64() != 101 {
+		t.Errorf("unexpected containers: %v", containers)
+	}
+
+	if err := client.Post(ctx, "/nodes/pve1/qemu/100/status/start", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.clusterResources(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected a new request after a write, got %d requests", got)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterStatusDataSource{}
+
+func NewClusterStatusDataSource() datasource.DataSource {
+	return &ClusterStatusDataSource{}
+}
+
+// ClusterStatusDataSource defines the data source implementation.
+type ClusterStatusDataSource struct {
+	client *ProxmoxClient
+}
+
+// ClusterStatusDataSourceModel describes the data source data model.
+type ClusterStatusDataSourceModel struct {
+	ID            types.String             `tfsdk:"id"`
+	ClusterName   types.String             `tfsdk:"cluster_name"`
+	Clustered     types.Bool               `tfsdk:"clustered"`
+	Quorate       types.Bool               `tfsdk:"quorate"`
+	ConfigVersion types.Int64              `tfsdk:"config_version"`
+	NodeCount     types.Int64              `tfsdk:"node_count"`
+	NodesOnline   types.Int64              `tfsdk:"nodes_online"`
+	Nodes         []ClusterStatusNodeModel `tfsdk:"nodes"`
+}
+
+// ClusterStatusNodeModel describes a node entry of the cluster status.
+type ClusterStatusNodeModel struct {
+	Name    types.String `tfsdk:"name"`
+	NodeID  types.Int64  `tfsdk:"node_id"`
+	Online  types.Bool   `tfsdk:"online"`
+	Local   types.Bool   `tfsdk:"local"`
+	Address types.String `tfsdk:"address"`
+}
+
+func (d *ClusterStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_status"
+}
+
+func (d *ClusterStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the cluster status, such as quorum and the nodes that are online. A standalone node " +
+			"is reported as a quorate cluster of one node without a name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cluster, null for a standalone node",
+				Computed:            true,
+			},
+			"clustered": schema.BoolAttribute{
+				MarkdownDescription: "Whether the node the provider connects to is part of a cluster",
+				Computed:            true,
+			},
+			"quorate": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster has quorum",
+				Computed:            true,
+			},
+			"config_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the corosync configuration, null for a standalone node",
+				Computed:            true,
+			},
+			"node_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of nodes of the cluster",
+				Computed:            true,
+			},
+			"nodes_online": schema.Int64Attribute{
+				MarkdownDescription: "Number of nodes that are online",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Nodes of the cluster, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node",
+							Computed:            true,
+						},
+						"node_id": schema.Int64Attribute{
+							MarkdownDescription: "Corosync node ID of the node",
+							Computed:            true,
+						},
+						"online": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node is online",
+							Computed:            true,
+						},
+						"local": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the node the provider connects to",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Cluster address of the node",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox cluster status")
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, "/cluster/status", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster status, got error: %s", err))
+		return
+	}
+
+	data.ClusterName = types.StringNull()
+	data.Clustered = types.BoolValue(false)
+	data.Quorate = types.BoolValue(true)
+	data.ConfigVersion = types.Int64Null()
+
+	nodes := []ClusterStatusNodeModel{}
+	online := int64(0)
+	for _, entry := range entries {
+		switch stringValue(entry, "type").ValueString() {
+		case "cluster":
+			data.ClusterName = stringValue(entry, "name")
+			data.Clustered = types.BoolValue(true)
+			data.Quorate = types.BoolValue(boolValue(entry, "quorate").ValueBool())
+			data.ConfigVersion = int64Value(entry, "version")
+		case "node":
+			node := ClusterStatusNodeModel{
+				Name:    stringValue(entry, "name"),
+				NodeID:  int64Value(entry, "nodeid"),
+				Online:  types.BoolValue(boolValue(entry, "online").ValueBool()),
+				Local:   types.BoolValue(boolValue(entry, "local").ValueBool()),
+				Address: stringValue(entry, "ip"),
+			}
+			if node.Online.ValueBool() {
+				online++
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return strings.Compare(nodes[i].Name.ValueString(), nodes[j].Name.ValueString()) < 0
+	})
+
+	data.Nodes = nodes
+	data.NodeCount = types.Int64Value(int64(len(nodes)))
+	data.NodesOnline = types.Int64Value(online)
+	data.ID = types.StringValue("cluster")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
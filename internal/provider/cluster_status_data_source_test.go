@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_cluster_status" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_cluster_status.test", "id", "cluster"),
+					resource.TestCheckResourceAttr("data.proxmox_cluster_status.test", "quorate", "true"),
+					resource.TestCheckResourceAttrSet("data.proxmox_cluster_status.test", "clustered"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_cluster_status.test", "nodes.*", map[string]string{
+						"name":   testNode(),
+						"online": "true",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file contains the deletion protection of resources that destroy data
+// which cannot be recreated from the configuration, such as guests. Unlike
+// the lifecycle prevent_destroy argument, the protection is part of the state,
+// so it also holds when the resource is removed from the configuration.
+
+// deletionProtectionAttribute returns the attribute that makes deleting the
+// described object fail while it is set.
+func deletionProtectionAttribute(noun string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Fail to destroy the %s, including replacing it, while set. The protection "+
+			"has to be cleared and applied before the %[1]s can be destroyed. Defaults to `false`", noun),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// checkDeletionProtection returns an error if the deletion protection of the
+// object in the state is set.
+func checkDeletionProtection(protected types.Bool, object string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if protected.ValueBool() {
+		diags.AddError("Deletion Protection Enabled",
+			fmt.Sprintf("Unable to destroy %s while deletion_protection is set. Set deletion_protection to false and "+
+				"apply the change before destroying it.", object))
+	}
+	return diags
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	for _, test := range []struct {
+		protected types.Bool
+		wantError bool
+	}{
+		{types.BoolValue(true), true},
+		{types.BoolValue(false), false},
+		{types.BoolNull(), false},
+	} {
+		diags := checkDeletionProtection(test.protected, "virtual machine 100")
+		if diags.HasError() != test.wantError {
+			t.Errorf("%s: got diagnostics %v, want error %t", test.protected, diags, test.wantError)
+		}
+	}
+}
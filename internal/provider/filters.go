@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file contains the filter blocks shared by the data sources listing
+// cluster objects. Filters match the attributes of the listed objects by
+// their names in the schema, so they work with every list without
+// per-data-source code.
+
+// FilterModel describes a filter block of a list data source.
+type FilterModel struct {
+	Name   types.String `tfsdk:"name"`
+	Values []string     `tfsdk:"values"`
+	Regex  types.String `tfsdk:"regex"`
+}
+
+// filterBlock returns the schema of the filter blocks. The noun is the plural
+// of the listed objects, e.g. "virtual machines".
+func filterBlock(noun string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: fmt.Sprintf("Only list %s matching the filter. With multiple blocks, %s have to match all of them", noun, noun),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf("Name of the attribute of the %s to filter on (e.g., `status`). List "+
+						"and set attributes like `tags` match if any of their elements matches, map attributes if any of "+
+						"their entries matches as `key=value`", noun),
+					Required: true,
+				},
+				"values": schema.ListAttribute{
+					MarkdownDescription: "Values of which the attribute has to match one exactly. Booleans are `true` or `false`",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+				"regex": schema.StringAttribute{
+					MarkdownDescription: "Regular expression the attribute has to match, in the [RE2 syntax](https://github.com/google/re2/wiki/Syntax)",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+// itemFilter is a compiled filter block.
+type itemFilter struct {
+	field  int
+	values []string
+	regex  *regexp.Regexp
+}
+
+// matches reports whether any of the values of the attribute passes the
+// filter.
+func (f itemFilter) matches(item reflect.Value) bool {
+	values, _ := filterValues(item.Field(f.field).Interface())
+	for _, value := range values {
+		if f.values != nil && !slices.Contains(f.values, value) {
+			continue
+		}
+		if f.regex != nil && !f.regex.MatchString(value) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// applyFilters returns the items matching all filter blocks. Items have to be
+// structs with tfsdk tags, like the nested models of the data sources.
+func applyFilters[T any](filters []FilterModel, items []T) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	itemType := reflect.TypeFor[T]()
+	fields := map[string]int{}
+	for i := 0; i < itemType.NumField(); i++ {
+		fields[itemType.Field(i).Tag.Get("tfsdk")] = i
+	}
+
+	compiled := make([]itemFilter, len(filters))
+	for i, filter := range filters {
+		filterPath := path.Root("filter").AtListIndex(i)
+
+		field, ok := fields[filter.Name.ValueString()]
+		if !ok {
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			diags.AddAttributeError(filterPath.AtName("name"), "Invalid Attribute Value",
+				fmt.Sprintf("Unknown attribute %q, expected one of: %s.", filter.Name.ValueString(), strings.Join(names, ", ")))
+			continue
+		}
+		// Attributes that cannot be filtered on would never match.
+		if _, ok := filterValues(reflect.Zero(itemType.Field(field).Type).Interface()); !ok {
+			diags.AddAttributeError(filterPath.AtName("name"), "Invalid Attribute Value",
+				fmt.Sprintf("Attribute %q cannot be filtered on.", filter.Name.ValueString()))
+			continue
+		}
+		compiled[i] = itemFilter{field: field, values: filter.Values}
+
+		if filter.Values == nil && filter.Regex.IsNull() {
+			diags.AddAttributeError(filterPath, "Missing Attribute Configuration",
+				"Each filter requires values, regex or both.")
+			continue
+		}
+		if !filter.Regex.IsNull() {
+			regex, err := regexp.Compile(filter.Regex.ValueString())
+			if err != nil {
+				diags.AddAttributeError(filterPath.AtName("regex"), "Invalid Attribute Value",
+					fmt.Sprintf("Invalid regular expression: %s.", err))
+				continue
+			}
+			compiled[i].regex = regex
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	matching := []T{}
+	for _, item := range items {
+		value := reflect.ValueOf(item)
+		if !slices.ContainsFunc(compiled, func(f itemFilter) bool { return !f.matches(value) }) {
+			matching = append(matching, item)
+		}
+	}
+	return matching, diags
+}
+
+// filterValues returns the string representations of an attribute value.
+// Null values have none, so they never match. Map entries are represented as
+// key=value. The second result is false for values that cannot be filtered
+// on, such as nested objects.
+func filterValues(value interface{}) ([]string, bool) {
+	switch value := value.(type) {
+	case types.String:
+		if !value.IsNull() {
+			return []string{value.ValueString()}, true
+		}
+	case types.Int64:
+		if !value.IsNull() {
+			return []string{strconv.FormatInt(value.ValueInt64(), 10)}, true
+		}
+	case types.Float64:
+		if !value.IsNull() {
+			return []string{strconv.FormatFloat(value.ValueFloat64(), 'f', -1, 64)}, true
+		}
+	case types.Bool:
+		if !value.IsNull() {
+			return []string{strconv.FormatBool(value.ValueBool())}, true
+		}
+	case []string:
+		return value, true
+	case types.List:
+		return elementFilterValues(value.Elements())
+	case types.Set:
+		return elementFilterValues(value.Elements())
+	case types.Map:
+		var values []string
+		for key, element := range value.Elements() {
+			elementValues, ok := filterValues(element)
+			if !ok {
+				return nil, false
+			}
+			for _, elementValue := range elementValues {
+				values = append(values, key+"="+elementValue)
+			}
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// elementFilterValues returns the string representations of the elements of
+// a list or set attribute.
+func elementFilterValues(elements []attr.Value) ([]string, bool) {
+	var values []string
+	for _, element := range elements {
+		elementValues, ok := filterValues(element)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, elementValues...)
+	}
+	return values, true
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApplyFilters(t *testing.T) {
+	vms := []VMSummaryModel{
+		{VMID: types.Int64Value(100), Name: types.StringValue("web-1"), Status: types.StringValue("running"), Tags: []string{"prod", "web"}, Template: types.BoolValue(false)},
+		{VMID: types.Int64Value(101), Name: types.StringValue("web-2"), Status: types.StringValue("stopped"), Tags: []string{"web"}, Template: types.BoolValue(false)},
+		{VMID: types.Int64Value(9000), Name: types.StringNull(), Status: types.StringValue("stopped"), Template: types.BoolValue(true)},
+	}
+
+	for name, test := range map[string]struct {
+		filters []FilterModel
+		want    []int64
+	}{
+		"none": {
+			want: []int64{100, 101, 9000},
+		},
+		"values": {
+			filters: []FilterModel{{Name: types.StringValue("status"), Values: []string{"stopped", "paused"}}},
+			want:    []int64{101, 9000},
+		},
+		"regex": {
+			filters: []FilterModel{{Name: types.StringValue("name"), Regex: types.StringValue("^web-")}},
+			want:    []int64{100, 101},
+		},
+		"list": {
+			filters: []FilterModel{{Name: types.StringValue("tags"), Values: []string{"prod"}}},
+			want:    []int64{100},
+		},
+		"bool and number": {
+			filters: []FilterModel{
+				{Name: types.StringValue("template"), Values: []string{"false"}},
+				{Name: types.StringValue("vm_id"), Regex: types.StringValue("^10[1-9]$")},
+			},
+			want: []int64{101},
+		},
+	} {
+		got, diags := applyFilters(test.filters, vms)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+		var ids []int64
+		for _, vm := range got {
+			ids = append(ids, vm.VMID.ValueInt64())
+		}
+		if len(ids) != len(test.want) {
+			t.Errorf("%s: got %v, want %v", name, ids, test.want)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != test.want[i] {
+				t.Errorf("%s: got %v, want %v", name, ids, test.want)
+				break
+			}
+		}
+	}
+
+	for _, filter := range []FilterModel{
+		{Name: types.StringValue("hostname"), Values: []string{"pve1"}},
+		{Name: types.StringValue("name"), Regex: types.StringValue("(")},
+		{Name: types.StringValue("name")},
+	} {
+		if _, diags := applyFilters([]FilterModel{filter}, vms); !diags.HasError() {
+			t.Errorf("expected error for filter on %s", filter.Name)
+		}
+	}
+}
+
+func TestApplyFiltersCollections(t *testing.T) {
+	type item struct {
+		ID      types.String `tfsdk:"id"`
+		Nodes   types.Set    `tfsdk:"nodes"`
+		Options types.Map    `tfsdk:"options"`
+		Disks   []struct{}   `tfsdk:"disks"`
+	}
+	items := []item{
+		{
+			ID:      types.StringValue("local"),
+			Nodes:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("pve1"), types.StringValue("pve2")}),
+			Options: types.MapValueMust(types.StringType, map[string]attr.Value{"path": types.StringValue("/var/lib/vz")}),
+		},
+		{
+			ID:      types.StringValue("ceph"),
+			Nodes:   types.SetNull(types.StringType),
+			Options: types.MapValueMust(types.StringType, map[string]attr.Value{"pool": types.StringValue("rbd")}),
+		},
+	}
+
+	for name, test := range map[string]struct {
+		filter FilterModel
+		want   string
+	}{
+		"set":       {FilterModel{Name: types.StringValue("nodes"), Values: []string{"pve2"}}, "local"},
+		"map":       {FilterModel{Name: types.StringValue("options"), Values: []string{"pool=rbd"}}, "ceph"},
+		"map regex": {FilterModel{Name: types.StringValue("options"), Regex: types.StringValue("^path=/var/")}, "local"},
+	} {
+		got, diags := applyFilters([]FilterModel{test.filter}, items)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+		if len(got) != 1 || got[0].ID.ValueString() != test.want {
+			t.Errorf("%s: got %v, want %s", name, got, test.want)
+		}
+	}
+
+	if _, diags := applyFilters([]FilterModel{{Name: types.StringValue("disks"), Values: []string{"scsi0"}}}, items); !diags.HasError() {
+		t.Error("expected error for filter on an unsupported attribute")
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FirewallLogDataSource{}
+
+func NewFirewallLogDataSource() datasource.DataSource {
+	return &FirewallLogDataSource{}
+}
+
+// FirewallLogDataSource defines the data source implementation.
+type FirewallLogDataSource struct {
+	client *ProxmoxClient
+}
+
+// FirewallLogDataSourceModel describes the data source data model.
+type FirewallLogDataSourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Node      types.String            `tfsdk:"node"`
+	VMID      types.Int64             `tfsdk:"vm_id"`
+	GuestType types.String            `tfsdk:"guest_type"`
+	Limit     types.Int64             `tfsdk:"limit"`
+	Since     types.Int64             `tfsdk:"since"`
+	Until     types.Int64             `tfsdk:"until"`
+	Entries   []FirewallLogEntryModel `tfsdk:"entries"`
+}
+
+// FirewallLogEntryModel describes a single log line.
+type FirewallLogEntryModel struct {
+	Line types.Int64  `tfsdk:"line"`
+	Text types.String `tfsdk:"text"`
+}
+
+func (d *FirewallLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_log"
+}
+
+func (d *FirewallLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads recent firewall log entries of a Proxmox VE node or guest.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of a guest on the node. If omitted, the log of the node firewall is read",
+				Optional:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"guest_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the guest, `qemu` (default) or `lxc`",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of entries to return",
+				Optional:            true,
+			},
+			"since": schema.Int64Attribute{
+				MarkdownDescription: "Only return entries logged after this Unix timestamp",
+				Optional:            true,
+			},
+			"until": schema.Int64Attribute{
+				MarkdownDescription: "Only return entries logged before this Unix timestamp",
+				Optional:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Log entries",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"line": schema.Int64Attribute{
+							MarkdownDescription: "Line number",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "Log line",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallLogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	basePath := "/nodes/" + url.PathEscape(node)
+	id := node
+	if !data.VMID.IsNull() {
+		guestType := guestTypeVM
+		if !data.GuestType.IsNull() {
+			guestType = data.GuestType.ValueString()
+		}
+		if guestType != guestTypeVM && guestType != guestTypeLXC {
+			resp.Diagnostics.AddError("Invalid Guest Type", fmt.Sprintf("Expected guest_type to be %q or %q, got: %q", guestTypeVM, guestTypeLXC, guestType))
+			return
+		}
+		basePath = guestPath(guestType, node, data.VMID.ValueInt64())
+		id = formatID(node, strconv.FormatInt(data.VMID.ValueInt64(), 10))
+	}
+
+	query := url.Values{}
+	if !data.Limit.IsNull() {
+		query.Set("limit", strconv.FormatInt(data.Limit.ValueInt64(), 10))
+	}
+	if !data.Since.IsNull() {
+		query.Set("since", strconv.FormatInt(data.Since.ValueInt64(), 10))
+	}
+	if !data.Until.IsNull() {
+		query.Set("until", strconv.FormatInt(data.Until.ValueInt64(), 10))
+	}
+
+	logPath := basePath + "/firewall/log"
+	if len(query) > 0 {
+		logPath += "?" + query.Encode()
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox firewall log", map[string]interface{}{"path": logPath})
+
+	var lines []struct {
+		N int64  `json:"n"`
+		T string `json:"t"`
+	}
+	if err := d.client.Get(ctx, logPath, &lines); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall log of %s, got error: %s", id, err))
+		return
+	}
+
+	entries := make([]FirewallLogEntryModel, 0, len(lines))
+	for _, line := range lines {
+		// Proxmox appends a "no content" marker line to empty logs.
+		if line.T == "no content" {
+			continue
+		}
+		entries = append(entries, FirewallLogEntryModel{
+			Line: types.Int64Value(line.N),
+			Text: types.StringValue(line.T),
+		})
+	}
+
+	data.Entries = entries
+	data.ID = types.StringValue(id)
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d firewall log entries", len(entries)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
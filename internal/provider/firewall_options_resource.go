@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallOptionsResource{}
+var _ resource.ResourceWithImportState = &FirewallOptionsResource{}
+
+func NewFirewallOptionsResource() resource.Resource {
+	return &FirewallOptionsResource{}
+}
+
+// FirewallOptionsResource defines the resource implementation.
+type FirewallOptionsResource struct {
+	client *ProxmoxClient
+}
+
+// FirewallOptionsResourceModel describes the resource data model.
+type FirewallOptionsResourceModel struct {
+	ID           types.String               `tfsdk:"id"`
+	Enabled      types.Bool                 `tfsdk:"enabled"`
+	EBTables     types.Bool                 `tfsdk:"ebtables"`
+	PolicyIn     types.String               `tfsdk:"policy_in"`
+	PolicyOut    types.String               `tfsdk:"policy_out"`
+	LogRateLimit *FirewallLogRateLimitModel `tfsdk:"log_ratelimit"`
+}
+
+// FirewallLogRateLimitModel describes the log_ratelimit property string.
+type FirewallLogRateLimitModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Burst   types.Int64  `tfsdk:"burst"`
+	Rate    types.String `tfsdk:"rate"`
+}
+
+// firewallOptionsKeys lists the cluster firewall options managed by this
+// resource, which are reset to their defaults on delete.
+var firewallOptionsKeys = []string{"enable", "ebtables", "policy_in", "policy_out", "log_ratelimit"}
+
+func (r *FirewallOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_options"
+}
+
+func (r *FirewallOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the cluster-wide Proxmox VE firewall options. Only one instance of this resource " +
+			"should exist per cluster. Destroying it resets the options to their defaults, which disables the firewall.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, always `cluster`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the firewall cluster wide",
+				Optional:            true,
+			},
+			"ebtables": schema.BoolAttribute{
+				MarkdownDescription: "Enable ebtables rules cluster wide",
+				Optional:            true,
+			},
+			"policy_in": schema.StringAttribute{
+				MarkdownDescription: "Input policy, one of `ACCEPT`, `REJECT` or `DROP`",
+				Optional:            true,
+			},
+			"policy_out": schema.StringAttribute{
+				MarkdownDescription: "Output policy, one of `ACCEPT`, `REJECT` or `DROP`",
+				Optional:            true,
+			},
+			"log_ratelimit": firewallLogRateLimitAttribute(),
+		},
+	}
+}
+
+// firewallLogRateLimitAttribute returns the schema of the log rate limiting
+// settings shared by the firewall options resources.
+func firewallLogRateLimitAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Log rate limiting settings",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable log rate limiting",
+				Required:            true,
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "Initial burst of packages which will always get logged before the rate is applied",
+				Optional:            true,
+			},
+			"rate": schema.StringAttribute{
+				MarkdownDescription: "Frequency with which the burst bucket gets refilled (e.g., `1/second`)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FirewallOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, "/cluster/firewall/options", data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster firewall options, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("cluster")
+
+	tflog.Trace(ctx, "created cluster firewall options")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var options map[string]interface{}
+	if err := r.client.Get(ctx, "/cluster/firewall/options", &options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster firewall options, got error: %s", err))
+		return
+	}
+
+	data.Enabled = boolValue(options, "enable")
+	data.EBTables = boolValue(options, "ebtables")
+	data.PolicyIn = stringValue(options, "policy_in")
+	data.PolicyOut = stringValue(options, "policy_out")
+	data.LogRateLimit = newFirewallLogRateLimitModel(options)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, "/cluster/firewall/options", data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster firewall options, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	params := apiParams{}
+	for _, key := range firewallOptionsKeys {
+		params.remove(key)
+	}
+
+	if err := r.client.Put(ctx, "/cluster/firewall/options", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset cluster firewall options, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the update parameters for the options. Options missing from
+// the configuration are reset to their defaults.
+func (m FirewallOptionsResourceModel) params() apiParams {
+	params := apiParams{}
+	params.updateBool("enable", m.Enabled)
+	params.updateBool("ebtables", m.EBTables)
+	params.updateString("policy_in", m.PolicyIn)
+	params.updateString("policy_out", m.PolicyOut)
+	params.updateString("log_ratelimit", m.LogRateLimit.value())
+	return params
+}
+
+// value returns the property string representation of the settings.
+func (m *FirewallLogRateLimitModel) value() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	var props propertyString
+	props.addBool("enable", m.Enabled)
+	props.addInt64("burst", m.Burst)
+	props.addString("rate", m.Rate)
+	return types.StringValue(props.String())
+}
+
+// newFirewallLogRateLimitModel parses the log_ratelimit option, returning
+// nil if it is not set.
+func newFirewallLogRateLimitModel(options map[string]interface{}) *FirewallLogRateLimitModel {
+	value, ok := options["log_ratelimit"].(string)
+	if !ok {
+		return nil
+	}
+
+	props := parsePropertyString(value, "enable")
+	return &FirewallLogRateLimitModel{
+		Enabled: propertyBoolValue(props, "enable"),
+		Burst:   propertyInt64Value(props, "burst"),
+		Rate:    propertyStringValue(props, "rate"),
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallOptionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallOptionsResourceConfig("ACCEPT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_firewall_options.test", "id", "cluster"),
+					resource.TestCheckResourceAttr("proxmox_firewall_options.test", "policy_in", "ACCEPT"),
+					resource.TestCheckResourceAttr("proxmox_firewall_options.test", "log_ratelimit.burst", "10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_firewall_options.test",
+				ImportState:       true,
+				ImportStateId:     "cluster",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallOptionsResourceConfig("DROP"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_firewall_options.test", "policy_in", "DROP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallOptionsResourceConfig(policyIn string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_firewall_options" "test" {
+  enabled    = false
+  ebtables   = true
+  policy_in  = %[1]q
+  policy_out = "ACCEPT"
+
+  log_ratelimit = {
+    enabled = true
+    burst   = 10
+    rate    = "2/second"
+  }
+}
+`, policyIn)
+}
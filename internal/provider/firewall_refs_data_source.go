@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FirewallRefsDataSource{}
+
+func NewFirewallRefsDataSource() datasource.DataSource {
+	return &FirewallRefsDataSource{}
+}
+
+// FirewallRefsDataSource defines the data source implementation.
+type FirewallRefsDataSource struct {
+	client *ProxmoxClient
+}
+
+// FirewallRefsDataSourceModel describes the data source data model.
+type FirewallRefsDataSourceModel struct {
+	ID   types.String            `tfsdk:"id"`
+	Type types.String            `tfsdk:"type"`
+	Refs []FirewallRefEntryModel `tfsdk:"refs"`
+}
+
+// FirewallRefEntryModel describes a single alias or IP set reference.
+type FirewallRefEntryModel struct {
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	Ref     types.String `tfsdk:"ref"`
+	Scope   types.String `tfsdk:"scope"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (d *FirewallRefsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_refs"
+}
+
+func (d *FirewallRefsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the firewall aliases and IP sets that can be referenced in firewall rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list references of this type, `alias` or `ipset`",
+				Optional:            true,
+			},
+			"refs": schema.ListNestedAttribute{
+				MarkdownDescription: "Firewall references",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Reference type, `alias` or `ipset`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the alias or IP set",
+							Computed:            true,
+						},
+						"ref": schema.StringAttribute{
+							MarkdownDescription: "Value to use in the `source` or `dest` of a rule (e.g., `+dc/servers`)",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Scope of the object, `dc` for cluster-wide or `guest` for guest-local objects",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment of the object",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FirewallRefsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallRefsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallRefsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refsPath := "/cluster/firewall/refs"
+	id := "refs"
+	if !data.Type.IsNull() {
+		refType := data.Type.ValueString()
+		if refType != "alias" && refType != "ipset" {
+			resp.Diagnostics.AddError("Invalid Reference Type", fmt.Sprintf("Expected type to be \"alias\" or \"ipset\", got: %q", refType))
+			return
+		}
+		refsPath += "?type=" + refType
+		id = refType
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox firewall references")
+
+	var refs []map[string]interface{}
+	if err := d.client.Get(ctx, refsPath, &refs); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall references, got error: %s", err))
+		return
+	}
+
+	entries := make([]FirewallRefEntryModel, len(refs))
+	for i, ref := range refs {
+		entries[i] = FirewallRefEntryModel{
+			Type:    stringValue(ref, "type"),
+			Name:    stringValue(ref, "name"),
+			Ref:     stringValue(ref, "ref"),
+			Scope:   stringValue(ref, "scope"),
+			Comment: stringValue(ref, "comment"),
+		}
+	}
+
+	data.Refs = entries
+	data.ID = types.StringValue(id)
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d firewall references", len(entries)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file contains the rule handling shared by the firewall rules
+// resources of the different firewall levels (cluster, guest).
+
+// FirewallRuleModel describes a single firewall rule.
+type FirewallRuleModel struct {
+	Type     types.String `tfsdk:"type"`
+	Action   types.String `tfsdk:"action"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Macro    types.String `tfsdk:"macro"`
+	Source   types.String `tfsdk:"source"`
+	Dest     types.String `tfsdk:"dest"`
+	Proto    types.String `tfsdk:"proto"`
+	SPort    types.String `tfsdk:"sport"`
+	DPort    types.String `tfsdk:"dport"`
+	ICMPType types.String `tfsdk:"icmp_type"`
+	Iface    types.String `tfsdk:"iface"`
+	Log      types.String `tfsdk:"log"`
+	Comment  types.String `tfsdk:"comment"`
+}
+
+// firewallRuleBlock returns the schema of the rule blocks. The order of the
+// blocks defines the position of the rules.
+func firewallRuleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Firewall rule. Rules are evaluated in the order of the blocks",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Rule type, one of `in`, `out`, `forward` or `group`",
+					Required:            true,
+				},
+				"action": schema.StringAttribute{
+					MarkdownDescription: "Rule action (`ACCEPT`, `DROP` or `REJECT`), or the name of the security group for rules of type `group`",
+					Required:            true,
+				},
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Enable the rule. Defaults to `true`",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+				"macro": schema.StringAttribute{
+					MarkdownDescription: "Predefined macro to use (e.g., `SSH`, `HTTPS`)",
+					Optional:            true,
+				},
+				"source": schema.StringAttribute{
+					MarkdownDescription: "Source address, range, alias or IP set",
+					Optional:            true,
+				},
+				"dest": schema.StringAttribute{
+					MarkdownDescription: "Destination address, range, alias or IP set",
+					Optional:            true,
+				},
+				"proto": schema.StringAttribute{
+					MarkdownDescription: "IP protocol name or number (e.g., `tcp`, `udp`, `icmp`)",
+					Optional:            true,
+				},
+				"sport": schema.StringAttribute{
+					MarkdownDescription: "Source port or port range",
+					Optional:            true,
+				},
+				"dport": schema.StringAttribute{
+					MarkdownDescription: "Destination port or port range",
+					Optional:            true,
+				},
+				"icmp_type": schema.StringAttribute{
+					MarkdownDescription: "ICMP type, only valid for the `icmp` and `icmpv6` protocols",
+					Optional:            true,
+				},
+				"iface": schema.StringAttribute{
+					MarkdownDescription: "Network interface the rule applies to",
+					Optional:            true,
+					Validators:          []validator.String{validators.BridgeName()},
+				},
+				"log": schema.StringAttribute{
+					MarkdownDescription: "Log level of the rule (e.g., `nolog`, `info`, `warning`)",
+					Optional:            true,
+				},
+				"comment": schema.StringAttribute{
+					MarkdownDescription: "Rule comment",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+// firewallRuleOptionalKeys lists the API names of the optional rule fields,
+// which are deleted on update when they are not configured.
+var firewallRuleOptionalKeys = []string{"macro", "source", "dest", "proto", "sport", "dport", "icmp-type", "iface", "log", "comment"}
+
+// params returns the API parameters of the rule. When update is set, unset
+// optional fields are removed from the existing rule.
+func (m FirewallRuleModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("type", m.Type)
+	params.setString("action", m.Action)
+	params.setBool("enable", m.Enabled)
+
+	values := map[string]types.String{
+		"macro":     m.Macro,
+		"source":    m.Source,
+		"dest":      m.Dest,
+		"proto":     m.Proto,
+		"sport":     m.SPort,
+		"dport":     m.DPort,
+		"icmp-type": m.ICMPType,
+		"iface":     m.Iface,
+		"log":       m.Log,
+		"comment":   m.Comment,
+	}
+	for _, key := range firewallRuleOptionalKeys {
+		if update {
+			params.updateString(key, values[key])
+		} else {
+			params.setString(key, values[key])
+		}
+	}
+
+	return params
+}
+
+// newFirewallRuleModel converts a rule returned by the API.
+func newFirewallRuleModel(rule map[string]interface{}) FirewallRuleModel {
+	enabled := boolValue(rule, "enable")
+	if enabled.IsNull() {
+		enabled = types.BoolValue(false)
+	}
+
+	return FirewallRuleModel{
+		Type:     stringValue(rule, "type"),
+		Action:   stringValue(rule, "action"),
+		Enabled:  enabled,
+		Macro:    stringValue(rule, "macro"),
+		Source:   stringValue(rule, "source"),
+		Dest:     stringValue(rule, "dest"),
+		Proto:    stringValue(rule, "proto"),
+		SPort:    stringValue(rule, "sport"),
+		DPort:    stringValue(rule, "dport"),
+		ICMPType: stringValue(rule, "icmp-type"),
+		Iface:    stringValue(rule, "iface"),
+		Log:      stringValue(rule, "log"),
+		Comment:  stringValue(rule, "comment"),
+	}
+}
+
+// readFirewallRules returns the rules below basePath ordered by position.
+func readFirewallRules(ctx context.Context, client *ProxmoxClient, basePath string) ([]FirewallRuleModel, error) {
+	var rules []map[string]interface{}
+	if err := client.Get(ctx, basePath, &rules); err != nil {
+		return nil, err
+	}
+
+	// The API returns the rules ordered by position.
+	models := make([]FirewallRuleModel, len(rules))
+	for i, rule := range rules {
+		models[i] = newFirewallRuleModel(rule)
+	}
+
+	return models, nil
+}
+
+// syncFirewallRules makes the rules below basePath match rules. Existing
+// positions are updated in place so that unchanged rules keep their position
+// and the ruleset never passes through a reordered state.
+func syncFirewallRules(ctx context.Context, client *ProxmoxClient, basePath string, rules []FirewallRuleModel) error {
+	current, err := readFirewallRules(ctx, client, basePath)
+	if err != nil {
+		return err
+	}
+
+	for pos, rule := range rules {
+		if pos < len(current) {
+			if err := client.Put(ctx, fmt.Sprintf("%s/%d", basePath, pos), rule.params(true), nil); err != nil {
+				return fmt.Errorf("updating rule at position %d: %w", pos, err)
+			}
+			continue
+		}
+
+		params := rule.params(false)
+		params["pos"] = pos
+		if err := client.Post(ctx, basePath, params, nil); err != nil {
+			return fmt.Errorf("creating rule at position %d: %w", pos, err)
+		}
+	}
+
+	// Remove surplus rules from the end so the remaining positions stay valid.
+	for pos := len(current) - 1; pos >= len(rules); pos-- {
+		if err := client.Delete(ctx, fmt.Sprintf("%s/%d", basePath, pos), nil); err != nil {
+			return fmt.Errorf("deleting rule at position %d: %w", pos, err)
+		}
+	}
+
+	return nil
+}
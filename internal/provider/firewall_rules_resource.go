@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallRulesResource{}
+var _ resource.ResourceWithImportState = &FirewallRulesResource{}
+
+const clusterFirewallRulesPath = "/cluster/firewall/rules"
+
+func NewFirewallRulesResource() resource.Resource {
+	return &FirewallRulesResource{}
+}
+
+// FirewallRulesResource defines the resource implementation.
+type FirewallRulesResource struct {
+	client *ProxmoxClient
+}
+
+// FirewallRulesResourceModel describes the resource data model.
+type FirewallRulesResourceModel struct {
+	ID    types.String        `tfsdk:"id"`
+	Rules []FirewallRuleModel `tfsdk:"rule"`
+}
+
+func (r *FirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rules"
+}
+
+func (r *FirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the ordered list of cluster-wide Proxmox VE firewall rules. The resource is " +
+			"authoritative: rules that are not part of the configuration are removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, always `cluster`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": firewallRuleBlock(),
+		},
+	}
+}
+
+func (r *FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := syncFirewallRules(ctx, r.client, clusterFirewallRulesPath, data.Rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cluster firewall rules, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("cluster")
+
+	tflog.Trace(ctx, "created cluster firewall rules", map[string]interface{}{"count": len(data.Rules)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	rules, err := readFirewallRules(ctx, r.client, clusterFirewallRulesPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster firewall rules, got error: %s", err))
+		return
+	}
+
+	data.Rules = rules
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := syncFirewallRules(ctx, r.client, clusterFirewallRulesPath, data.Rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster firewall rules, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if err := syncFirewallRules(ctx, r.client, clusterFirewallRulesPath, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cluster firewall rules, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
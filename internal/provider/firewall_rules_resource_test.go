@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFirewallRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig() + `
+resource "proxmox_firewall_rules" "test" {
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "SSH"
+  }
+
+  rule {
+    type    = "in"
+    action  = "ACCEPT"
+    proto   = "tcp"
+    dport   = "8006"
+    comment = "Web UI"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "id", "cluster"),
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "rule.0.macro", "SSH"),
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "rule.1.dport", "8006"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_firewall_rules.test",
+				ImportState:       true,
+				ImportStateId:     "cluster",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: insert a rule at the top
+			{
+				Config: testAccProviderConfig() + `
+resource "proxmox_firewall_rules" "test" {
+  rule {
+    type   = "in"
+    action = "DROP"
+    source = "192.0.2.0/24"
+  }
+
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "SSH"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "rule.0.action", "DROP"),
+					resource.TestCheckNoResourceAttr("proxmox_firewall_rules.test", "rule.0.macro"),
+					resource.TestCheckResourceAttr("proxmox_firewall_rules.test", "rule.1.macro", "SSH"),
+				),
+			},
+		},
+	})
+}
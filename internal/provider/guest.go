@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Guest types as used in Proxmox API paths. Resources that work the same way
+// for virtual machines and containers are parameterized by one of these.
+const (
+	guestTypeVM  = "qemu"
+	guestTypeLXC = "lxc"
+)
+
+// guestTypeName returns the prefix of the Terraform type names of resources
+// handling the given guest type.
+func guestTypeName(guestType string) string {
+	if guestType == guestTypeLXC {
+		return "lxc"
+	}
+	return "vm"
+}
+
+// guestLabel returns a human readable name of the guest type.
+func guestLabel(guestType string) string {
+	if guestType == guestTypeLXC {
+		return "container"
+	}
+	return "virtual machine"
+}
+
+// guestPath returns the API path of a guest.
+func guestPath(guestType, node string, vmID int64) string {
+	return fmt.Sprintf("/nodes/%s/%s/%d", url.PathEscape(node), guestType, vmID)
+}
+
+// findGuest looks up a guest of the given type in the cluster resource list
+// by ID or, if vmID is zero, by name. An empty node matches any node. It fails
+// unless exactly one guest matches.
+func findGuest(ctx context.Context, client *ProxmoxClient, guestType string, vmID int64, name, node string) (map[string]interface{}, error) {
+	entries, err := client.clusterResourcesOfType(ctx, guestType)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []map[string]interface{}
+	for _, entry := range entries {
+		switch {
+		case node != "" && stringValue(entry, "node").ValueString() != node:
+		case vmID != 0 && int64Value(entry, "vmid").ValueInt64() != vmID:
+		case vmID == 0 && stringValue(entry, "name").ValueString() != name:
+		default:
+			matches = append(matches, entry)
+		}
+	}
+
+	label := fmt.Sprintf("%s %d", guestLabel(guestType), vmID)
+	if vmID == 0 {
+		label = fmt.Sprintf("%s named %q", guestLabel(guestType), name)
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%s not found", label)
+	case 1:
+		return matches[0], nil
+	}
+	// VM IDs are unique, so only names can be ambiguous.
+	return nil, fmt.Errorf("found %d %ss named %q, set the ID or node to select one", len(matches), guestLabel(guestType), name)
+}
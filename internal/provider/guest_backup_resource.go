@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/pveapi"
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// guestBackupTimeouts are the default timeouts of backing up guests and
+// deleting their backups.
+var guestBackupTimeouts = operationTimeouts{Create: time.Hour, Delete: 10 * time.Minute}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GuestBackupResource{}
+var _ resource.ResourceWithImportState = &GuestBackupResource{}
+
+func NewGuestBackupResource() resource.Resource {
+	return &GuestBackupResource{}
+}
+
+// GuestBackupResource defines the resource implementation.
+type GuestBackupResource struct {
+	client *ProxmoxClient
+}
+
+// GuestBackupResourceModel describes the resource data model.
+type GuestBackupResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Node            types.String   `tfsdk:"node"`
+	VMID            types.Int64    `tfsdk:"vm_id"`
+	Storage         types.String   `tfsdk:"storage"`
+	Mode            types.String   `tfsdk:"mode"`
+	Compress        types.String   `tfsdk:"compress"`
+	NotesTemplate   types.String   `tfsdk:"notes_template"`
+	Protected       types.Bool     `tfsdk:"protected"`
+	Triggers        types.Map      `tfsdk:"triggers"`
+	DeleteOnDestroy types.Bool     `tfsdk:"delete_on_destroy"`
+	VolumeID        types.String   `tfsdk:"volume_id"`
+	Size            types.Int64    `tfsdk:"size"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GuestBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guest_backup"
+}
+
+func (r *GuestBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a one-time backup of a virtual machine or container and waits for it to finish. " +
+			"Changing any argument, or replacing the resource with `replace_triggered_by`, creates a new backup. " +
+			"Backups are kept when the resource is destroyed unless `delete_on_destroy` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier in the `node/volume_id` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the guest is running on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the virtual machine or container",
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage the backup is written to",
+				Required:            true,
+				Validators:          []validator.String{validators.StorageID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Backup mode, one of `snapshot`, `suspend` or `stop`. Defaults to `snapshot`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("snapshot"),
+				Validators:          []validator.String{validators.APIParameter("POST /nodes/{node}/vzdump", "mode")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compress": schema.StringAttribute{
+				MarkdownDescription: "Compression algorithm, one of `0`, `1`, `gzip`, `lzo` or `zstd`",
+				Optional:            true,
+				Validators:          []validator.String{validators.APIParameter("POST /nodes/{node}/vzdump", "compress")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notes_template": schema.StringAttribute{
+				MarkdownDescription: "Template for the notes of the backup, supporting the `{{guestname}}`, `{{node}}`, " +
+					"`{{vmid}}` and `{{cluster}}` variables",
+				Optional:   true,
+				Validators: []validator.String{validators.APIParameter("POST /nodes/{node}/vzdump", "notes-template")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protected": schema.BoolAttribute{
+				MarkdownDescription: "Protect the backup from pruning and removal",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that create a new backup when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Delete the backup archive when the resource is destroyed. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"volume_id": schema.StringAttribute{
+				MarkdownDescription: "Volume ID of the backup archive",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the backup archive in bytes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, guestBackupTimeouts.opts()),
+		},
+	}
+}
+
+func (r *GuestBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GuestBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GuestBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, guestBackupTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	node := data.Node.ValueString()
+
+	params := pveapi.BackupRequest{
+		VMID:          pveapi.Ptr(strconv.FormatInt(data.VMID.ValueInt64(), 10)),
+		Storage:       optionalString(data.Storage),
+		Mode:          optionalString(data.Mode),
+		Compress:      optionalString(data.Compress),
+		NotesTemplate: optionalString(data.NotesTemplate),
+		Protected:     optionalBool(data.Protected),
+	}
+
+	var upid string
+	err := r.client.Post(ctx, "/nodes/"+url.PathEscape(node)+"/vzdump", params, &upid)
+	if err == nil {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to back up guest %d, got error: %s", data.VMID.ValueInt64(), err))
+		return
+	}
+
+	// The task does not return the created volume, so pick the most recent
+	// backup of the guest on the storage.
+	backups, err := listBackups(ctx, r.client, node, data.Storage.ValueString(), data.VMID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups of guest %d, got error: %s", data.VMID.ValueInt64(), err))
+		return
+	}
+	var latest map[string]interface{}
+	for _, backup := range backups {
+		if latest == nil || int64Value(backup, "ctime").ValueInt64() > int64Value(latest, "ctime").ValueInt64() {
+			latest = backup
+		}
+	}
+	if latest == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the backup of guest %d on storage %s", data.VMID.ValueInt64(), data.Storage.ValueString()))
+		return
+	}
+
+	data.VolumeID = stringValue(latest, "volid")
+	data.Size = int64Value(latest, "size")
+	data.ID = types.StringValue(formatID(node, data.VolumeID.ValueString()))
+
+	tflog.Trace(ctx, "created guest backup", map[string]interface{}{"volume_id": data.VolumeID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GuestBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	// Backups disappearing through pruning must not create new ones, so the
+	// archive is only looked up when importing.
+	if !data.VolumeID.IsNull() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	node, volumeID, err := parseGuestBackupID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	storage, _, _ := strings.Cut(volumeID, ":")
+
+	backups, err := listBackups(ctx, r.client, node, storage, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups on storage %s, got error: %s", storage, err))
+		return
+	}
+	for _, backup := range backups {
+		if stringValue(backup, "volid").ValueString() != volumeID {
+			continue
+		}
+
+		data.Node = types.StringValue(node)
+		data.VMID = int64Value(backup, "vmid")
+		data.Storage = types.StringValue(storage)
+		data.Mode = types.StringValue("snapshot")
+		data.Protected = boolValue(backup, "protected")
+		data.DeleteOnDestroy = types.BoolValue(false)
+		data.VolumeID = types.StringValue(volumeID)
+		data.Size = int64Value(backup, "size")
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *GuestBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GuestBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	// All other arguments force a new backup, so only delete_on_destroy can
+	// change in place.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GuestBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.DeleteOnDestroy.ValueBool() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, guestBackupTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	var upid string
+	contentPath := "/nodes/" + url.PathEscape(data.Node.ValueString()) + "/storage/" + url.PathEscape(data.Storage.ValueString()) +
+		"/content/" + url.PathEscape(data.VolumeID.ValueString())
+	err := r.client.Delete(ctx, contentPath, &upid)
+	if err == nil && upid != "" {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup %s, got error: %s", data.VolumeID.ValueString(), err))
+		return
+	}
+}
+
+func (r *GuestBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, err := parseGuestBackupID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// listBackups lists the backups on a storage, limited to a single guest unless
+// vmID is 0.
+func listBackups(ctx context.Context, client *ProxmoxClient, node, storage string, vmID int64) ([]map[string]interface{}, error) {
+	query := url.Values{"content": {"backup"}}
+	if vmID != 0 {
+		query.Set("vmid", fmt.Sprint(vmID))
+	}
+
+	var backups []map[string]interface{}
+	err := client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/storage/"+url.PathEscape(storage)+"/content?"+query.Encode(), &backups)
+	return backups, err
+}
+
+// parseGuestBackupID splits a `node/volume_id` resource identifier. The
+// volume ID itself contains slashes.
+func parseGuestBackupID(id string) (string, string, error) {
+	parts, err := parseID(id, 2, "node/volume_id")
+	if err != nil {
+		return "", "", err
+	}
+	if !strings.Contains(parts[1], ":") {
+		return "", "", fmt.Errorf("unexpected ID %q, expected format node/volume_id", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGuestBackupResource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGuestBackupResourceConfig(vmID, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_guest_backup.test", "vm_id", vmID),
+					resource.TestMatchResourceAttr("proxmox_guest_backup.test", "volume_id", regexp.MustCompile(`^local:backup/vzdump-`)),
+					resource.TestCheckResourceAttrSet("proxmox_guest_backup.test", "size"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_guest_backup.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compress", "triggers", "delete_on_destroy"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGuestBackupResourceConfig(vmID, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_guest_backup.test", "triggers.release", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuestBackupResourceConfig(vmID, release string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_guest_backup" "test" {
+  node              = %[1]q
+  vm_id             = %[2]s
+  storage           = "local"
+  compress          = "zstd"
+  delete_on_destroy = true
+
+  triggers = {
+    release = %[3]q
+  }
+}
+`, testNode(), vmID, release)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GuestConfigDataSource{}
+
+func NewVMConfigDataSource() datasource.DataSource {
+	return &GuestConfigDataSource{guestType: guestTypeVM}
+}
+
+func NewLXCConfigDataSource() datasource.DataSource {
+	return &GuestConfigDataSource{guestType: guestTypeLXC}
+}
+
+// GuestConfigDataSource defines the data source implementation, shared by
+// virtual machines and containers.
+type GuestConfigDataSource struct {
+	client    *ProxmoxClient
+	guestType string
+}
+
+// GuestConfigDataSourceModel describes the data source data model.
+type GuestConfigDataSourceModel struct {
+	ID             types.String      `tfsdk:"id"`
+	Node           types.String      `tfsdk:"node"`
+	VMID           types.Int64       `tfsdk:"vm_id"`
+	Config         map[string]string `tfsdk:"config"`
+	Pending        map[string]string `tfsdk:"pending"`
+	PendingDeletes []string          `tfsdk:"pending_deletes"`
+}
+
+func (d *GuestConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + guestTypeName(d.guestType) + "_config"
+}
+
+func (d *GuestConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	label := guestLabel(d.guestType)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Reads the raw configuration of a Proxmox VE %[1]s, including changes that "+
+			"are pending until the %[1]s is restarted. Values are returned as the API reports them, e.g. property "+
+			"strings for devices.", label),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier in the `node/vm_id` format",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the " + label + " runs on",
+				Required:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the " + label,
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"config": schema.MapAttribute{
+				MarkdownDescription: "Current configuration of the " + label,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"pending": schema.MapAttribute{
+				MarkdownDescription: "Pending values of options that will change on the next restart",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"pending_deletes": schema.ListAttribute{
+				MarkdownDescription: "Options that will be removed on the next restart",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *GuestConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GuestConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GuestConfigDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, vmID := data.Node.ValueString(), data.VMID.ValueInt64()
+	data.ID = types.StringValue(formatID(node, strconv.FormatInt(vmID, 10)))
+
+	tflog.Debug(ctx, "Reading Proxmox guest configuration", map[string]interface{}{"id": data.ID.ValueString()})
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, guestPath(d.guestType, node, vmID)+"/pending", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Config, data.Pending, data.PendingDeletes = newGuestConfig(entries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newGuestConfig splits the entries of the pending configuration API into
+// the current values, the pending values and the options pending removal.
+func newGuestConfig(entries []map[string]interface{}) (map[string]string, map[string]string, []string) {
+	config, pending, deletes := map[string]string{}, map[string]string{}, []string{}
+	for _, entry := range entries {
+		key := stringValue(entry, "key").ValueString()
+		if value := stringValue(entry, "value"); !value.IsNull() {
+			config[key] = value.ValueString()
+		}
+		if value := stringValue(entry, "pending"); !value.IsNull() {
+			pending[key] = value.ValueString()
+		}
+		if boolValue(entry, "delete").ValueBool() {
+			deletes = append(deletes, key)
+		}
+	}
+	return config, pending, deletes
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMConfigDataSource(t *testing.T) {
+	testAccGuestConfigDataSource(t, "vm", testAccRequireEnv(t, "PROXMOX_VM_ID"))
+}
+
+func TestAccLXCConfigDataSource(t *testing.T) {
+	testAccGuestConfigDataSource(t, "lxc", testAccRequireEnv(t, "PROXMOX_LXC_ID"))
+}
+
+func testAccGuestConfigDataSource(t *testing.T, typeName, vmID string) {
+	dataSourceName := "data.proxmox_" + typeName + "_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_%[1]s_config" "test" {
+  node  = %[2]q
+  vm_id = %[3]s
+}
+`, typeName, testNode(), vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", testNode()+"/"+vmID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "config.memory"),
+				),
+			},
+		},
+	})
+}
+
+func TestNewGuestConfig(t *testing.T) {
+	config, pending, deletes := newGuestConfig([]map[string]interface{}{
+		{"key": "memory", "value": float64(2048), "pending": float64(4096)},
+		{"key": "name", "value": "db"},
+		{"key": "net1", "pending": "virtio,bridge=vmbr1"},
+		{"key": "onboot", "value": float64(1), "delete": float64(1)},
+	})
+
+	if want := map[string]string{"memory": "2048", "name": "db", "onboot": "1"}; !reflect.DeepEqual(config, want) {
+		t.Errorf("got config %v, want %v", config, want)
+	}
+	if want := map[string]string{"memory": "4096", "net1": "virtio,bridge=vmbr1"}; !reflect.DeepEqual(pending, want) {
+		t.Errorf("got pending %v, want %v", pending, want)
+	}
+	if want := []string{"onboot"}; !reflect.DeepEqual(deletes, want) {
+		t.Errorf("got pending deletes %v, want %v", deletes, want)
+	}
+}
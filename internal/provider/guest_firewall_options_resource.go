@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GuestFirewallOptionsResource{}
+var _ resource.ResourceWithImportState = &GuestFirewallOptionsResource{}
+
+func NewVMFirewallOptionsResource() resource.Resource {
+	return &GuestFirewallOptionsResource{guestType: guestTypeVM}
+}
+
+func NewLXCFirewallOptionsResource() resource.Resource {
+	return &GuestFirewallOptionsResource{guestType: guestTypeLXC}
+}
+
+// GuestFirewallOptionsResource defines the resource implementation, shared by
+// virtual machines and containers.
+type GuestFirewallOptionsResource struct {
+	client    *ProxmoxClient
+	guestType string
+}
+
+// GuestFirewallOptionsResourceModel describes the resource data model.
+type GuestFirewallOptionsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Node        types.String `tfsdk:"node"`
+	VMID        types.Int64  `tfsdk:"vm_id"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	DHCP        types.Bool   `tfsdk:"dhcp"`
+	IPFilter    types.Bool   `tfsdk:"ipfilter"`
+	MACFilter   types.Bool   `tfsdk:"macfilter"`
+	NDP         types.Bool   `tfsdk:"ndp"`
+	RAdv        types.Bool   `tfsdk:"radv"`
+	LogLevelIn  types.String `tfsdk:"log_level_in"`
+	LogLevelOut types.String `tfsdk:"log_level_out"`
+	PolicyIn    types.String `tfsdk:"policy_in"`
+	PolicyOut   types.String `tfsdk:"policy_out"`
+}
+
+// guestFirewallOptionsKeys lists the guest firewall options managed by this
+// resource, which are reset to their defaults on delete.
+var guestFirewallOptionsKeys = []string{
+	"enable", "dhcp", "ipfilter", "macfilter", "ndp", "radv", "log_level_in", "log_level_out", "policy_in", "policy_out",
+}
+
+func (r *GuestFirewallOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + guestTypeName(r.guestType) + "_firewall_options"
+}
+
+func (r *GuestFirewallOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	label := guestLabel(r.guestType)
+	logLevel := "Log level (`emerg`, `alert`, `crit`, `err`, `warning`, `notice`, `info`, `debug` or `nolog`) "
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages the firewall options of a Proxmox VE %s. Destroying the resource "+
+			"resets the options to their defaults.", label),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier in the `node/vm_id` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the " + label + " runs on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the " + label,
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the firewall of the guest. It also has to be enabled on the network devices",
+				Optional:            true,
+			},
+			"dhcp": schema.BoolAttribute{
+				MarkdownDescription: "Allow DHCP traffic",
+				Optional:            true,
+			},
+			"ipfilter": schema.BoolAttribute{
+				MarkdownDescription: "Enable default IP filters, only allowing the configured and link-local addresses",
+				Optional:            true,
+			},
+			"macfilter": schema.BoolAttribute{
+				MarkdownDescription: "Enable the MAC address filter",
+				Optional:            true,
+			},
+			"ndp": schema.BoolAttribute{
+				MarkdownDescription: "Enable NDP (Neighbor Discovery Protocol)",
+				Optional:            true,
+			},
+			"radv": schema.BoolAttribute{
+				MarkdownDescription: "Allow sending router advertisements",
+				Optional:            true,
+			},
+			"log_level_in": schema.StringAttribute{
+				MarkdownDescription: logLevel + "for incoming traffic",
+				Optional:            true,
+			},
+			"log_level_out": schema.StringAttribute{
+				MarkdownDescription: logLevel + "for outgoing traffic",
+				Optional:            true,
+			},
+			"policy_in": schema.StringAttribute{
+				MarkdownDescription: "Input policy, one of `ACCEPT`, `REJECT` or `DROP`",
+				Optional:            true,
+			},
+			"policy_out": schema.StringAttribute{
+				MarkdownDescription: "Output policy, one of `ACCEPT`, `REJECT` or `DROP`",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *GuestFirewallOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GuestFirewallOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GuestFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(formatID(data.Node.ValueString(), strconv.FormatInt(data.VMID.ValueInt64(), 10)))
+
+	if err := r.client.Put(ctx, r.path(data), data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall options of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created guest firewall options", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestFirewallOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GuestFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var options map[string]interface{}
+	err := r.client.Get(ctx, r.path(data), &options)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall options of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Enabled = boolValue(options, "enable")
+	data.DHCP = boolValue(options, "dhcp")
+	data.IPFilter = boolValue(options, "ipfilter")
+	data.MACFilter = boolValue(options, "macfilter")
+	data.NDP = boolValue(options, "ndp")
+	data.RAdv = boolValue(options, "radv")
+	data.LogLevelIn = stringValue(options, "log_level_in")
+	data.LogLevelOut = stringValue(options, "log_level_out")
+	data.PolicyIn = stringValue(options, "policy_in")
+	data.PolicyOut = stringValue(options, "policy_out")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestFirewallOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GuestFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data), data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall options of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestFirewallOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GuestFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := apiParams{}
+	for _, key := range guestFirewallOptionsKeys {
+		params.remove(key)
+	}
+
+	err := r.client.Put(ctx, r.path(data), params, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset firewall options of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *GuestFirewallOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	node, vmID, err := parseGuestID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), vmID)...)
+}
+
+func (r *GuestFirewallOptionsResource) path(data GuestFirewallOptionsResourceModel) string {
+	return guestPath(r.guestType, data.Node.ValueString(), data.VMID.ValueInt64()) + "/firewall/options"
+}
+
+// params returns the update parameters for the options. Options missing from
+// the configuration are reset to their defaults.
+func (m GuestFirewallOptionsResourceModel) params() apiParams {
+	params := apiParams{}
+	params.updateBool("enable", m.Enabled)
+	params.updateBool("dhcp", m.DHCP)
+	params.updateBool("ipfilter", m.IPFilter)
+	params.updateBool("macfilter", m.MACFilter)
+	params.updateBool("ndp", m.NDP)
+	params.updateBool("radv", m.RAdv)
+	params.updateString("log_level_in", m.LogLevelIn)
+	params.updateString("log_level_out", m.LogLevelOut)
+	params.updateString("policy_in", m.PolicyIn)
+	params.updateString("policy_out", m.PolicyOut)
+	return params
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMFirewallOptionsResource(t *testing.T) {
+	testAccGuestFirewallOptionsResource(t, "vm", testAccRequireEnv(t, "PROXMOX_VM_ID"))
+}
+
+func TestAccLXCFirewallOptionsResource(t *testing.T) {
+	testAccGuestFirewallOptionsResource(t, "lxc", testAccRequireEnv(t, "PROXMOX_LXC_ID"))
+}
+
+func testAccGuestFirewallOptionsResource(t *testing.T, typeName, vmID string) {
+	resourceName := "proxmox_" + typeName + "_firewall_options.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGuestFirewallOptionsResourceConfig(typeName, vmID, "DROP"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testNode()+"/"+vmID),
+					resource.TestCheckResourceAttr(resourceName, "policy_in", "DROP"),
+					resource.TestCheckResourceAttr(resourceName, "ipfilter", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGuestFirewallOptionsResourceConfig(typeName, vmID, "REJECT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_in", "REJECT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuestFirewallOptionsResourceConfig(typeName, vmID, policyIn string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_%[4]s_firewall_options" "test" {
+  node      = %[1]q
+  vm_id     = %[2]s
+  enabled   = true
+  ipfilter  = true
+  policy_in = %[3]q
+}
+`, testNode(), vmID, policyIn, typeName)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GuestFirewallRulesResource{}
+var _ resource.ResourceWithImportState = &GuestFirewallRulesResource{}
+
+func NewVMFirewallRulesResource() resource.Resource {
+	return &GuestFirewallRulesResource{guestType: guestTypeVM}
+}
+
+func NewLXCFirewallRulesResource() resource.Resource {
+	return &GuestFirewallRulesResource{guestType: guestTypeLXC}
+}
+
+// GuestFirewallRulesResource defines the resource implementation, shared by
+// virtual machines and containers.
+type GuestFirewallRulesResource struct {
+	client    *ProxmoxClient
+	guestType string
+}
+
+// GuestFirewallRulesResourceModel describes the resource data model.
+type GuestFirewallRulesResourceModel struct {
+	ID    types.String        `tfsdk:"id"`
+	Node  types.String        `tfsdk:"node"`
+	VMID  types.Int64         `tfsdk:"vm_id"`
+	Rules []FirewallRuleModel `tfsdk:"rule"`
+}
+
+func (r *GuestFirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + guestTypeName(r.guestType) + "_firewall_rules"
+}
+
+func (r *GuestFirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	label := guestLabel(r.guestType)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages the ordered list of firewall rules of a Proxmox VE %s. The resource "+
+			"is authoritative: rules that are not part of the configuration are removed. Security groups are "+
+			"referenced with rules of type `group`.", label),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier in the `node/vm_id` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the " + label + " runs on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the " + label,
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": firewallRuleBlock(),
+		},
+	}
+}
+
+func (r *GuestFirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GuestFirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GuestFirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(formatID(data.Node.ValueString(), strconv.FormatInt(data.VMID.ValueInt64(), 10)))
+
+	if err := syncFirewallRules(ctx, r.client, r.path(data), data.Rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall rules of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created guest firewall rules", map[string]interface{}{"id": data.ID.ValueString(), "count": len(data.Rules)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestFirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GuestFirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	rules, err := readFirewallRules(ctx, r.client, r.path(data))
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rules of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Rules = rules
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestFirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GuestFirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := syncFirewallRules(ctx, r.client, r.path(data), data.Rules); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall rules of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GuestFirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GuestFirewallRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := syncFirewallRules(ctx, r.client, r.path(data), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall rules of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *GuestFirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	node, vmID, err := parseGuestID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_id"), vmID)...)
+}
+
+func (r *GuestFirewallRulesResource) path(data GuestFirewallRulesResourceModel) string {
+	return guestPath(r.guestType, data.Node.ValueString(), data.VMID.ValueInt64()) + "/firewall/rules"
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMFirewallRulesResource(t *testing.T) {
+	testAccGuestFirewallRulesResource(t, "vm", testAccRequireEnv(t, "PROXMOX_VM_ID"))
+}
+
+func TestAccLXCFirewallRulesResource(t *testing.T) {
+	testAccGuestFirewallRulesResource(t, "lxc", testAccRequireEnv(t, "PROXMOX_LXC_ID"))
+}
+
+func testAccGuestFirewallRulesResource(t *testing.T, typeName, vmID string) {
+	resourceName := "proxmox_" + typeName + "_firewall_rules.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGuestFirewallRulesResourceConfig(typeName, vmID, `
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "HTTPS"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testNode()+"/"+vmID),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: insert a rule before the existing one
+			{
+				Config: testAccGuestFirewallRulesResourceConfig(typeName, vmID, `
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "SSH"
+  }
+
+  rule {
+    type   = "in"
+    action = "ACCEPT"
+    macro  = "HTTPS"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.macro", "SSH"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.macro", "HTTPS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuestFirewallRulesResourceConfig(typeName, vmID, rules string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_%[4]s_firewall_rules" "test" {
+  node  = %[1]q
+  vm_id = %[2]s
+%[3]s
+}
+`, testNode(), vmID, rules, typeName)
+}
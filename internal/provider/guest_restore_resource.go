@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/pveapi"
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// guestRestoreTimeouts are the default timeouts of restoring guests and
+// destroying them.
+var guestRestoreTimeouts = operationTimeouts{Create: time.Hour, Delete: 20 * time.Minute}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GuestRestoreResource{}
+var _ resource.ResourceWithImportState = &GuestRestoreResource{}
+var _ resource.ResourceWithIdentity = &GuestRestoreResource{}
+
+func NewVMRestoreResource() resource.Resource {
+	return &GuestRestoreResource{guestType: guestTypeVM}
+}
+
+func NewLXCRestoreResource() resource.Resource {
+	return &GuestRestoreResource{guestType: guestTypeLXC}
+}
+
+// GuestRestoreResource defines the resource implementation, shared by
+// virtual machines and containers.
+type GuestRestoreResource struct {
+	client    *ProxmoxClient
+	guestType string
+}
+
+// GuestRestoreResourceModel describes the resource data model.
+type GuestRestoreResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Node     types.String `tfsdk:"node"`
+	VMID     types.Int64  `tfsdk:"vm_id"`
+	Archive  types.String `tfsdk:"archive"`
+	Storage  types.String `tfsdk:"storage"`
+	Pool     types.String `tfsdk:"pool"`
+	Unique   types.Bool   `tfsdk:"unique"`
+	Force    types.Bool   `tfsdk:"force"`
+	Start    types.Bool   `tfsdk:"start"`
+	BWLimit  types.Int64  `tfsdk:"bwlimit"`
+	Triggers types.Map    `tfsdk:"triggers"`
+
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *GuestRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + guestTypeName(r.guestType) + "_restore"
+}
+
+func (r *GuestRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	label := guestLabel(r.guestType)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Restores a Proxmox VE %[1]s from a backup archive and waits for the restore "+
+			"to finish. Changing any argument other than `deletion_protection` restores the %[1]s again. Imported "+
+			"%[1]ss only take the arguments that cannot be read back from the configuration on the next apply, "+
+			"without being restored again. Destroying the resource stops and destroys the restored %[1]s.", label),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier in the `node/vm_id` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the " + label + " is restored on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the restored " + label,
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"archive": schema.StringAttribute{
+				MarkdownDescription: "Volume ID of the backup archive (e.g., `local:backup/vzdump-qemu-100-2024_01_01-02_00_00.vma.zst`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					restoreArgumentString(),
+				},
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Storage the disks are restored to. Defaults to the storages of the backup",
+				Optional:            true,
+				Validators:          []validator.String{validators.StorageID()},
+				PlanModifiers: []planmodifier.String{
+					restoreArgumentString(),
+				},
+			},
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "Pool the " + label + " is added to",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					restoreArgumentString(),
+				},
+			},
+			"unique": schema.BoolAttribute{
+				MarkdownDescription: "Regenerate unique properties such as MAC addresses, required when the original " +
+					label + " is still running",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					restoreArgumentBool(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Overwrite an existing " + label + " with the same ID",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					restoreArgumentBool(),
+				},
+			},
+			"start": schema.BoolAttribute{
+				MarkdownDescription: "Start the " + label + " after the restore",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					restoreArgumentBool(),
+				},
+			},
+			"bwlimit": schema.Int64Attribute{
+				MarkdownDescription: "I/O bandwidth limit of the restore in KiB/s",
+				Optional:            true,
+				Validators:          []validator.Int64{validators.APIParameter("POST /nodes/{node}/"+r.guestType, "bwlimit")},
+				PlanModifiers: []planmodifier.Int64{
+					restoreArgumentInt64(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that restore the " + label + " again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					restoreArgumentMap(),
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute(label),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, guestRestoreTimeouts.opts()),
+		},
+	}
+}
+
+func (r *GuestRestoreResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = guestIdentitySchema(guestLabel(r.guestType))
+}
+
+func (r *GuestRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GuestRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GuestRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, guestRestoreTimeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Both create APIs restore from a backup, containers take the archive as
+	// template and need the restore flag.
+	var params interface{}
+	if r.guestType == guestTypeLXC {
+		request := pveapi.CreateContainerRequest{
+			VMID:       data.VMID.ValueInt64(),
+			OSTemplate: data.Archive.ValueString(),
+			Restore:    pveapi.Ptr(pveapi.Bool(true)),
+			Storage:    optionalString(data.Storage),
+			Pool:       optionalString(data.Pool),
+			Unique:     optionalBool(data.Unique),
+			Force:      optionalBool(data.Force),
+			Start:      optionalBool(data.Start),
+		}
+		if bwLimit := optionalInt64(data.BWLimit); bwLimit != nil {
+			request.BWLimit = pveapi.Ptr(float64(*bwLimit))
+		}
+		params = request
+	} else {
+		params = pveapi.CreateVMRequest{
+			VMID:    data.VMID.ValueInt64(),
+			Archive: optionalString(data.Archive),
+			Storage: optionalString(data.Storage),
+			Pool:    optionalString(data.Pool),
+			Unique:  optionalBool(data.Unique),
+			Force:   optionalBool(data.Force),
+			Start:   optionalBool(data.Start),
+			BWLimit: optionalInt64(data.BWLimit),
+		}
+	}
+
+	node := data.Node.ValueString()
+
+	var upid string
+	err := r.client.Post(ctx, "/nodes/"+url.PathEscape(node)+"/"+r.guestType, params, &upid)
+	if err == nil {
+		err = r.client.waitForTask(ctx, upid)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore %s %d, got error: %s", guestLabel(r.guestType), data.VMID.ValueInt64(), err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, fmt.Sprint(data.VMID.ValueInt64())))
+
+	tflog.Trace(ctx, "restored "+guestLabel(r.guestType), map[string]interface{}{"vm_id": data.VMID.ValueInt64()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity, err := r.client.guestIdentity(ctx, data.VMID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity of %s %d, got error: %s", guestLabel(r.guestType), data.VMID.ValueInt64(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *GuestRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GuestRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	_, vmID, err := parseGuestID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	// The shared cluster resource list avoids a request per restored guest.
+	// Guests are looked up by ID only, so that guests migrated to another
+	// node, e.g. by HA, are not restored again.
+	entries, err := r.client.clusterResourcesOfType(ctx, r.guestType)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s %d, got error: %s", guestLabel(r.guestType), vmID, err))
+		return
+	}
+	for _, entry := range entries {
+		if int64Value(entry, "vmid").ValueInt64() != vmID {
+			continue
+		}
+
+		// The ID follows the guest, while the node argument keeps the node
+		// it was restored on, which only imported guests take from the
+		// cluster.
+		node := stringValue(entry, "node").ValueString()
+		data.ID = types.StringValue(formatID(node, fmt.Sprint(vmID)))
+		if data.Node.IsNull() {
+			data.Node = types.StringValue(node)
+		}
+		data.VMID = types.Int64Value(vmID)
+		// Imported guests are not protected until configured otherwise.
+		if data.DeletionProtection.IsNull() {
+			data.DeletionProtection = types.BoolValue(false)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		// The identity never changes, so it is only read for guests imported
+		// by ID or created by earlier versions of the provider.
+		if resp.Identity != nil && resp.Identity.Raw.IsNull() {
+			identity, err := r.client.guestIdentity(ctx, vmID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity of %s %d, got error: %s", guestLabel(r.guestType), vmID, err))
+				return
+			}
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+		}
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *GuestRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GuestRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	// All other arguments force a new restore, so only deletion_protection
+	// and the arguments of imported guests can change in place. The latter
+	// are in the state now, so later changes restore the guest again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, guestRestoreImportedKey, nil)...)
+}
+
+func (r *GuestRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GuestRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection,
+		fmt.Sprintf("%s %d", guestLabel(r.guestType), data.VMID.ValueInt64()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, guestRestoreTimeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// The ID has the node the guest is on, which differs from the node
+	// argument after migrations.
+	node, _, err := parseGuestID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	guest := guestPath(r.guestType, node, data.VMID.ValueInt64())
+
+	var status struct {
+		Status string `json:"status"`
+	}
+	err = r.client.Get(ctx, guest+"/status/current", &status)
+	if isNotFound(err) {
+		return
+	}
+	if err == nil && status.Status == "running" {
+		var upid string
+		err = r.client.Post(ctx, guest+"/status/stop", nil, &upid)
+		if err == nil {
+			err = r.client.waitForTask(ctx, upid)
+		}
+	}
+	if err == nil {
+		var upid string
+		err = r.client.Delete(ctx, guest+"?purge=1&destroy-unreferenced-disks=1", &upid)
+		if err == nil {
+			err = r.client.waitForTask(ctx, upid)
+		}
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to destroy %s %d, got error: %s", guestLabel(r.guestType), data.VMID.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *GuestRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity guestIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id, err := r.client.guestIDFromIdentity(ctx, r.guestType, identity)
+		if err != nil {
+			resp.Diagnostics.AddError("Unexpected Import Identity", err.Error())
+			return
+		}
+		// Complete the cluster, which is optional for import.
+		vmID := identity.VMID.ValueInt64()
+		identity, err = r.client.guestIdentity(ctx, vmID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity of %s %d, got error: %s", guestLabel(r.guestType), vmID, err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, guestRestoreImportedKey, []byte("true"))...)
+		return
+	}
+
+	if _, _, err := parseGuestID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, guestRestoreImportedKey, []byte("true"))...)
+}
+
+// guestRestoreImportedKey is the private state key marking imported guests,
+// whose restore arguments cannot be read back and are null in the state.
+const guestRestoreImportedKey = "imported"
+
+// privateState is the private state of plan modifier requests.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// restoreRequiresReplace reports whether a changed restore argument restores
+// the guest again. Arguments of imported guests that are still null in the
+// state are only recorded on the first apply, instead of replacing the guest
+// that was restored without this resource.
+func restoreRequiresReplace(ctx context.Context, private privateState, state attr.Value) (bool, diag.Diagnostics) {
+	if !state.IsNull() {
+		return true, nil
+	}
+	imported, diags := private.GetKey(ctx, guestRestoreImportedKey)
+	return imported == nil, diags
+}
+
+// restoreArgumentDescription describes the plan modifier of restore arguments.
+const restoreArgumentDescription = "Changing this argument restores the guest again, unless the guest was imported " +
+	"and the argument is set for the first time."
+
+// restoreArgumentString is the plan modifier of string restore arguments.
+func restoreArgumentString() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		var diags diag.Diagnostics
+		resp.RequiresReplace, diags = restoreRequiresReplace(ctx, req.Private, req.StateValue)
+		resp.Diagnostics.Append(diags...)
+	}, restoreArgumentDescription, restoreArgumentDescription)
+}
+
+// restoreArgumentBool is the plan modifier of bool restore arguments.
+func restoreArgumentBool() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+		var diags diag.Diagnostics
+		resp.RequiresReplace, diags = restoreRequiresReplace(ctx, req.Private, req.StateValue)
+		resp.Diagnostics.Append(diags...)
+	}, restoreArgumentDescription, restoreArgumentDescription)
+}
+
+// restoreArgumentInt64 is the plan modifier of int64 restore arguments.
+func restoreArgumentInt64() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+		var diags diag.Diagnostics
+		resp.RequiresReplace, diags = restoreRequiresReplace(ctx, req.Private, req.StateValue)
+		resp.Diagnostics.Append(diags...)
+	}, restoreArgumentDescription, restoreArgumentDescription)
+}
+
+// restoreArgumentMap is the plan modifier of map restore arguments.
+func restoreArgumentMap() planmodifier.Map {
+	return mapplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+		var diags diag.Diagnostics
+		resp.RequiresReplace, diags = restoreRequiresReplace(ctx, req.Private, req.StateValue)
+		resp.Diagnostics.Append(diags...)
+	}, restoreArgumentDescription, restoreArgumentDescription)
+}
@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+func TestAccVMRestoreResource(t *testing.T) {
+	testAccGuestRestoreResource(t, "vm", testAccRequireEnv(t, "PROXMOX_VM_ID"))
+}
+
+func TestAccLXCRestoreResource(t *testing.T) {
+	testAccGuestRestoreResource(t, "lxc", testAccRequireEnv(t, "PROXMOX_LXC_ID"))
+}
+
+// testAccGuestRestoreResource backs up the existing guest and restores the
+// backup as a new guest with ID 990.
+func testAccGuestRestoreResource(t *testing.T, typeName, vmID string) {
+	resourceName := "proxmox_" + typeName + "_restore.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGuestRestoreResourceConfig(typeName, vmID, "1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", testNode()+"/990"),
+					resource.TestCheckResourceAttrPair(resourceName, "archive", "proxmox_guest_backup.test", "volume_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"archive", "unique", "triggers"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGuestRestoreResourceConfig(typeName, vmID, "2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rehearsal", "2"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			// Deletion protection testing
+			{
+				Config:      testAccGuestRestoreResourceConfig(typeName, vmID, "3", true),
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccGuestRestoreResourceConfig(typeName, vmID, "2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuestRestoreResourceConfig(typeName, vmID, rehearsal string, protected bool) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_guest_backup" "test" {
+  node              = %[2]q
+  vm_id             = %[3]s
+  storage           = "local"
+  delete_on_destroy = true
+}
+
+resource "proxmox_%[1]s_restore" "test" {
+  node    = %[2]q
+  vm_id   = 990
+  archive = proxmox_guest_backup.test.volume_id
+  unique  = true
+
+  deletion_protection = %[5]t
+
+  triggers = {
+    rehearsal = %[4]q
+  }
+}
+`, typeName, testNode(), vmID, rehearsal, protected)
+}
+
+func TestVMRestoreResourceLifecycle(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 100, map[string]interface{}{"name": "web", "cores": "2", "memory": "2048"})
+
+	backup, err := h.create("proxmox_guest_backup", map[string]interface{}{
+		"node":              fakeproxmox.Node,
+		"vm_id":             100,
+		"storage":           "local",
+		"delete_on_destroy": true,
+	})
+	if err != nil {
+		t.Fatalf("unable to back up guest: %s", err)
+	}
+	archive, _ := backup.attributes()["volume_id"].(string)
+	if !strings.HasPrefix(archive, "local:backup/vzdump-qemu-100-") {
+		t.Fatalf("unexpected backup volume %q", archive)
+	}
+
+	config := map[string]interface{}{
+		"node":    fakeproxmox.Node,
+		"vm_id":   990,
+		"archive": archive,
+		"start":   true,
+	}
+	restored, err := h.create("proxmox_vm_restore", config)
+	if err != nil {
+		t.Fatalf("unable to restore guest: %s", err)
+	}
+	if got := restored.attributes()["id"]; got != fakeproxmox.Node+"/990" {
+		t.Errorf("expected ID %s/990, got %v", fakeproxmox.Node, got)
+	}
+	guestConfig, running, ok := h.Fake.Guest(990)
+	if !ok || !running || guestConfig["name"] != "web" || guestConfig["cores"] != "2" {
+		t.Errorf("expected a running copy of guest 100, got %v (running: %t)", guestConfig, running)
+	}
+
+	if _, err := h.create("proxmox_vm_restore", config); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected restoring onto an existing guest to fail, got %v", err)
+	}
+
+	config["deletion_protection"] = true
+	if err := h.update(restored, config); err != nil {
+		t.Fatalf("unable to enable deletion protection: %s", err)
+	}
+	if err := h.destroy(restored); err == nil || !strings.Contains(err.Error(), "Deletion Protection Enabled") {
+		t.Fatalf("expected deletion protection to prevent destroying, got %v", err)
+	}
+	if _, _, ok := h.Fake.Guest(990); !ok {
+		t.Fatal("expected the protected guest to be kept")
+	}
+
+	config["deletion_protection"] = false
+	if err := h.update(restored, config); err != nil {
+		t.Fatalf("unable to disable deletion protection: %s", err)
+	}
+	// A lock held by another operation delays the destroy, which is retried.
+	h.Fake.HoldLock(990, 1)
+	if err := h.destroy(restored); err != nil {
+		t.Fatalf("unable to destroy guest: %s", err)
+	}
+	if _, _, ok := h.Fake.Guest(990); ok {
+		t.Error("expected the restored guest to be destroyed")
+	}
+
+	if err := h.destroy(backup); err != nil {
+		t.Fatalf("unable to delete backup: %s", err)
+	}
+	if volumes := h.Fake.Volumes("local"); len(volumes) != 0 {
+		t.Errorf("expected the backup to be deleted, got %v", volumes)
+	}
+}
+
+func TestVMRestoreResourceTaskFailure(t *testing.T) {
+	h := newTestHarness(t)
+	archive := h.Fake.AddBackup("local", fakeproxmox.QEMU, 100, map[string]interface{}{"name": "web"})
+
+	h.Fake.FailNextTask("unable to restore VM 990 - no space left on device")
+	_, err := h.create("proxmox_vm_restore", map[string]interface{}{
+		"node":    fakeproxmox.Node,
+		"vm_id":   990,
+		"archive": archive,
+	})
+	if err == nil || !strings.Contains(err.Error(), "no space left on device") {
+		t.Fatalf("expected the task error, got %v", err)
+	}
+	if _, _, ok := h.Fake.Guest(990); ok {
+		t.Error("expected no guest after the failed restore")
+	}
+}
+
+func TestVMRestoreResourceIdentity(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.ClusterName = "lab"
+	archive := h.Fake.AddBackup("local", fakeproxmox.QEMU, 100, map[string]interface{}{"name": "web"})
+
+	restored, err := h.create("proxmox_vm_restore", map[string]interface{}{
+		"node":    fakeproxmox.Node,
+		"vm_id":   990,
+		"archive": archive,
+	})
+	if err != nil {
+		t.Fatalf("unable to restore guest: %s", err)
+	}
+	want := map[string]interface{}{"cluster": "lab", "vm_id": int64(990)}
+	if got := h.identityAttributes(restored); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected identity %v, got %v", want, got)
+	}
+
+	// Guests imported by ID get their identity when read.
+	imported, err := h.importResource("proxmox_vm_restore", fakeproxmox.Node+"/990")
+	if err != nil {
+		t.Fatalf("unable to import guest: %s", err)
+	}
+	if got := h.identityAttributes(imported); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected identity %v after import by ID, got %v", want, got)
+	}
+
+	// The node is looked up and the cluster completed when importing by
+	// identity.
+	imported, err = h.importResourceByIdentity("proxmox_vm_restore", map[string]interface{}{"vm_id": 990})
+	if err != nil {
+		t.Fatalf("unable to import guest by identity: %s", err)
+	}
+	if got := imported.attributes()["id"]; got != fakeproxmox.Node+"/990" {
+		t.Errorf("expected ID %s/990, got %v", fakeproxmox.Node, got)
+	}
+	if got := h.identityAttributes(imported); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected identity %v after import by identity, got %v", want, got)
+	}
+
+	for _, test := range []struct {
+		identity map[string]interface{}
+		message  string
+	}{
+		{map[string]interface{}{"cluster": "prod", "vm_id": 990}, "belongs to cluster prod, but the provider is configured for cluster lab"},
+		{map[string]interface{}{"vm_id": 991}, "virtual machine 991 does not exist"},
+	} {
+		if _, err := h.importResourceByIdentity("proxmox_vm_restore", test.identity); err == nil || !strings.Contains(err.Error(), test.message) {
+			t.Errorf("%v: expected error containing %q, got %v", test.identity, test.message, err)
+		}
+	}
+}
+
+func TestVMRestoreResourceImport(t *testing.T) {
+	h := newTestHarness(t)
+	archive := h.Fake.AddBackup("local", fakeproxmox.QEMU, 100, map[string]interface{}{"name": "web"})
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 990, map[string]interface{}{"name": "web"})
+
+	imported, err := h.importResource("proxmox_vm_restore", fakeproxmox.Node+"/990")
+	if err != nil {
+		t.Fatalf("unable to import guest: %s", err)
+	}
+
+	// The restore arguments cannot be read back, so they are taken from the
+	// configuration without restoring the guest again.
+	config := map[string]interface{}{
+		"node":    fakeproxmox.Node,
+		"vm_id":   990,
+		"archive": archive,
+		"storage": "local",
+		"force":   true,
+	}
+	configValue := h.value(h.resourceSchema("proxmox_vm_restore").Block, config)
+	plan, err := h.plan(imported, configValue)
+	if err != nil {
+		t.Fatalf("unable to plan imported guest: %s", err)
+	}
+	if len(plan.RequiresReplace) != 0 {
+		t.Fatalf("expected the imported guest to be kept, got replacement for %v", plan.RequiresReplace)
+	}
+	if err := h.update(imported, config); err != nil {
+		t.Fatalf("unable to update imported guest: %s", err)
+	}
+	for _, request := range h.Fake.Requests() {
+		if strings.HasPrefix(request, "DELETE ") || strings.HasPrefix(request, "POST ") {
+			t.Errorf("expected no restore or destroy, got %s", request)
+		}
+	}
+
+	plan, err = h.plan(imported, configValue)
+	if err != nil {
+		t.Fatalf("unable to plan imported guest: %s", err)
+	}
+	if planned := h.unmarshal("proxmox_vm_restore", plan.PlannedState); !planned.Equal(imported.state) {
+		t.Errorf("expected an empty plan after the update, got %v", planned)
+	}
+
+	// Changes after the first apply restore the guest again.
+	config["storage"] = "data"
+	plan, err = h.plan(imported, h.value(h.resourceSchema("proxmox_vm_restore").Block, config))
+	if err != nil {
+		t.Fatalf("unable to plan imported guest: %s", err)
+	}
+	if len(plan.RequiresReplace) != 1 {
+		t.Errorf("expected a storage change to restore the guest again, got %v", plan.RequiresReplace)
+	}
+}
+
+func TestVMRestoreResourceMigrated(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddNode("pve2")
+	archive := h.Fake.AddBackup("local", fakeproxmox.QEMU, 100, map[string]interface{}{"name": "web"})
+
+	restored, err := h.create("proxmox_vm_restore", map[string]interface{}{
+		"node":    fakeproxmox.Node,
+		"vm_id":   990,
+		"archive": archive,
+	})
+	if err != nil {
+		t.Fatalf("unable to restore guest: %s", err)
+	}
+
+	h.Fake.MoveGuest(990, "pve2")
+	if ok, err := h.refresh(restored); err != nil || !ok {
+		t.Fatalf("expected the migrated guest to be kept, got %t, %v", ok, err)
+	}
+	attributes := restored.attributes()
+	if attributes["id"] != "pve2/990" || attributes["node"] != fakeproxmox.Node {
+		t.Errorf("expected ID pve2/990 and node %s, got %v and %v", fakeproxmox.Node, attributes["id"], attributes["node"])
+	}
+
+	if err := h.destroy(restored); err != nil {
+		t.Fatalf("unable to destroy guest: %s", err)
+	}
+	if _, _, ok := h.Fake.Guest(990); ok {
+		t.Error("expected the migrated guest to be destroyed")
+	}
+}
+
+func TestVMRestoreResourceImportByIdentityMigrated(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddNode("pve2")
+	archive := h.Fake.AddBackup("local", fakeproxmox.QEMU, 100, map[string]interface{}{"name": "web"})
+	h.Fake.AddGuest(fakeproxmox.QEMU, "pve2", 990, map[string]interface{}{"name": "web"})
+
+	// The guest is found on the node it was migrated to, which the node
+	// argument of imported guests takes.
+	imported, err := h.importResourceByIdentity("proxmox_vm_restore", map[string]interface{}{"vm_id": 990})
+	if err != nil {
+		t.Fatalf("unable to import guest by identity: %s", err)
+	}
+	attributes := imported.attributes()
+	if attributes["id"] != "pve2/990" || attributes["node"] != "pve2" {
+		t.Errorf("expected ID pve2/990 and node pve2, got %v and %v", attributes["id"], attributes["node"])
+	}
+
+	plan, err := h.plan(imported, h.value(h.resourceSchema("proxmox_vm_restore").Block, map[string]interface{}{
+		"node":    "pve2",
+		"vm_id":   990,
+		"archive": archive,
+	}))
+	if err != nil {
+		t.Fatalf("unable to plan imported guest: %s", err)
+	}
+	if len(plan.RequiresReplace) != 0 {
+		t.Errorf("expected the imported guest to be kept, got replacement for %v", plan.RequiresReplace)
+	}
+}
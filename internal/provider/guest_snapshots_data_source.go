@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GuestSnapshotsDataSource{}
+
+func NewVMSnapshotsDataSource() datasource.DataSource {
+	return &GuestSnapshotsDataSource{guestType: guestTypeVM}
+}
+
+func NewLXCSnapshotsDataSource() datasource.DataSource {
+	return &GuestSnapshotsDataSource{guestType: guestTypeLXC}
+}
+
+// GuestSnapshotsDataSource defines the data source implementation, shared by
+// virtual machines and containers.
+type GuestSnapshotsDataSource struct {
+	client    *ProxmoxClient
+	guestType string
+}
+
+// GuestSnapshotsDataSourceModel describes the data source data model.
+type GuestSnapshotsDataSourceModel struct {
+	ID        types.String    `tfsdk:"id"`
+	Node      types.String    `tfsdk:"node"`
+	VMID      types.Int64     `tfsdk:"vm_id"`
+	Current   types.String    `tfsdk:"current"`
+	Snapshots []SnapshotModel `tfsdk:"snapshots"`
+}
+
+// SnapshotModel describes a snapshot of a guest.
+type SnapshotModel struct {
+	Name         types.String `tfsdk:"name"`
+	Parent       types.String `tfsdk:"parent"`
+	Description  types.String `tfsdk:"description"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+	VMState      types.Bool   `tfsdk:"vm_state"`
+}
+
+func (d *GuestSnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + guestTypeName(d.guestType) + "_snapshots"
+}
+
+func (d *GuestSnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	label := guestLabel(d.guestType)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the snapshots of a Proxmox VE %s, oldest first.", label),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier in the `node/vm_id` format",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the " + label + " runs on",
+				Required:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the " + label,
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"current": schema.StringAttribute{
+				MarkdownDescription: "Name of the snapshot the current state is based on, null if there is none",
+				Computed:            true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				MarkdownDescription: "Snapshots, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the snapshot",
+							Computed:            true,
+						},
+						"parent": schema.StringAttribute{
+							MarkdownDescription: "Name of the parent snapshot, null for the first snapshot",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the snapshot",
+							Computed:            true,
+						},
+						"creation_time": schema.Int64Attribute{
+							MarkdownDescription: "Creation time as Unix timestamp",
+							Computed:            true,
+						},
+						"vm_state": schema.BoolAttribute{
+							MarkdownDescription: "Whether the snapshot includes the RAM of the running virtual machine",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GuestSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GuestSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GuestSnapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, vmID := data.Node.ValueString(), data.VMID.ValueInt64()
+	data.ID = types.StringValue(formatID(node, strconv.FormatInt(vmID, 10)))
+
+	tflog.Debug(ctx, "Reading Proxmox guest snapshots", map[string]interface{}{"id": data.ID.ValueString()})
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, guestPath(d.guestType, node, vmID)+"/snapshot", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read snapshots of guest %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Current = types.StringNull()
+	data.Snapshots = []SnapshotModel{}
+	for _, entry := range entries {
+		// The list includes the current state as a pseudo snapshot, whose
+		// parent is the snapshot it is based on.
+		if stringValue(entry, "name").ValueString() == "current" {
+			data.Current = stringValue(entry, "parent")
+			continue
+		}
+		data.Snapshots = append(data.Snapshots, SnapshotModel{
+			Name:         stringValue(entry, "name"),
+			Parent:       stringValue(entry, "parent"),
+			Description:  stringValue(entry, "description"),
+			CreationTime: int64Value(entry, "snaptime"),
+			VMState:      types.BoolValue(boolValue(entry, "vmstate").ValueBool()),
+		})
+	}
+	sort.SliceStable(data.Snapshots, func(i, j int) bool {
+		return data.Snapshots[i].CreationTime.ValueInt64() < data.Snapshots[j].CreationTime.ValueInt64()
+	})
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d snapshots", len(data.Snapshots)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
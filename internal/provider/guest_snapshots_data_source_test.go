@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMSnapshotsDataSource(t *testing.T) {
+	testAccGuestSnapshotsDataSource(t, "vm", testAccRequireEnv(t, "PROXMOX_VM_ID"))
+}
+
+func TestAccLXCSnapshotsDataSource(t *testing.T) {
+	testAccGuestSnapshotsDataSource(t, "lxc", testAccRequireEnv(t, "PROXMOX_LXC_ID"))
+}
+
+func testAccGuestSnapshotsDataSource(t *testing.T, typeName, vmID string) {
+	dataSourceName := "data.proxmox_" + typeName + "_snapshots.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_%[1]s_snapshots" "test" {
+  node  = %[2]q
+  vm_id = %[3]s
+}
+`, typeName, testNode(), vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", testNode()+"/"+vmID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshots.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HAGroupResource{}
+var _ resource.ResourceWithImportState = &HAGroupResource{}
+
+func NewHAGroupResource() resource.Resource {
+	return &HAGroupResource{}
+}
+
+// HAGroupResource defines the resource implementation.
+type HAGroupResource struct {
+	client *ProxmoxClient
+}
+
+// HAGroupResourceModel describes the resource data model.
+type HAGroupResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Group      types.String `tfsdk:"group"`
+	Nodes      types.Map    `tfsdk:"nodes"`
+	Restricted types.Bool   `tfsdk:"restricted"`
+	NoFailback types.Bool   `tfsdk:"nofailback"`
+	Comment    types.String `tfsdk:"comment"`
+}
+
+func (r *HAGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_group"
+}
+
+func (r *HAGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Proxmox VE HA group, restricting the nodes HA resources run on.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the group name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Name of the HA group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nodes": schema.MapAttribute{
+				MarkdownDescription: "Members of the group, mapping node names to their priority. Resources run on the " +
+					"available node with the highest priority",
+				ElementType: types.Int64Type,
+				Required:    true,
+			},
+			"restricted": schema.BoolAttribute{
+				MarkdownDescription: "Only run resources on the group members. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"nofailback": schema.BoolAttribute{
+				MarkdownDescription: "Do not migrate resources back to a node with higher priority when it becomes " +
+					"available again. Defaults to `false`",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the group",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *HAGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HAGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HAGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("group", data.Group)
+	params["type"] = "group"
+
+	if err := r.client.Post(ctx, "/cluster/ha/groups", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HA group %s, got error: %s", data.Group.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Group
+
+	tflog.Trace(ctx, "created HA group", map[string]interface{}{"group": data.Group.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HAGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HAGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var group map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &group)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HA group %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Group = data.ID
+	data.Nodes = haGroupNodesValue(stringValue(group, "nodes").ValueString())
+	data.Restricted = boolValue(group, "restricted")
+	if data.Restricted.IsNull() {
+		data.Restricted = types.BoolValue(false)
+	}
+	data.NoFailback = boolValue(group, "nofailback")
+	if data.NoFailback.IsNull() {
+		data.NoFailback = types.BoolValue(false)
+	}
+	data.Comment = stringValue(group, "comment")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HAGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HAGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.Group.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HA group %s, got error: %s", data.Group.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HAGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HAGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.Group.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HA group %s, got error: %s", data.Group.ValueString(), err))
+		return
+	}
+}
+
+func (r *HAGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *HAGroupResource) path(group string) string {
+	return "/cluster/ha/groups/" + url.PathEscape(group)
+}
+
+// params returns the API parameters of the group. When update is set, unset
+// optional fields are removed from the existing group.
+func (m HAGroupResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params["nodes"] = haGroupNodes(m.Nodes)
+	params.setBool("restricted", m.Restricted)
+	params.setBool("nofailback", m.NoFailback)
+	if update {
+		params.updateString("comment", m.Comment)
+	} else {
+		params.setString("comment", m.Comment)
+	}
+	return params
+}
+
+// haGroupNodes formats the node priorities in the `node:priority` list
+// format of the API, sorted by node name.
+func haGroupNodes(nodes types.Map) string {
+	var items []string
+	for node, elem := range nodes.Elements() {
+		if priority, ok := elem.(types.Int64); ok {
+			items = append(items, node+":"+strconv.FormatInt(priority.ValueInt64(), 10))
+		}
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// haGroupNodesValue parses the nodes of a group. Nodes without priority have
+// the priority 0.
+func haGroupNodesValue(s string) types.Map {
+	elems := map[string]attr.Value{}
+	for _, item := range splitList(s) {
+		node, priority, _ := strings.Cut(item, ":")
+		p, _ := strconv.ParseInt(priority, 10, 64)
+		elems[node] = types.Int64Value(p)
+	}
+	return types.MapValueMust(types.Int64Type, elems)
+}
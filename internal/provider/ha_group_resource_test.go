@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHAGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccHAGroupResourceConfig(2, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_ha_group.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_ha_group.test", "nodes."+testNode(), "2"),
+					resource.TestCheckResourceAttr("proxmox_ha_group.test", "restricted", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_ha_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccHAGroupResourceConfig(5, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_ha_group.test", "nodes."+testNode(), "5"),
+					resource.TestCheckResourceAttr("proxmox_ha_group.test", "restricted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHAGroupResourceConfig(priority int, restricted bool) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_ha_group" "test" {
+  group      = "tfacc"
+  restricted = %[3]t
+  comment    = "Managed by Terraform"
+
+  nodes = {
+    %[1]q = %[2]d
+  }
+}
+`, testNode(), priority, restricted)
+}
+
+func TestHAGroupNodes(t *testing.T) {
+	nodes := haGroupNodesValue("pve2:1,pve1:2,pve3")
+
+	if got, want := haGroupNodes(nodes), "pve1:2,pve2:1,pve3:0"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HAResourceResource{}
+var _ resource.ResourceWithImportState = &HAResourceResource{}
+
+func NewHAResourceResource() resource.Resource {
+	return &HAResourceResource{}
+}
+
+// HAResourceResource defines the resource implementation.
+type HAResourceResource struct {
+	client *ProxmoxClient
+}
+
+// HAResourceResourceModel describes the resource data model.
+type HAResourceResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	VMID        types.Int64  `tfsdk:"vm_id"`
+	Type        types.String `tfsdk:"type"`
+	State       types.String `tfsdk:"state"`
+	Group       types.String `tfsdk:"group"`
+	MaxRestart  types.Int64  `tfsdk:"max_restart"`
+	MaxRelocate types.Int64  `tfsdk:"max_relocate"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+func (r *HAResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_resource"
+}
+
+func (r *HAResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Puts a virtual machine or container under Proxmox VE high availability management.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, the HA resource ID in the `type:vm_id` format (e.g., `vm:100`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the virtual machine or container",
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Guest type, `vm` for virtual machines or `ct` for containers. Defaults to `vm`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("vm"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Requested state, one of `started`, `stopped`, `disabled` or `ignored`. Defaults to `started`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("started"),
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "HA group the guest is assigned to, see `proxmox_ha_group`",
+				Optional:            true,
+			},
+			"max_restart": schema.Int64Attribute{
+				MarkdownDescription: "Maximal number of restart attempts on the same node after a start failure. Defaults to `1`",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"max_relocate": schema.Int64Attribute{
+				MarkdownDescription: "Maximal number of relocation attempts after a start failure. Defaults to `1`",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the HA resource",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *HAResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HAResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HAResourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sid := data.Type.ValueString() + ":" + strconv.FormatInt(data.VMID.ValueInt64(), 10)
+
+	params := data.params(false)
+	params["sid"] = sid
+
+	if err := r.client.Post(ctx, "/cluster/ha/resources", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HA resource %s, got error: %s", sid, err))
+		return
+	}
+
+	data.ID = types.StringValue(sid)
+
+	tflog.Trace(ctx, "created HA resource", map[string]interface{}{"sid": sid})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HAResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HAResourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	guestType, vmID, err := parseHAResourceID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	var haResource map[string]interface{}
+	err = r.client.Get(ctx, r.path(data.ID.ValueString()), &haResource)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HA resource %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.VMID = types.Int64Value(vmID)
+	data.Type = types.StringValue(guestType)
+	data.State = stringValue(haResource, "state")
+	if data.State.IsNull() {
+		data.State = types.StringValue("started")
+	}
+	data.Group = stringValue(haResource, "group")
+	data.MaxRestart = int64Value(haResource, "max_restart")
+	if data.MaxRestart.IsNull() {
+		data.MaxRestart = types.Int64Value(1)
+	}
+	data.MaxRelocate = int64Value(haResource, "max_relocate")
+	if data.MaxRelocate.IsNull() {
+		data.MaxRelocate = types.Int64Value(1)
+	}
+	data.Comment = stringValue(haResource, "comment")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HAResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HAResourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.ID.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HA resource %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HAResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HAResourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HA resource %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *HAResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, err := parseHAResourceID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *HAResourceResource) path(sid string) string {
+	return "/cluster/ha/resources/" + url.PathEscape(sid)
+}
+
+// params returns the API parameters of the HA resource. When update is set,
+// unset optional fields are removed from the existing resource.
+func (m HAResourceResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("state", m.State)
+	params.setInt64("max_restart", m.MaxRestart)
+	params.setInt64("max_relocate", m.MaxRelocate)
+
+	setString := params.setString
+	if update {
+		setString = params.updateString
+	}
+	setString("group", m.Group)
+	setString("comment", m.Comment)
+
+	return params
+}
+
+// parseHAResourceID splits an HA resource ID such as `vm:100` into the guest
+// type and ID.
+func parseHAResourceID(sid string) (string, int64, error) {
+	guestType, id, _ := strings.Cut(sid, ":")
+	if guestType != "vm" && guestType != "ct" {
+		return "", 0, fmt.Errorf("unexpected ID %q, expected format vm:vm_id or ct:vm_id", sid)
+	}
+	vmID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected ID %q, the VM ID must be numeric", sid)
+	}
+	return guestType, vmID, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHAResourceResource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccHAResourceResourceConfig(vmID, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_ha_resource.test", "id", "vm:"+vmID),
+					resource.TestCheckResourceAttr("proxmox_ha_resource.test", "state", "ignored"),
+					resource.TestCheckResourceAttr("proxmox_ha_resource.test", "max_relocate", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_ha_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccHAResourceResourceConfig(vmID, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_ha_resource.test", "max_restart", "3"),
+				),
+			},
+		},
+	})
+}
+
+// The resource is kept in the ignored state so the test does not start or
+// stop the guest.
+func testAccHAResourceResourceConfig(vmID string, maxRestart int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_ha_resource" "test" {
+  vm_id       = %[1]s
+  state       = "ignored"
+  max_restart = %[2]d
+  comment     = "Managed by Terraform"
+}
+`, vmID, maxRestart)
+}
@@ -0,0 +1,559 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+// testHarness drives the provider through the plugin protocol against a
+// fake Proxmox VE API server, the way Terraform does during plan and apply.
+// It covers resource and data source logic without Terraform CLI or a
+// cluster, while acceptance tests remain the reference for real behavior.
+type testHarness struct {
+	t      *testing.T
+	ctx    context.Context
+	server tfprotov6.ProviderServer
+	schema *tfprotov6.GetProviderSchemaResponse
+
+	// identitySchemas are the identity schemas of the resources that
+	// support identities, by type name.
+	identitySchemas map[string]*tfprotov6.ResourceIdentitySchema
+
+	// Fake is the API server the provider is configured with.
+	Fake *fakeproxmox.Server
+}
+
+// testResource is a resource instance managed through a testHarness.
+type testResource struct {
+	typeName string
+	state    tftypes.Value
+	private  []byte
+	identity *tfprotov6.ResourceIdentityData
+}
+
+// newTestHarness starts a fake API server and configures the provider for
+// it, with a short task poll interval.
+func newTestHarness(t *testing.T) *testHarness {
+	t.Helper()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unable to create provider server: %s", err)
+	}
+
+	h := &testHarness{t: t, ctx: context.Background(), server: server, Fake: fakeproxmox.New(t)}
+
+	h.schema, err = server.GetProviderSchema(h.ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unable to get provider schema: %s", err)
+	}
+	if err := diagnosticsError(h.schema.Diagnostics); err != nil {
+		t.Fatalf("unable to get provider schema: %s", err)
+	}
+
+	identitySchemas, err := server.GetResourceIdentitySchemas(h.ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("unable to get resource identity schemas: %s", err)
+	}
+	if err := diagnosticsError(identitySchemas.Diagnostics); err != nil {
+		t.Fatalf("unable to get resource identity schemas: %s", err)
+	}
+	h.identitySchemas = identitySchemas.IdentitySchemas
+
+	config := h.value(h.schema.Provider.Block, map[string]interface{}{
+		"endpoint":           h.Fake.URL,
+		"token_id":           fakeproxmox.TokenID,
+		"token_secret":       fakeproxmox.TokenSecret,
+		"task_poll_interval": "10ms",
+	})
+	resp, err := server.ConfigureProvider(h.ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.9.0",
+		Config:           h.dynamicValue(config),
+	})
+	if err != nil {
+		t.Fatalf("unable to configure provider: %s", err)
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		t.Fatalf("unable to configure provider: %s", err)
+	}
+
+	return h
+}
+
+// create plans and applies a new resource with the given configuration.
+func (h *testHarness) create(typeName string, config map[string]interface{}) (*testResource, error) {
+	h.t.Helper()
+
+	r := &testResource{typeName: typeName, state: tftypes.NewValue(h.resourceType(typeName), nil)}
+	return r, h.apply(r, config)
+}
+
+// update plans and applies a change of the configuration of a resource,
+// replacing it if the plan requires so.
+func (h *testHarness) update(r *testResource, config map[string]interface{}) error {
+	h.t.Helper()
+	return h.apply(r, config)
+}
+
+// refresh reads the resource. It returns false if the resource was removed
+// from the state because it no longer exists.
+func (h *testHarness) refresh(r *testResource) (bool, error) {
+	h.t.Helper()
+
+	resp, err := h.server.ReadResource(h.ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:        r.typeName,
+		CurrentState:    h.dynamicValue(r.state),
+		CurrentIdentity: r.identity,
+		Private:         r.private,
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return false, err
+	}
+
+	r.state, r.private, r.identity = h.unmarshal(r.typeName, resp.NewState), resp.Private, resp.NewIdentity
+	return !r.state.IsNull(), nil
+}
+
+// destroy plans and applies the deletion of a resource.
+func (h *testHarness) destroy(r *testResource) error {
+	h.t.Helper()
+
+	null := tftypes.NewValue(h.resourceType(r.typeName), nil)
+	if _, err := h.plan(r, null); err != nil {
+		return err
+	}
+	if err := h.applyPlanned(r, null, nil, nil); err != nil {
+		return err
+	}
+	r.state, r.identity = null, nil
+	return nil
+}
+
+// importResource imports an existing object by its import ID and reads it.
+func (h *testHarness) importResource(typeName, id string) (*testResource, error) {
+	h.t.Helper()
+	return h.importRequest(&tfprotov6.ImportResourceStateRequest{TypeName: typeName, ID: id})
+}
+
+// importResourceByIdentity imports an existing object by the attributes of
+// its identity and reads it.
+func (h *testHarness) importResourceByIdentity(typeName string, identity map[string]interface{}) (*testResource, error) {
+	h.t.Helper()
+
+	value, err := tfValue(h.identityType(typeName), identity)
+	if err != nil {
+		h.t.Fatalf("invalid identity: %s", err)
+	}
+	return h.importRequest(&tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		Identity: &tfprotov6.ResourceIdentityData{IdentityData: h.dynamicValue(value)},
+	})
+}
+
+func (h *testHarness) importRequest(req *tfprotov6.ImportResourceStateRequest) (*testResource, error) {
+	typeName := req.TypeName
+	resp, err := h.server.ImportResourceState(h.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+	if len(resp.ImportedResources) != 1 {
+		return nil, fmt.Errorf("expected 1 imported resource, got %d", len(resp.ImportedResources))
+	}
+
+	imported := resp.ImportedResources[0]
+	r := &testResource{typeName: typeName, state: h.unmarshal(typeName, imported.State), private: imported.Private, identity: imported.Identity}
+	if ok, err := h.refresh(r); err != nil || !ok {
+		return nil, errors.Join(err, errors.New("imported resource does not exist"))
+	}
+	return r, nil
+}
+
+// readDataSource reads a data source with the given configuration and
+// returns its attributes.
+func (h *testHarness) readDataSource(typeName string, config map[string]interface{}) (map[string]interface{}, error) {
+	h.t.Helper()
+
+	schema, ok := h.schema.DataSourceSchemas[typeName]
+	if !ok {
+		h.t.Fatalf("unknown data source %s", typeName)
+	}
+	configValue := h.value(schema.Block, config)
+
+	validate, err := h.server.ValidateDataResourceConfig(h.ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: typeName,
+		Config:   h.dynamicValue(configValue),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(validate.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.server.ReadDataSource(h.ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: typeName,
+		Config:   h.dynamicValue(configValue),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	state, err := resp.State.Unmarshal(schema.ValueType())
+	if err != nil {
+		return nil, err
+	}
+	return goValue(state).(map[string]interface{}), nil
+}
+
+// apply validates and plans the configuration and applies the plan. Changes
+// that require replacement destroy the resource before creating it again.
+func (h *testHarness) apply(r *testResource, config map[string]interface{}) error {
+	h.t.Helper()
+
+	schema := h.resourceSchema(r.typeName)
+	configValue := h.value(schema.Block, config)
+
+	validate, err := h.server.ValidateResourceConfig(h.ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: r.typeName,
+		Config:   h.dynamicValue(configValue),
+		// Like Terraform 1.11 and later, allow write-only attributes.
+		ClientCapabilities: &tfprotov6.ValidateResourceConfigClientCapabilities{WriteOnlyAttributesAllowed: true},
+	})
+	if err != nil {
+		return err
+	}
+	if err := diagnosticsError(validate.Diagnostics); err != nil {
+		return err
+	}
+
+	plan, err := h.plan(r, configValue)
+	if err != nil {
+		return err
+	}
+	if !r.state.IsNull() && len(plan.RequiresReplace) > 0 {
+		if err := h.destroy(r); err != nil {
+			return err
+		}
+		if plan, err = h.plan(r, configValue); err != nil {
+			return err
+		}
+	}
+
+	return h.applyPlanned(r, h.unmarshal(r.typeName, plan.PlannedState), plan.PlannedPrivate, plan.PlannedIdentity, configValue)
+}
+
+// plan plans the change of the resource to the configuration.
+func (h *testHarness) plan(r *testResource, config tftypes.Value) (*tfprotov6.PlanResourceChangeResponse, error) {
+	schema := h.resourceSchema(r.typeName)
+
+	resp, err := h.server.PlanResourceChange(h.ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         r.typeName,
+		PriorState:       h.dynamicValue(r.state),
+		ProposedNewState: h.dynamicValue(proposedNewState(schema.Block, r.state, config)),
+		Config:           h.dynamicValue(config),
+		PriorPrivate:     r.private,
+		PriorIdentity:    r.identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, diagnosticsError(resp.Diagnostics)
+}
+
+// applyPlanned applies a planned state, which is null for deletions.
+func (h *testHarness) applyPlanned(r *testResource, planned tftypes.Value, private []byte, identity *tfprotov6.ResourceIdentityData, config ...tftypes.Value) error {
+	configValue := tftypes.NewValue(h.resourceType(r.typeName), nil)
+	if len(config) > 0 {
+		configValue = config[0]
+	}
+	if private == nil {
+		private = r.private
+	}
+
+	resp, err := h.server.ApplyResourceChange(h.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:        r.typeName,
+		PriorState:      h.dynamicValue(r.state),
+		PlannedState:    h.dynamicValue(planned),
+		Config:          h.dynamicValue(configValue),
+		PlannedPrivate:  private,
+		PlannedIdentity: identity,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Like Terraform, keep the state of resources whose creation failed
+	// partially, so that they can be destroyed.
+	if resp.NewState != nil {
+		if state := h.unmarshal(r.typeName, resp.NewState); !state.IsNull() || planned.IsNull() {
+			r.state, r.private = state, resp.Private
+			if resp.NewIdentity != nil {
+				r.identity = resp.NewIdentity
+			}
+		}
+	}
+	return diagnosticsError(resp.Diagnostics)
+}
+
+// attributes returns the attributes of the resource state.
+func (r *testResource) attributes() map[string]interface{} {
+	if r.state.IsNull() {
+		return nil
+	}
+	return goValue(r.state).(map[string]interface{})
+}
+
+// identityAttributes returns the attributes of the identity of the resource,
+// or nil if the provider returned none.
+func (h *testHarness) identityAttributes(r *testResource) map[string]interface{} {
+	if r.identity == nil || r.identity.IdentityData == nil {
+		return nil
+	}
+	value, err := r.identity.IdentityData.Unmarshal(h.identityType(r.typeName))
+	if err != nil {
+		h.t.Fatalf("unable to decode identity: %s", err)
+	}
+	if value.IsNull() {
+		return nil
+	}
+	return goValue(value).(map[string]interface{})
+}
+
+func (h *testHarness) identityType(typeName string) tftypes.Type {
+	schema, ok := h.identitySchemas[typeName]
+	if !ok {
+		h.t.Fatalf("resource %s has no identity", typeName)
+	}
+	return schema.ValueType()
+}
+
+func (h *testHarness) resourceSchema(typeName string) *tfprotov6.Schema {
+	schema, ok := h.schema.ResourceSchemas[typeName]
+	if !ok {
+		h.t.Fatalf("unknown resource %s", typeName)
+	}
+	return schema
+}
+
+func (h *testHarness) resourceType(typeName string) tftypes.Type {
+	return h.resourceSchema(typeName).ValueType()
+}
+
+func (h *testHarness) dynamicValue(value tftypes.Value) *tfprotov6.DynamicValue {
+	dv, err := tfprotov6.NewDynamicValue(value.Type(), value)
+	if err != nil {
+		h.t.Fatalf("unable to encode value: %s", err)
+	}
+	return &dv
+}
+
+func (h *testHarness) unmarshal(typeName string, dv *tfprotov6.DynamicValue) tftypes.Value {
+	value, err := dv.Unmarshal(h.resourceType(typeName))
+	if err != nil {
+		h.t.Fatalf("unable to decode value: %s", err)
+	}
+	return value
+}
+
+// value converts configuration given as Go values to a value of the schema
+// block. Missing attributes and blocks are null.
+func (h *testHarness) value(block *tfprotov6.SchemaBlock, config map[string]interface{}) tftypes.Value {
+	value, err := tfValue(block.ValueType(), config)
+	if err != nil {
+		h.t.Fatalf("invalid configuration: %s", err)
+	}
+	return value
+}
+
+// tfValue converts a Go value to a value of the given type. Numbers may be
+// given as int, int64 or float64, collections as []interface{} and maps and
+// objects as map[string]interface{}.
+func tfValue(typ tftypes.Type, value interface{}) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected object, got %T", value)
+		}
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range typ.AttributeTypes {
+			attribute, err := tfValue(attributeType, values[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+			attributes[name] = attribute
+		}
+		for name := range values {
+			if _, ok := typ.AttributeTypes[name]; !ok {
+				return tftypes.Value{}, fmt.Errorf("unsupported attribute %q", name)
+			}
+		}
+		return tftypes.NewValue(typ, attributes), nil
+	case tftypes.List, tftypes.Set:
+		values, ok := value.([]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected collection, got %T", value)
+		}
+		var elementType tftypes.Type
+		if list, ok := typ.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = typ.(tftypes.Set).ElementType
+		}
+		elements := []tftypes.Value{}
+		for _, v := range values {
+			element, err := tfValue(elementType, v)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements = append(elements, element)
+		}
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.Map:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected map, got %T", value)
+		}
+		elements := map[string]tftypes.Value{}
+		for key, v := range values {
+			element, err := tfValue(typ.ElementType, v)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements[key] = element
+		}
+		return tftypes.NewValue(typ, elements), nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		return tftypes.NewValue(typ, big.NewFloat(float64(v))), nil
+	case int64:
+		return tftypes.NewValue(typ, new(big.Float).SetInt64(v)), nil
+	case float64:
+		return tftypes.NewValue(typ, big.NewFloat(v)), nil
+	}
+	return tftypes.NewValue(typ, value), nil
+}
+
+// goValue converts a value to Go values as accepted by tfValue, with whole
+// numbers as int64. Unknown values are returned as tftypes.UnknownValue.
+func goValue(value tftypes.Value) interface{} {
+	if !value.IsKnown() {
+		return tftypes.UnknownValue
+	}
+	if value.IsNull() {
+		return nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.Object{}), typ.Is(tftypes.Map{}):
+		var values map[string]tftypes.Value
+		_ = value.As(&values)
+		converted := map[string]interface{}{}
+		for name, v := range values {
+			converted[name] = goValue(v)
+		}
+		return converted
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		var values []tftypes.Value
+		_ = value.As(&values)
+		converted := []interface{}{}
+		for _, v := range values {
+			converted = append(converted, goValue(v))
+		}
+		return converted
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		_ = value.As(&n)
+		if n.IsInt() {
+			i, _ := n.Int64()
+			return i
+		}
+		f, _ := n.Float64()
+		return f
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return b
+	}
+	var s string
+	_ = value.As(&s)
+	return s
+}
+
+// proposedNewState merges the configuration with the prior state like
+// Terraform does before planning: computed attributes that are not
+// configured keep their prior value.
+func proposedNewState(block *tfprotov6.SchemaBlock, prior, config tftypes.Value) tftypes.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return config
+	}
+
+	var priorValues, configValues map[string]tftypes.Value
+	if !prior.IsNull() && prior.IsKnown() {
+		_ = prior.As(&priorValues)
+	}
+	_ = config.As(&configValues)
+
+	proposed := map[string]tftypes.Value{}
+	for name, value := range configValues {
+		proposed[name] = value
+	}
+	for _, attribute := range block.Attributes {
+		if attribute.Computed && configValues[attribute.Name].IsNull() {
+			if priorValue, ok := priorValues[attribute.Name]; ok {
+				proposed[attribute.Name] = priorValue
+			}
+		}
+	}
+	for _, nested := range block.BlockTypes {
+		if nested.Nesting != tfprotov6.SchemaNestedBlockNestingModeSingle {
+			continue
+		}
+		priorValue, ok := priorValues[nested.TypeName]
+		if !ok {
+			priorValue = tftypes.NewValue(nested.Block.ValueType(), nil)
+		}
+		proposed[nested.TypeName] = proposedNewState(nested.Block, priorValue, configValues[nested.TypeName])
+	}
+
+	return tftypes.NewValue(config.Type(), proposed)
+}
+
+// diagnosticsError returns the error diagnostics as a single error.
+func diagnosticsError(diagnostics []*tfprotov6.Diagnostic) error {
+	var errs []error
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, errors.New(diagnostic.Summary+": "+diagnostic.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ISOImagesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ISOImagesDataSource{}
+
+func NewISOImagesDataSource() datasource.DataSource {
+	return &ISOImagesDataSource{}
+}
+
+// ISOImagesDataSource defines the data source implementation.
+type ISOImagesDataSource struct {
+	client *ProxmoxClient
+}
+
+// ISOImagesDataSourceModel describes the data source data model.
+type ISOImagesDataSourceModel struct {
+	ID        types.String    `tfsdk:"id"`
+	Node      types.String    `tfsdk:"node"`
+	Storages  []string        `tfsdk:"storages"`
+	Name      types.String    `tfsdk:"name"`
+	NameRegex types.String    `tfsdk:"name_regex"`
+	Filters   []FilterModel   `tfsdk:"filter"`
+	Images    []ISOImageModel `tfsdk:"images"`
+	Latest    *ISOImageModel  `tfsdk:"latest"`
+}
+
+// ISOImageModel describes an ISO image on a storage.
+type ISOImageModel struct {
+	VolumeID     types.String `tfsdk:"volume_id"`
+	Storage      types.String `tfsdk:"storage"`
+	Name         types.String `tfsdk:"name"`
+	Size         types.Int64  `tfsdk:"size"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+}
+
+func (d *ISOImagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iso_images"
+}
+
+func isoImageAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"volume_id": schema.StringAttribute{
+			MarkdownDescription: "Volume ID of the image (e.g., `local:iso/ubuntu-24.04-live-server-amd64.iso`)",
+			Computed:            true,
+		},
+		"storage": schema.StringAttribute{
+			MarkdownDescription: "Storage the image is stored on",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "File name of the image",
+			Computed:            true,
+		},
+		"size": schema.Int64Attribute{
+			MarkdownDescription: "Size of the image in bytes",
+			Computed:            true,
+		},
+		"creation_time": schema.Int64Attribute{
+			MarkdownDescription: "Upload time of the image as Unix timestamp",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *ISOImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the ISO images available on a Proxmox VE node, across all storages holding ISO " +
+			"images unless limited to some. `latest` returns the newest match, e.g. the latest Ubuntu release.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the storages are accessed from",
+				Required:            true,
+			},
+			"storages": schema.ListAttribute{
+				MarkdownDescription: "Only list images on these storages",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{validators.StorageID()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list images with this file name",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list images whose file name matches this regular expression",
+				Optional:            true,
+			},
+			"images": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching images, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: isoImageAttributes(),
+				},
+			},
+			"latest": schema.SingleNestedAttribute{
+				MarkdownDescription: "Newest matching image, null if no image matches",
+				Computed:            true,
+				Attributes:          isoImageAttributes(),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("images"),
+		},
+	}
+}
+
+func (d *ISOImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ISOImagesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The regular expression is invalid: %s", err),
+		)
+	}
+}
+
+func (d *ISOImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ISOImagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	nodePath := "/nodes/" + url.PathEscape(node)
+
+	tflog.Debug(ctx, "Reading Proxmox ISO images", map[string]interface{}{"node": node})
+
+	storages := data.Storages
+	if storages == nil {
+		var entries []map[string]interface{}
+		if err := d.client.GetCached(ctx, nodePath+"/storage?content=iso&enabled=1", &entries); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storages of node %s, got error: %s", node, err))
+			return
+		}
+		for _, entry := range entries {
+			storages = append(storages, stringValue(entry, "storage").ValueString())
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+	}
+
+	images := []ISOImageModel{}
+	for _, storage := range storages {
+		var entries []map[string]interface{}
+		if err := d.client.GetCached(ctx, nodePath+"/storage/"+url.PathEscape(storage)+"/content?content=iso", &entries); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ISO images on storage %s, got error: %s", storage, err))
+			return
+		}
+		for _, entry := range entries {
+			image := newISOImageModel(storage, entry)
+			name := image.Name.ValueString()
+			if !data.Name.IsNull() && name != data.Name.ValueString() {
+				continue
+			}
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+			images = append(images, image)
+		}
+	}
+	images, diags := applyFilters(data.Filters, images)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sortISOImages(images)
+
+	data.ID = data.Node
+	data.Images = images
+	data.Latest = nil
+	if len(images) > 0 {
+		data.Latest = &images[0]
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d ISO images", len(images)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newISOImageModel converts an entry of the storage content list. The file
+// name is the part of the volume ID after the "iso/" directory.
+func newISOImageModel(storage string, entry map[string]interface{}) ISOImageModel {
+	volumeID := stringValue(entry, "volid").ValueString()
+	_, name, _ := strings.Cut(volumeID, ":")
+	name = strings.TrimPrefix(name, "iso/")
+
+	return ISOImageModel{
+		VolumeID:     types.StringValue(volumeID),
+		Storage:      types.StringValue(storage),
+		Name:         types.StringValue(name),
+		Size:         int64Value(entry, "size"),
+		CreationTime: int64Value(entry, "ctime"),
+	}
+}
+
+// sortISOImages sorts images newest first. Images uploaded at the same time
+// are sorted by name in descending order, so that the higher version of
+// consistently named images comes first.
+func sortISOImages(images []ISOImageModel) {
+	sort.Slice(images, func(i, j int) bool {
+		if ti, tj := images[i].CreationTime.ValueInt64(), images[j].CreationTime.ValueInt64(); ti != tj {
+			return ti > tj
+		}
+		return strings.Compare(images[i].Name.ValueString(), images[j].Name.ValueString()) > 0
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccISOImagesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_iso_images" "test" {
+  node       = %[1]q
+  storages   = ["local"]
+  name_regex = "^no-such-image-"
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_iso_images.test", "id", testNode()),
+					resource.TestCheckResourceAttr("data.proxmox_iso_images.test", "images.#", "0"),
+					resource.TestCheckNoResourceAttr("data.proxmox_iso_images.test", "latest"),
+				),
+			},
+			// Invalid regular expression
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_iso_images" "test" {
+  node       = %[1]q
+  name_regex = "ubuntu-("
+}
+`, testNode()),
+				ExpectError: regexp.MustCompile(`The regular expression is invalid`),
+			},
+		},
+	})
+}
+
+func TestISOImageModels(t *testing.T) {
+	images := []ISOImageModel{
+		newISOImageModel("local", map[string]interface{}{"volid": "local:iso/ubuntu-22.04.iso", "ctime": float64(100)}),
+		newISOImageModel("nfs", map[string]interface{}{"volid": "nfs:iso/ubuntu-24.04.iso", "ctime": float64(200), "size": float64(3)}),
+		newISOImageModel("nfs", map[string]interface{}{"volid": "nfs:iso/ubuntu-24.10.iso", "ctime": float64(200)}),
+	}
+	sortISOImages(images)
+
+	if got := images[0].Name.ValueString(); got != "ubuntu-24.10.iso" {
+		t.Errorf("got newest image %q", got)
+	}
+	if got := images[1]; got.VolumeID.ValueString() != "nfs:iso/ubuntu-24.04.iso" || got.Storage.ValueString() != "nfs" || got.Size.ValueInt64() != 3 {
+		t.Errorf("unexpected image %+v", got)
+	}
+	if got := images[2].Name.ValueString(); got != "ubuntu-22.04.iso" {
+		t.Errorf("got oldest image %q", got)
+	}
+}
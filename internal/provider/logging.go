@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// This file contains the fields added to log entries, so that the entries of
+// one resource or API request can be found in the TF_LOG output of large
+// runs. The framework already adds tf_req_id, tf_rpc and tf_resource_type to
+// all entries of a Terraform request. Terraform does not send resource
+// addresses to providers, so resources add their ID instead, and the client
+// adds an ID to each API request that is kept across its retries.
+
+// Log fields added by the provider.
+const (
+	logFieldResourceID   = "resource_id"
+	logFieldAPIRequestID = "api_request_id"
+)
+
+// withResourceID returns ctx with the ID of a resource added to its log
+// entries. Unknown and null IDs, e.g. during creation, are not added.
+func withResourceID(ctx context.Context, id types.String) context.Context {
+	if id.IsNull() || id.IsUnknown() {
+		return ctx
+	}
+	return tflog.SetField(ctx, logFieldResourceID, id.ValueString())
+}
+
+// withAPIRequestID returns ctx with a new random API request ID added to its
+// log entries.
+func withAPIRequestID(ctx context.Context) context.Context {
+	return tflog.SetField(ctx, logFieldAPIRequestID, fmt.Sprintf("%08x", rand.Uint32()))
+}
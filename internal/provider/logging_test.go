@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRequestLogFields(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, lockErrorBody)
+			return
+		}
+		fmt.Fprint(w, `{"data":null}`)
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, requestRetryDelay: time.Millisecond}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = withResourceID(ctx, types.StringValue("pve1/vmbr0"))
+	ctx = withResourceID(ctx, types.StringUnknown())
+
+	if err := client.Put(ctx, "/nodes/pve1/network/vmbr0", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.Get(ctx, "/nodes/pve1/network", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode log entries: %s", err)
+	}
+
+	requestIDs := map[string]map[interface{}]bool{}
+	for _, entry := range entries {
+		if entry[logFieldResourceID] != "pve1/vmbr0" {
+			t.Errorf("expected the resource ID in entry %v", entry)
+		}
+		path, _ := entry["path"].(string)
+		if requestIDs[path] == nil {
+			requestIDs[path] = map[interface{}]bool{}
+		}
+		requestIDs[path][entry[logFieldAPIRequestID]] = true
+	}
+	if len(entries) != 4 {
+		t.Errorf("expected 2 attempts, a retry and a second request to be logged, got %v", entries)
+	}
+	put, get := requestIDs["/nodes/pve1/network/vmbr0"], requestIDs["/nodes/pve1/network"]
+	if len(put) != 1 || len(get) != 1 || put[nil] || get[nil] {
+		t.Fatalf("expected one API request ID per request, got %v and %v", put, get)
+	}
+	for id := range put {
+		if get[id] {
+			t.Errorf("expected different API request IDs, got %v for both", id)
+		}
+	}
+}
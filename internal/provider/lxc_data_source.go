@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	// lxcNetworkKeyPattern matches the configuration keys of container network
+	// interfaces.
+	lxcNetworkKeyPattern = regexp.MustCompile(`^net\d+$`)
+	// lxcMountPointKeyPattern matches the configuration keys of the root
+	// filesystem and mount points of a container.
+	lxcMountPointKeyPattern = regexp.MustCompile(`^(rootfs|mp\d+)$`)
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LXCDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &LXCDataSource{}
+
+func NewLXCDataSource() datasource.DataSource {
+	return &LXCDataSource{}
+}
+
+// LXCDataSource defines the data source implementation.
+type LXCDataSource struct {
+	client *ProxmoxClient
+}
+
+// LXCDataSourceModel describes the data source data model.
+type LXCDataSourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	VMID         types.Int64          `tfsdk:"vm_id"`
+	Name         types.String         `tfsdk:"name"`
+	Node         types.String         `tfsdk:"node"`
+	Status       types.String         `tfsdk:"status"`
+	Uptime       types.Int64          `tfsdk:"uptime"`
+	Lock         types.String         `tfsdk:"lock"`
+	Template     types.Bool           `tfsdk:"template"`
+	Pool         types.String         `tfsdk:"pool"`
+	Tags         []string             `tfsdk:"tags"`
+	Description  types.String         `tfsdk:"description"`
+	OSType       types.String         `tfsdk:"os_type"`
+	Arch         types.String         `tfsdk:"arch"`
+	Cores        types.Int64          `tfsdk:"cores"`
+	Memory       types.Int64          `tfsdk:"memory"`
+	Swap         types.Int64          `tfsdk:"swap"`
+	Unprivileged types.Bool           `tfsdk:"unprivileged"`
+	OnBoot       types.Bool           `tfsdk:"on_boot"`
+	Networks     []LXCNetworkModel    `tfsdk:"networks"`
+	MountPoints  []LXCMountPointModel `tfsdk:"mount_points"`
+}
+
+// LXCNetworkModel describes a network interface of a container.
+type LXCNetworkModel struct {
+	Key        types.String `tfsdk:"key"`
+	Name       types.String `tfsdk:"name"`
+	Bridge     types.String `tfsdk:"bridge"`
+	MACAddress types.String `tfsdk:"mac_address"`
+	IPv4       types.String `tfsdk:"ipv4"`
+	Gateway    types.String `tfsdk:"gateway"`
+	IPv6       types.String `tfsdk:"ipv6"`
+	Gateway6   types.String `tfsdk:"gateway6"`
+	VLAN       types.Int64  `tfsdk:"vlan"`
+	Firewall   types.Bool   `tfsdk:"firewall"`
+	MTU        types.Int64  `tfsdk:"mtu"`
+}
+
+// LXCMountPointModel describes the root filesystem or a mount point of a
+// container.
+type LXCMountPointModel struct {
+	Key      types.String `tfsdk:"key"`
+	Volume   types.String `tfsdk:"volume"`
+	Storage  types.String `tfsdk:"storage"`
+	Path     types.String `tfsdk:"path"`
+	Size     types.String `tfsdk:"size"`
+	Backup   types.Bool   `tfsdk:"backup"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
+}
+
+func (d *LXCDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lxc"
+}
+
+func (d *LXCDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current configuration and status of a container, looked up by ID or hostname.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier in the `node/vm_id` format",
+				Computed:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the container. Either `vm_id` or `name` must be set",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the container. Either `vm_id` or `name` must be set",
+				Optional:            true,
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Node the container is located on. When set, only this node is searched",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the container (e.g., `running` or `stopped`)",
+				Computed:            true,
+			},
+			"uptime": schema.Int64Attribute{
+				MarkdownDescription: "Uptime in seconds",
+				Computed:            true,
+			},
+			"lock": schema.StringAttribute{
+				MarkdownDescription: "Lock held on the container (e.g., `backup` or `mounted`)",
+				Computed:            true,
+			},
+			"template": schema.BoolAttribute{
+				MarkdownDescription: "Whether the container is a template",
+				Computed:            true,
+			},
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "Pool the container is a member of",
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the container, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Notes of the container",
+				Computed:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system type of the container (e.g., `debian` or `alpine`)",
+				Computed:            true,
+			},
+			"arch": schema.StringAttribute{
+				MarkdownDescription: "Architecture of the container (e.g., `amd64`)",
+				Computed:            true,
+			},
+			"cores": schema.Int64Attribute{
+				MarkdownDescription: "Number of CPU cores, null if the container may use all cores of the node",
+				Computed:            true,
+			},
+			"memory": schema.Int64Attribute{
+				MarkdownDescription: "Memory in MiB",
+				Computed:            true,
+			},
+			"swap": schema.Int64Attribute{
+				MarkdownDescription: "Swap in MiB",
+				Computed:            true,
+			},
+			"unprivileged": schema.BoolAttribute{
+				MarkdownDescription: "Whether the container is unprivileged",
+				Computed:            true,
+			},
+			"on_boot": schema.BoolAttribute{
+				MarkdownDescription: "Whether the container is started when the node boots",
+				Computed:            true,
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "Network interfaces, sorted by key",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Configuration key of the interface (e.g., `net0`)",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the interface inside the container (e.g., `eth0`)",
+							Computed:            true,
+						},
+						"bridge": schema.StringAttribute{
+							MarkdownDescription: "Bridge the interface is attached to",
+							Computed:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the interface",
+							Computed:            true,
+						},
+						"ipv4": schema.StringAttribute{
+							MarkdownDescription: "IPv4 address in CIDR notation, `dhcp` or `manual`",
+							Computed:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "IPv4 gateway",
+							Computed:            true,
+						},
+						"ipv6": schema.StringAttribute{
+							MarkdownDescription: "IPv6 address in CIDR notation, `auto`, `dhcp` or `manual`",
+							Computed:            true,
+						},
+						"gateway6": schema.StringAttribute{
+							MarkdownDescription: "IPv6 gateway",
+							Computed:            true,
+						},
+						"vlan": schema.Int64Attribute{
+							MarkdownDescription: "VLAN tag of the interface",
+							Computed:            true,
+						},
+						"firewall": schema.BoolAttribute{
+							MarkdownDescription: "Whether the firewall is enabled on the interface",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the interface",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"mount_points": schema.ListNestedAttribute{
+				MarkdownDescription: "Root filesystem and mount points, sorted by key",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Configuration key (`rootfs` or e.g. `mp0`)",
+							Computed:            true,
+						},
+						"volume": schema.StringAttribute{
+							MarkdownDescription: "Volume ID, or the host path of bind mounts",
+							Computed:            true,
+						},
+						"storage": schema.StringAttribute{
+							MarkdownDescription: "Storage of the volume, null for bind mounts",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path inside the container",
+							Computed:            true,
+						},
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Size of the volume (e.g., `8G`)",
+							Computed:            true,
+						},
+						"backup": schema.BoolAttribute{
+							MarkdownDescription: "Whether the mount point is included in backups",
+							Computed:            true,
+						},
+						"read_only": schema.BoolAttribute{
+							MarkdownDescription: "Whether the mount point is read-only",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LXCDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LXCDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data LXCDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.VMID.IsNull() && data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vm_id"),
+			"Missing Attribute Configuration",
+			"Either vm_id or name must be set to look up the container.",
+		)
+	}
+}
+
+func (d *LXCDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LXCDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox container", map[string]interface{}{"vm_id": data.VMID.ValueInt64(), "name": data.Name.ValueString()})
+
+	entry, err := findGuest(ctx, d.client, guestTypeLXC, data.VMID.ValueInt64(), data.Name.ValueString(), data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find container, got error: %s", err))
+		return
+	}
+
+	node, vmID := stringValue(entry, "node").ValueString(), int64Value(entry, "vmid").ValueInt64()
+	lxcPath := guestPath(guestTypeLXC, node, vmID)
+
+	var config, status map[string]interface{}
+	if err := d.client.Get(ctx, lxcPath+"/config", &config); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration of container %d, got error: %s", vmID, err))
+		return
+	}
+	if err := d.client.Get(ctx, lxcPath+"/status/current", &status); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status of container %d, got error: %s", vmID, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, strconv.FormatInt(vmID, 10)))
+	data.VMID = types.Int64Value(vmID)
+	data.Name = stringValue(config, "hostname")
+	data.Node = types.StringValue(node)
+	data.Status = stringValue(status, "status")
+	data.Uptime = int64Value(status, "uptime")
+	data.Lock = stringValue(status, "lock")
+	data.Template = types.BoolValue(boolValue(config, "template").ValueBool())
+	data.Pool = stringValue(entry, "pool")
+	data.Tags = splitList(stringValue(config, "tags").ValueString())
+	sort.Strings(data.Tags)
+	data.Description = stringValue(config, "description")
+	data.OSType = stringValue(config, "ostype")
+	data.Arch = stringValue(config, "arch")
+	data.Cores = int64Value(config, "cores")
+	data.Memory = int64Value(config, "memory")
+	data.Swap = int64Value(config, "swap")
+	data.Unprivileged = types.BoolValue(boolValue(config, "unprivileged").ValueBool())
+	data.OnBoot = types.BoolValue(boolValue(config, "onboot").ValueBool())
+	data.Networks, data.MountPoints = newLXCDeviceModels(config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newLXCDeviceModels returns the network interfaces and mount points of a
+// container configuration, sorted by key.
+func newLXCDeviceModels(config map[string]interface{}) ([]LXCNetworkModel, []LXCMountPointModel) {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	networks, mountPoints := []LXCNetworkModel{}, []LXCMountPointModel{}
+	for _, key := range keys {
+		switch {
+		case lxcNetworkKeyPattern.MatchString(key):
+			props := propertyStringValues(config, key, "name")
+			networks = append(networks, LXCNetworkModel{
+				Key:        types.StringValue(key),
+				Name:       propertyStringValue(props, "name"),
+				Bridge:     propertyStringValue(props, "bridge"),
+				MACAddress: propertyStringValue(props, "hwaddr"),
+				IPv4:       propertyStringValue(props, "ip"),
+				Gateway:    propertyStringValue(props, "gw"),
+				IPv6:       propertyStringValue(props, "ip6"),
+				Gateway6:   propertyStringValue(props, "gw6"),
+				VLAN:       propertyInt64Value(props, "tag"),
+				Firewall:   types.BoolValue(propertyBoolValue(props, "firewall").ValueBool()),
+				MTU:        propertyInt64Value(props, "mtu"),
+			})
+		case lxcMountPointKeyPattern.MatchString(key):
+			props := propertyStringValues(config, key, "volume")
+			volume := propertyStringValue(props, "volume")
+			storage := types.StringNull()
+			if name, _, ok := strings.Cut(volume.ValueString(), ":"); ok {
+				storage = types.StringValue(name)
+			}
+			mountPath := propertyStringValue(props, "mp")
+			if key == "rootfs" {
+				mountPath = types.StringValue("/")
+			}
+			// Only mount points are excluded from backups by default.
+			backup := propertyBoolValue(props, "backup")
+			if backup.IsNull() {
+				backup = types.BoolValue(key == "rootfs")
+			}
+			mountPoints = append(mountPoints, LXCMountPointModel{
+				Key:      types.StringValue(key),
+				Volume:   volume,
+				Storage:  storage,
+				Path:     mountPath,
+				Size:     propertyStringValue(props, "size"),
+				Backup:   backup,
+				ReadOnly: types.BoolValue(propertyBoolValue(props, "ro").ValueBool()),
+			})
+		}
+	}
+	return networks, mountPoints
+}
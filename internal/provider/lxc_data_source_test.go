@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLXCDataSource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_LXC_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_lxc" "test" {
+  vm_id = %[1]s
+}
+`, vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_lxc.test", "vm_id", vmID),
+					resource.TestCheckResourceAttrSet("data.proxmox_lxc.test", "name"),
+					resource.TestCheckResourceAttrSet("data.proxmox_lxc.test", "status"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_lxc.test", "mount_points.*", map[string]string{
+						"key":  "rootfs",
+						"path": "/",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestLXCDeviceModels(t *testing.T) {
+	config := map[string]interface{}{
+		"net0":     "name=eth0,bridge=vmbr0,hwaddr=BC:24:11:00:00:01,ip=10.0.0.5/24,gw=10.0.0.1,firewall=1,tag=20",
+		"rootfs":   "local-lvm:vm-200-disk-0,size=8G",
+		"mp0":      "local-lvm:vm-200-disk-1,mp=/srv/data,backup=1,size=16G",
+		"mp1":      "/mnt/shared,mp=/mnt/shared,ro=1",
+		"hostname": "web1",
+	}
+
+	networks, mountPoints := newLXCDeviceModels(config)
+	if len(networks) != 1 || len(mountPoints) != 3 {
+		t.Fatalf("unexpected devices %v, %v", networks, mountPoints)
+	}
+
+	net0 := networks[0]
+	if net0.Name.ValueString() != "eth0" || net0.IPv4.ValueString() != "10.0.0.5/24" || net0.VLAN.ValueInt64() != 20 ||
+		!net0.Firewall.ValueBool() {
+		t.Errorf("unexpected net0 %+v", net0)
+	}
+
+	mp0, mp1, rootfs := mountPoints[0], mountPoints[1], mountPoints[2]
+	if mp0.Path.ValueString() != "/srv/data" || !mp0.Backup.ValueBool() || mp0.Storage.ValueString() != "local-lvm" {
+		t.Errorf("unexpected mp0 %+v", mp0)
+	}
+	if !mp1.Storage.IsNull() || mp1.Backup.ValueBool() || !mp1.ReadOnly.ValueBool() {
+		t.Errorf("unexpected mp1 %+v", mp1)
+	}
+	if rootfs.Path.ValueString() != "/" || !rootfs.Backup.ValueBool() || rootfs.Size.ValueString() != "8G" {
+		t.Errorf("unexpected rootfs %+v", rootfs)
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	metricsServerInfluxDB = "influxdb"
+	metricsServerGraphite = "graphite"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetricsServerResource{}
+var _ resource.ResourceWithImportState = &MetricsServerResource{}
+var _ resource.ResourceWithValidateConfig = &MetricsServerResource{}
+
+func NewMetricsServerResource() resource.Resource {
+	return &MetricsServerResource{}
+}
+
+// MetricsServerResource defines the resource implementation.
+type MetricsServerResource struct {
+	client *ProxmoxClient
+}
+
+// MetricsServerResourceModel describes the resource data model.
+type MetricsServerResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	Server            types.String `tfsdk:"server"`
+	Port              types.Int64  `tfsdk:"port"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	MTU               types.Int64  `tfsdk:"mtu"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	Token             types.String `tfsdk:"token"`
+	TokenVersion      types.Int64  `tfsdk:"token_version"`
+	Organization      types.String `tfsdk:"organization"`
+	Bucket            types.String `tfsdk:"bucket"`
+	APIPathPrefix     types.String `tfsdk:"api_path_prefix"`
+	MaxBodySize       types.Int64  `tfsdk:"max_body_size"`
+	VerifyCertificate types.Bool   `tfsdk:"verify_certificate"`
+	Path              types.String `tfsdk:"path"`
+}
+
+func (r *MetricsServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics_server"
+}
+
+func (r *MetricsServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an external metrics server Proxmox VE sends node, guest and storage statistics to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the server name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the metrics server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the metrics server, either `influxdb` or `graphite`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Address of the metrics server",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the metrics server",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol metrics are sent with, one of `udp`, `http` or `https` for InfluxDB and " +
+					"`udp` or `tcp` for Graphite. Proxmox VE defaults to `udp`",
+				Optional: true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the metrics server. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of UDP packets",
+				Optional:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for TCP and HTTP connections",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "InfluxDB access token, required for InfluxDB 2.x. The token is write-only, so " +
+					"changes made outside of Terraform are not detected",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"token_version": secretVersionAttribute("InfluxDB token"),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "InfluxDB organization, only used for InfluxDB 2.x over HTTP(S)",
+				Optional:            true,
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "InfluxDB bucket or database metrics are written to",
+				Optional:            true,
+			},
+			"api_path_prefix": schema.StringAttribute{
+				MarkdownDescription: "Path prefix of the InfluxDB HTTP API, for servers behind a reverse proxy",
+				Optional:            true,
+			},
+			"max_body_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in bytes of a single InfluxDB HTTP request",
+				Optional:            true,
+			},
+			"verify_certificate": schema.BoolAttribute{
+				MarkdownDescription: "Verify the TLS certificate of an InfluxDB server reached over HTTPS",
+				Optional:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Root path of the Graphite metrics",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *MetricsServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MetricsServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MetricsServerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	// Options of the other server type are rejected by the API with a less
+	// helpful error.
+	var invalid map[string]bool
+	switch data.Type.ValueString() {
+	case metricsServerInfluxDB:
+		invalid = map[string]bool{"path": !data.Path.IsNull()}
+	case metricsServerGraphite:
+		invalid = map[string]bool{
+			"token":              !data.Token.IsNull(),
+			"organization":       !data.Organization.IsNull(),
+			"bucket":             !data.Bucket.IsNull(),
+			"api_path_prefix":    !data.APIPathPrefix.IsNull(),
+			"max_body_size":      !data.MaxBodySize.IsNull(),
+			"verify_certificate": !data.VerifyCertificate.IsNull(),
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The type must be %q or %q, got %q.", metricsServerInfluxDB, metricsServerGraphite, data.Type.ValueString()),
+		)
+		return
+	}
+
+	for name, set := range invalid {
+		if set {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The %s attribute is not supported by %s metrics servers.", name, data.Type.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *MetricsServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetricsServerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("type", data.Type)
+	params.setString("token", token)
+
+	if err := r.client.Post(ctx, r.path(data.Name.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metrics server %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Name
+
+	tflog.Trace(ctx, "created metrics server", map[string]interface{}{"name": data.Name.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetricsServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetricsServerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var server map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &server)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metrics server %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Name = data.ID
+	data.Type = stringValue(server, "type")
+	data.Server = stringValue(server, "server")
+	data.Port = int64Value(server, "port")
+	data.Protocol = stringValue(server, data.protocolKey())
+	data.Enabled = types.BoolValue(!boolValue(server, "disable").ValueBool())
+	data.MTU = int64Value(server, "mtu")
+	data.Timeout = int64Value(server, "timeout")
+	data.Organization = stringValue(server, "organization")
+	data.Bucket = stringValue(server, "bucket")
+	data.APIPathPrefix = stringValue(server, "api-path-prefix")
+	data.MaxBodySize = int64Value(server, "max-body-size")
+	data.VerifyCertificate = boolValue(server, "verify-certificate")
+	data.Path = stringValue(server, "path")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetricsServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MetricsServerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := data.params(true)
+
+	if secretVersionChanged(data.TokenVersion, state.TokenVersion) {
+		params.updateString("token", token)
+	}
+
+	if err := r.client.Put(ctx, r.path(data.Name.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metrics server %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetricsServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetricsServerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.Name.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metrics server %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+}
+
+func (r *MetricsServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MetricsServerResource) path(name string) string {
+	return "/cluster/metrics/server/" + url.PathEscape(name)
+}
+
+// protocolKey returns the API option holding the protocol, which differs
+// between the server types.
+func (m MetricsServerResourceModel) protocolKey() string {
+	if m.Type.ValueString() == metricsServerInfluxDB {
+		return "influxdbproto"
+	}
+	return "proto"
+}
+
+// params returns the API parameters of the metrics server. When update is
+// set, unset optional fields are removed from the existing server.
+func (m MetricsServerResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("server", m.Server)
+	params.setInt64("port", m.Port)
+
+	// The API only knows the inverted disable flag.
+	if !m.Enabled.ValueBool() {
+		params["disable"] = 1
+	} else if update {
+		params.remove("disable")
+	}
+
+	setString, setInt64, setBool := params.setString, params.setInt64, params.setBool
+	if update {
+		setString, setInt64, setBool = params.updateString, params.updateInt64, params.updateBool
+	}
+	setString(m.protocolKey(), m.Protocol)
+	setInt64("mtu", m.MTU)
+	setInt64("timeout", m.Timeout)
+
+	if m.Type.ValueString() == metricsServerInfluxDB {
+		setString("organization", m.Organization)
+		setString("bucket", m.Bucket)
+		setString("api-path-prefix", m.APIPathPrefix)
+		setInt64("max-body-size", m.MaxBodySize)
+		setBool("verify-certificate", m.VerifyCertificate)
+	} else {
+		setString("path", m.Path)
+	}
+
+	return params
+}
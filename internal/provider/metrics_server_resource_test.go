@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetricsServerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetricsServerResourceConfig(8089, "proxmox"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_metrics_server.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_metrics_server.test", "port", "8089"),
+					resource.TestCheckResourceAttr("proxmox_metrics_server.test", "bucket", "proxmox"),
+					resource.TestCheckResourceAttr("proxmox_metrics_server.test", "enabled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_metrics_server.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetricsServerResourceConfig(8090, "pve"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_metrics_server.test", "port", "8090"),
+					resource.TestCheckResourceAttr("proxmox_metrics_server.test", "bucket", "pve"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricsServerResourceConfig(port int, bucket string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_metrics_server" "test" {
+  name     = "tfacc"
+  type     = "influxdb"
+  server   = "127.0.0.1"
+  port     = %[1]d
+  protocol = "udp"
+  bucket   = %[2]q
+  enabled  = false
+}
+`, port, bucket)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file contains the handling shared by the resources managing the
+// network interfaces of a node. Proxmox VE writes interface changes to a
+// staging file, they only become active once the network configuration of the
+// node is applied.
+
+// NetworkInterfaceModel describes the attributes common to all network
+// interface resources. It is embedded in the resource models.
+type NetworkInterfaceModel struct {
+	ID        types.String          `tfsdk:"id"`
+	Node      types.String          `tfsdk:"node"`
+	Iface     types.String          `tfsdk:"iface"`
+	Autostart types.Bool            `tfsdk:"autostart"`
+	Comments  types.String          `tfsdk:"comments"`
+	CIDR      NormalizedStringValue `tfsdk:"cidr"`
+	Gateway   NormalizedStringValue `tfsdk:"gateway"`
+	CIDR6     NormalizedStringValue `tfsdk:"cidr6"`
+	Gateway6  NormalizedStringValue `tfsdk:"gateway6"`
+	MTU       types.Int64           `tfsdk:"mtu"`
+}
+
+// networkInterfaceAttributes returns the schema of the attributes common to
+// all network interface resources.
+func networkInterfaceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Resource identifier in the `node/iface` format",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"node": schema.StringAttribute{
+			MarkdownDescription: "Name of the node",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"iface": schema.StringAttribute{
+			MarkdownDescription: "Name of the interface",
+			Required:            true,
+			Validators:          []validator.String{validators.BridgeName()},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"autostart": schema.BoolAttribute{
+			MarkdownDescription: "Bring the interface up on boot. Defaults to `true`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"comments": schema.StringAttribute{
+			MarkdownDescription: "Interface comment",
+			Optional:            true,
+		},
+		"cidr": schema.StringAttribute{
+			MarkdownDescription: "IPv4 address in CIDR notation",
+			CustomType:          cidrType,
+			Optional:            true,
+		},
+		"gateway": schema.StringAttribute{
+			MarkdownDescription: "IPv4 default gateway",
+			CustomType:          ipAddressType,
+			Optional:            true,
+		},
+		"cidr6": schema.StringAttribute{
+			MarkdownDescription: "IPv6 address in CIDR notation",
+			CustomType:          cidrType,
+			Optional:            true,
+		},
+		"gateway6": schema.StringAttribute{
+			MarkdownDescription: "IPv6 default gateway",
+			CustomType:          ipAddressType,
+			Optional:            true,
+		},
+		"mtu": schema.Int64Attribute{
+			MarkdownDescription: "MTU of the interface",
+			Optional:            true,
+		},
+	}
+}
+
+// params returns the API parameters of the common fields. When update is set,
+// unset optional fields are removed from the existing interface.
+func (m NetworkInterfaceModel) params(ifaceType string, update bool) apiParams {
+	params := apiParams{}
+	params["type"] = ifaceType
+	params.setBool("autostart", m.Autostart)
+
+	setString, setInt64 := params.setString, params.setInt64
+	if update {
+		setString, setInt64 = params.updateString, params.updateInt64
+	} else {
+		params.setString("iface", m.Iface)
+	}
+	setString("comments", m.Comments)
+	setString("cidr", m.CIDR.StringValue)
+	setString("gateway", m.Gateway.StringValue)
+	setString("cidr6", m.CIDR6.StringValue)
+	setString("gateway6", m.Gateway6.StringValue)
+	setInt64("mtu", m.MTU)
+
+	return params
+}
+
+// read updates the common fields from an interface returned by the API.
+func (m *NetworkInterfaceModel) read(iface map[string]interface{}) {
+	m.Autostart = boolValue(iface, "autostart")
+	if m.Autostart.IsNull() {
+		m.Autostart = types.BoolValue(false)
+	}
+
+	// The interfaces file stores comments line by line.
+	m.Comments = stringValue(iface, "comments")
+	if !m.Comments.IsNull() {
+		m.Comments = types.StringValue(strings.TrimRight(m.Comments.ValueString(), "\n"))
+	}
+
+	m.CIDR = cidrType.value(stringValue(iface, "cidr"))
+	m.Gateway = ipAddressType.value(stringValue(iface, "gateway"))
+	m.CIDR6 = cidrType.value(stringValue(iface, "cidr6"))
+	m.Gateway6 = ipAddressType.value(stringValue(iface, "gateway6"))
+	m.MTU = int64Value(iface, "mtu")
+}
+
+// networkPath returns the API path of the network configuration of a node.
+func networkPath(node string) string {
+	return "/nodes/" + url.PathEscape(node) + "/network"
+}
+
+// networkInterfacePath returns the API path of a network interface.
+func networkInterfacePath(node, iface string) string {
+	return networkPath(node) + "/" + url.PathEscape(iface)
+}
+
+// parseNetworkInterfaceID splits a `node/iface` resource identifier.
+func parseNetworkInterfaceID(id string) (string, string, error) {
+	parts, err := parseID(id, 2, "node/iface")
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// networkInterfaceTypes returns the types of the interfaces of a node keyed
+// by interface name.
+func networkInterfaceTypes(ctx context.Context, client *ProxmoxClient, node string) (map[string]string, error) {
+	var ifaces []map[string]interface{}
+	if err := client.Get(ctx, networkPath(node), &ifaces); err != nil {
+		return nil, err
+	}
+
+	ifaceTypes := map[string]string{}
+	for _, iface := range ifaces {
+		ifaceTypes[stringValue(iface, "iface").ValueString()] = stringValue(iface, "type").ValueString()
+	}
+	return ifaceTypes, nil
+}
+
+// checkNetworkMembers verifies that the interfaces in members exist on the
+// node and have one of the allowed types, so that invalid member lists are
+// rejected before they end up in the staged configuration.
+func checkNetworkMembers(ctx context.Context, client *ProxmoxClient, node string, members []string, allowedTypes ...string) error {
+	ifaceTypes, err := networkInterfaceTypes(ctx, client, node)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		ifaceType, ok := ifaceTypes[member]
+		if !ok {
+			return fmt.Errorf("interface %s does not exist on node %s", member, node)
+		}
+		if !slices.Contains(allowedTypes, ifaceType) {
+			return fmt.Errorf("interface %s has type %s, expected one of: %s", member, ifaceType, strings.Join(allowedTypes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// ovsAttributes returns the schema of the attributes shared by the Open
+// vSwitch interface resources. The bridge and VLAN tag attributes are only
+// included for ports attached to a bridge.
+func ovsAttributes(port bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"ovs_options": schema.StringAttribute{
+			MarkdownDescription: "Additional Open vSwitch options, passed through unchanged",
+			Optional:            true,
+		},
+	}
+	if port {
+		attributes["bridge"] = schema.StringAttribute{
+			MarkdownDescription: "Open vSwitch bridge the interface is attached to",
+			Required:            true,
+			Validators:          []validator.String{validators.BridgeName()},
+		}
+		attributes["vlan_tag"] = schema.Int64Attribute{
+			MarkdownDescription: "VLAN tag of the port",
+			Optional:            true,
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkApplyResource{}
+var _ resource.ResourceWithImportState = &NetworkApplyResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkApplyResource{}
+
+func NewNetworkApplyResource() resource.Resource {
+	return &NetworkApplyResource{}
+}
+
+// NetworkApplyResource defines the resource implementation.
+type NetworkApplyResource struct {
+	client *ProxmoxClient
+}
+
+// NetworkApplyResourceModel describes the resource data model.
+type NetworkApplyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Node            types.String `tfsdk:"node"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	RevertOnFailure types.Bool   `tfsdk:"revert_on_failure"`
+	PendingChanges  types.String `tfsdk:"pending_changes"`
+}
+
+func (r *NetworkApplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_apply"
+}
+
+func (r *NetworkApplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies the staged network configuration of a Proxmox VE node. The network interface " +
+			"resources only stage their changes, so this resource should depend on them and list them in `triggers`. " +
+			"Changes staged outside of Terraform are detected as drift and applied on the next run.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, applies the network configuration again",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"revert_on_failure": schema.BoolAttribute{
+				MarkdownDescription: "Discard the staged configuration if Proxmox VE rejects it, so that it is not " +
+					"applied by accident later on. A configuration that was accepted but failed to reload is already " +
+					"active and cannot be reverted. Defaults to `true`",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"pending_changes": schema.StringAttribute{
+				MarkdownDescription: "Diff of the staged network configuration that has not been applied yet",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NetworkApplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkApplyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// After applying nothing is pending anymore. Pending changes found on
+	// refresh therefore show up as a diff, which triggers an update.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_changes"), types.StringValue(""))...)
+}
+
+func (r *NetworkApplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkApplyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply network configuration of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Node
+	data.PendingChanges = types.StringValue("")
+
+	tflog.Trace(ctx, "applied network configuration", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkApplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkApplyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var result struct {
+		Changes string `json:"changes"`
+	}
+	err := r.client.GetResult(ctx, networkPath(data.ID.ValueString()), &result)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network configuration of node %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Node = data.ID
+	data.PendingChanges = types.StringValue(result.Changes)
+	if data.RevertOnFailure.IsNull() {
+		data.RevertOnFailure = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkApplyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.apply(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply network configuration of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.PendingChanges = types.StringValue("")
+
+	tflog.Trace(ctx, "applied network configuration", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkApplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The applied configuration stays active, so removing the resource only
+	// drops it from the Terraform state.
+}
+
+func (r *NetworkApplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply applies the staged network configuration and waits for the reload to
+// finish. If the API rejects the configuration, it is discarded on request.
+// Once accepted, the configuration has replaced /etc/network/interfaces, so
+// there is nothing left to revert when the reload fails.
+func (r *NetworkApplyResource) apply(ctx context.Context, data NetworkApplyResourceModel) error {
+	node := data.Node.ValueString()
+
+	var upid string
+	err := r.client.Put(ctx, networkPath(node), nil, &upid)
+	if err == nil {
+		if err = r.client.waitForTask(ctx, upid); err != nil {
+			return fmt.Errorf("configuration applied but reload failed: %w", err)
+		}
+		return nil
+	}
+	if !data.RevertOnFailure.ValueBool() {
+		return err
+	}
+
+	tflog.Warn(ctx, "reverting staged network configuration", map[string]interface{}{"node": node})
+
+	if revertErr := r.client.Delete(ctx, networkPath(node), nil); revertErr != nil {
+		return fmt.Errorf("%w (reverting the staged configuration failed as well: %s)", err, revertErr)
+	}
+	return fmt.Errorf("%w (the staged configuration has been reverted)", err)
+}
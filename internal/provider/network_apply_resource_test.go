@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkApplyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkApplyResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_apply.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_network_apply.test", "pending_changes", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_network_apply.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNetworkApplyResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bridge.test", "comments", "second"),
+					resource.TestCheckResourceAttr("proxmox_network_apply.test", "pending_changes", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkApplyResourceConfig(comment string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_network_ovs_bridge" "test" {
+  node     = %[1]q
+  iface    = "vmbr98"
+  comments = %[2]q
+}
+
+resource "proxmox_network_apply" "test" {
+  node = %[1]q
+
+  triggers = {
+    bridge = jsonencode(proxmox_network_ovs_bridge.test)
+  }
+}
+`, testNode(), comment)
+}
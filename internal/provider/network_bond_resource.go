@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkBondResource{}
+var _ resource.ResourceWithImportState = &NetworkBondResource{}
+
+func NewNetworkBondResource() resource.Resource {
+	return &NetworkBondResource{}
+}
+
+// NetworkBondResource defines the resource implementation.
+type NetworkBondResource struct {
+	client *ProxmoxClient
+}
+
+// NetworkBondResourceModel describes the resource data model.
+type NetworkBondResourceModel struct {
+	NetworkInterfaceModel
+	Slaves     types.Set    `tfsdk:"slaves"`
+	Mode       types.String `tfsdk:"mode"`
+	HashPolicy types.String `tfsdk:"hash_policy"`
+	Primary    types.String `tfsdk:"primary"`
+}
+
+func (r *NetworkBondResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_bond"
+}
+
+func (r *NetworkBondResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := networkInterfaceAttributes()
+	attributes["slaves"] = schema.SetAttribute{
+		MarkdownDescription: "Physical network interfaces of the bond. They must exist on the node",
+		ElementType:         types.StringType,
+		Required:            true,
+		Validators:          []validator.Set{validators.BridgeName()},
+	}
+	attributes["mode"] = schema.StringAttribute{
+		MarkdownDescription: "Bonding mode (`balance-rr`, `active-backup`, `balance-xor`, `broadcast`, `802.3ad`, `balance-tlb` or `balance-alb`)",
+		Required:            true,
+	}
+	attributes["hash_policy"] = schema.StringAttribute{
+		MarkdownDescription: "Transmit hash policy for the `balance-xor` and `802.3ad` modes (`layer2`, `layer2+3` or `layer3+4`)",
+		Optional:            true,
+	}
+	attributes["primary"] = schema.StringAttribute{
+		MarkdownDescription: "Primary interface for the `active-backup` mode",
+		Optional:            true,
+		Validators:          []validator.String{validators.BridgeName()},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Linux bond interface of a Proxmox VE node. Changes are staged until the " +
+			"network configuration of the node is applied with `proxmox_network_apply`.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *NetworkBondResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkBondResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkBondResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := r.params(ctx, data, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	if err := r.client.Post(ctx, networkPath(node), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create bond %s on node %s, got error: %s", data.Iface.ValueString(), node, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, data.Iface.ValueString()))
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created network bond", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkBondResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkBondResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.read(ctx, &data)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkBondResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkBondResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := r.params(ctx, data, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkBondResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkBondResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *NetworkBondResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the bond after verifying that its
+// members are physical interfaces of the node.
+func (r *NetworkBondResource) params(ctx context.Context, data NetworkBondResourceModel, update bool, diags *diag.Diagnostics) apiParams {
+	var slaves []string
+	diags.Append(data.Slaves.ElementsAs(ctx, &slaves, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if err := checkNetworkMembers(ctx, r.client, data.Node.ValueString(), slaves, "eth"); err != nil {
+		diags.AddAttributeError(path.Root("slaves"), "Invalid Bond Member", fmt.Sprintf("Unable to use the interfaces as bond members: %s", err))
+		return nil
+	}
+
+	params := data.params("bond", update)
+	params["slaves"] = strings.Join(slaves, " ")
+
+	setString := params.setString
+	if update {
+		setString = params.updateString
+	}
+	setString("bond_mode", data.Mode)
+	setString("bond_xmit_hash_policy", data.HashPolicy)
+	setString("bond-primary", data.Primary)
+
+	return params
+}
+
+// read refreshes data from the API.
+func (r *NetworkBondResource) read(ctx context.Context, data *NetworkBondResourceModel) error {
+	node, iface, err := parseNetworkInterfaceID(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var bond map[string]interface{}
+	if err := r.client.Get(ctx, networkInterfacePath(node, iface), &bond); err != nil {
+		return err
+	}
+
+	data.Node = types.StringValue(node)
+	data.Iface = types.StringValue(iface)
+	data.NetworkInterfaceModel.read(bond)
+
+	slaves, diags := types.SetValueFrom(ctx, types.StringType, splitList(stringValue(bond, "slaves").ValueString()))
+	if diags.HasError() {
+		return fmt.Errorf("unable to convert bond members")
+	}
+	data.Slaves = slaves
+	data.Mode = stringValue(bond, "bond_mode")
+	data.HashPolicy = stringValue(bond, "bond_xmit_hash_policy")
+	data.Primary = stringValue(bond, "bond-primary")
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkBondResource(t *testing.T) {
+	nic := testAccRequireEnv(t, "PROXMOX_NIC")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkBondResourceConfig(nic, "active-backup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_bond.test", "id", testNode()+"/bond9"),
+					resource.TestCheckResourceAttr("proxmox_network_bond.test", "mode", "active-backup"),
+					resource.TestCheckTypeSetElemAttr("proxmox_network_bond.test", "slaves.*", nic),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_network_bond.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNetworkBondResourceConfig(nic, "balance-alb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_bond.test", "mode", "balance-alb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkBondResourceConfig(nic, mode string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_network_bond" "test" {
+  node     = %[1]q
+  iface    = "bond9"
+  slaves   = [%[2]q]
+  mode     = %[3]q
+  comments = "terraform acceptance test"
+}
+`, testNode(), nic, mode)
+}
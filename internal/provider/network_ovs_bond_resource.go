@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkOVSBondResource{}
+var _ resource.ResourceWithImportState = &NetworkOVSBondResource{}
+
+func NewNetworkOVSBondResource() resource.Resource {
+	return &NetworkOVSBondResource{}
+}
+
+// NetworkOVSBondResource defines the resource implementation.
+type NetworkOVSBondResource struct {
+	client *ProxmoxClient
+}
+
+// NetworkOVSBondResourceModel describes the resource data model.
+type NetworkOVSBondResourceModel struct {
+	NetworkInterfaceModel
+	Slaves     types.Set    `tfsdk:"slaves"`
+	Mode       types.String `tfsdk:"mode"`
+	Bridge     types.String `tfsdk:"bridge"`
+	VLANTag    types.Int64  `tfsdk:"vlan_tag"`
+	OVSOptions types.String `tfsdk:"ovs_options"`
+}
+
+func (r *NetworkOVSBondResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_ovs_bond"
+}
+
+func (r *NetworkOVSBondResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := networkInterfaceAttributes()
+	maps.Copy(attributes, ovsAttributes(true))
+	attributes["slaves"] = schema.SetAttribute{
+		MarkdownDescription: "Physical network interfaces of the bond. They must exist on the node",
+		ElementType:         types.StringType,
+		Required:            true,
+		Validators:          []validator.Set{validators.BridgeName()},
+	}
+	attributes["mode"] = schema.StringAttribute{
+		MarkdownDescription: "Bonding mode (`active-backup`, `balance-slb`, `balance-tcp`, `lacp-balance-slb` or `lacp-balance-tcp`)",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Open vSwitch bond of a Proxmox VE node. Changes are staged until the " +
+			"network configuration of the node is applied with `proxmox_network_apply`.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *NetworkOVSBondResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkOVSBondResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkOVSBondResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := r.params(ctx, data, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	if err := r.client.Post(ctx, networkPath(node), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OVS bond %s on node %s, got error: %s", data.Iface.ValueString(), node, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, data.Iface.ValueString()))
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created OVS bond", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSBondResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkOVSBondResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.read(ctx, &data)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSBondResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkOVSBondResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := r.params(ctx, data, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OVS bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSBondResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkOVSBondResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OVS bond %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *NetworkOVSBondResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the bond after verifying that its
+// members are physical interfaces and its bridge is an OVS bridge.
+func (r *NetworkOVSBondResource) params(ctx context.Context, data NetworkOVSBondResourceModel, update bool, diags *diag.Diagnostics) apiParams {
+	var slaves []string
+	diags.Append(data.Slaves.ElementsAs(ctx, &slaves, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	node := data.Node.ValueString()
+	if err := checkNetworkMembers(ctx, r.client, node, slaves, "eth"); err != nil {
+		diags.AddAttributeError(path.Root("slaves"), "Invalid Bond Member", fmt.Sprintf("Unable to use the interfaces as bond members: %s", err))
+		return nil
+	}
+	if err := checkNetworkMembers(ctx, r.client, node, []string{data.Bridge.ValueString()}, "OVSBridge"); err != nil {
+		diags.AddAttributeError(path.Root("bridge"), "Invalid Bridge", fmt.Sprintf("Unable to attach the bond to the bridge: %s", err))
+		return nil
+	}
+
+	params := data.params("OVSBond", update)
+	params["ovs_bonds"] = strings.Join(slaves, " ")
+	params.setString("bond_mode", data.Mode)
+	params.setString("ovs_bridge", data.Bridge)
+
+	setString, setInt64 := params.setString, params.setInt64
+	if update {
+		setString, setInt64 = params.updateString, params.updateInt64
+	}
+	setInt64("ovs_tag", data.VLANTag)
+	setString("ovs_options", data.OVSOptions)
+
+	return params
+}
+
+// read refreshes data from the API.
+func (r *NetworkOVSBondResource) read(ctx context.Context, data *NetworkOVSBondResourceModel) error {
+	node, iface, err := parseNetworkInterfaceID(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var bond map[string]interface{}
+	if err := r.client.Get(ctx, networkInterfacePath(node, iface), &bond); err != nil {
+		return err
+	}
+
+	data.Node = types.StringValue(node)
+	data.Iface = types.StringValue(iface)
+	data.NetworkInterfaceModel.read(bond)
+
+	slaves, diags := types.SetValueFrom(ctx, types.StringType, splitList(stringValue(bond, "ovs_bonds").ValueString()))
+	if diags.HasError() {
+		return fmt.Errorf("unable to convert bond members")
+	}
+	data.Slaves = slaves
+	data.Mode = stringValue(bond, "bond_mode")
+	data.Bridge = stringValue(bond, "ovs_bridge")
+	data.VLANTag = int64Value(bond, "ovs_tag")
+	data.OVSOptions = stringValue(bond, "ovs_options")
+
+	return nil
+}
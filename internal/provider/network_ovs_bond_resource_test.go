@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkOVSBondResource(t *testing.T) {
+	nic := testAccRequireEnv(t, "PROXMOX_NIC")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkOVSBondResourceConfig(nic, "active-backup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bond.test", "id", testNode()+"/bond99"),
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bond.test", "mode", "active-backup"),
+					resource.TestCheckTypeSetElemAttr("proxmox_network_ovs_bond.test", "slaves.*", nic),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_network_ovs_bond.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNetworkOVSBondResourceConfig(nic, "balance-slb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bond.test", "mode", "balance-slb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkOVSBondResourceConfig(nic, mode string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_network_ovs_bridge" "test" {
+  node  = %[1]q
+  iface = "vmbr99"
+}
+
+resource "proxmox_network_ovs_bond" "test" {
+  node   = %[1]q
+  iface  = "bond99"
+  slaves = [%[2]q]
+  mode   = %[3]q
+  bridge = proxmox_network_ovs_bridge.test.iface
+}
+`, testNode(), nic, mode)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkOVSBridgeResource{}
+var _ resource.ResourceWithImportState = &NetworkOVSBridgeResource{}
+
+func NewNetworkOVSBridgeResource() resource.Resource {
+	return &NetworkOVSBridgeResource{}
+}
+
+// NetworkOVSBridgeResource defines the resource implementation.
+type NetworkOVSBridgeResource struct {
+	client *ProxmoxClient
+}
+
+// NetworkOVSBridgeResourceModel describes the resource data model.
+type NetworkOVSBridgeResourceModel struct {
+	NetworkInterfaceModel
+	Ports      types.Set    `tfsdk:"ports"`
+	OVSOptions types.String `tfsdk:"ovs_options"`
+}
+
+func (r *NetworkOVSBridgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_ovs_bridge"
+}
+
+func (r *NetworkOVSBridgeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := networkInterfaceAttributes()
+	maps.Copy(attributes, ovsAttributes(false))
+	attributes["ports"] = schema.SetAttribute{
+		MarkdownDescription: "Physical interfaces and Linux bonds attached to the bridge. Open vSwitch bonds and internal " +
+			"ports are attached through their own resources and are not listed here",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators:  []validator.Set{validators.BridgeName()},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Open vSwitch bridge of a Proxmox VE node. Changes are staged until the " +
+			"network configuration of the node is applied with `proxmox_network_apply`.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *NetworkOVSBridgeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkOVSBridgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkOVSBridgeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := r.params(ctx, data, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	if err := r.client.Post(ctx, networkPath(node), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OVS bridge %s on node %s, got error: %s", data.Iface.ValueString(), node, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, data.Iface.ValueString()))
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS bridge %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created OVS bridge", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSBridgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkOVSBridgeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.read(ctx, &data)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS bridge %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSBridgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkOVSBridgeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := r.params(ctx, data, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OVS bridge %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS bridge %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSBridgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkOVSBridgeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OVS bridge %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *NetworkOVSBridgeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the bridge. Proxmox VE lists the OVS
+// bonds and internal ports attached to the bridge in its ports too, so on
+// update they are kept in addition to the configured ports.
+func (r *NetworkOVSBridgeResource) params(ctx context.Context, data NetworkOVSBridgeResourceModel, update bool, diags *diag.Diagnostics) apiParams {
+	var ports []string
+	if !data.Ports.IsNull() {
+		diags.Append(data.Ports.ElementsAs(ctx, &ports, false)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	node := data.Node.ValueString()
+	if err := checkNetworkMembers(ctx, r.client, node, ports, "eth", "bond"); err != nil {
+		diags.AddAttributeError(path.Root("ports"), "Invalid Bridge Port", fmt.Sprintf("Unable to attach the interfaces to the bridge: %s", err))
+		return nil
+	}
+
+	params := data.params("OVSBridge", update)
+	setString := params.setString
+	if update {
+		setString = params.updateString
+
+		var bridge map[string]interface{}
+		err := r.client.Get(ctx, networkInterfacePath(node, data.Iface.ValueString()), &bridge)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read OVS bridge %s, got error: %s", data.ID.ValueString(), err))
+			return nil
+		}
+		_, ovsPorts, err := r.ports(ctx, node, bridge)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read interfaces of node %s, got error: %s", node, err))
+			return nil
+		}
+		ports = append(ports, ovsPorts...)
+	}
+
+	if len(ports) > 0 {
+		params["ovs_ports"] = strings.Join(ports, " ")
+	} else if update {
+		params.remove("ovs_ports")
+	}
+	setString("ovs_options", data.OVSOptions)
+
+	return params
+}
+
+// ports returns the ports of the bridge, split into the physical ports and
+// the OVS interfaces managed by other resources.
+func (r *NetworkOVSBridgeResource) ports(ctx context.Context, node string, bridge map[string]interface{}) ([]string, []string, error) {
+	ifaceTypes, err := networkInterfaceTypes(ctx, r.client, node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ports, ovsPorts []string
+	for _, port := range splitList(stringValue(bridge, "ovs_ports").ValueString()) {
+		if strings.HasPrefix(ifaceTypes[port], "OVS") {
+			ovsPorts = append(ovsPorts, port)
+		} else {
+			ports = append(ports, port)
+		}
+	}
+	return ports, ovsPorts, nil
+}
+
+// read refreshes data from the API.
+func (r *NetworkOVSBridgeResource) read(ctx context.Context, data *NetworkOVSBridgeResourceModel) error {
+	node, iface, err := parseNetworkInterfaceID(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var bridge map[string]interface{}
+	if err := r.client.Get(ctx, networkInterfacePath(node, iface), &bridge); err != nil {
+		return err
+	}
+
+	ports, _, err := r.ports(ctx, node, bridge)
+	if err != nil {
+		return err
+	}
+
+	data.Node = types.StringValue(node)
+	data.Iface = types.StringValue(iface)
+	data.NetworkInterfaceModel.read(bridge)
+	data.OVSOptions = stringValue(bridge, "ovs_options")
+
+	if len(ports) > 0 || !data.Ports.IsNull() {
+		set, diags := types.SetValueFrom(ctx, types.StringType, ports)
+		if diags.HasError() {
+			return fmt.Errorf("unable to convert bridge ports")
+		}
+		data.Ports = set
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkOVSBridgeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkOVSBridgeResourceConfig("10.99.0.1/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bridge.test", "id", testNode()+"/vmbr99"),
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bridge.test", "cidr", "10.99.0.1/24"),
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bridge.test", "autostart", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_network_ovs_bridge.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNetworkOVSBridgeResourceConfig("10.99.1.1/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_bridge.test", "cidr", "10.99.1.1/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkOVSBridgeResourceConfig(cidr string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_network_ovs_bridge" "test" {
+  node  = %[1]q
+  iface = "vmbr99"
+  cidr  = %[2]q
+}
+`, testNode(), cidr)
+}
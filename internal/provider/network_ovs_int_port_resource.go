@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkOVSIntPortResource{}
+var _ resource.ResourceWithImportState = &NetworkOVSIntPortResource{}
+
+func NewNetworkOVSIntPortResource() resource.Resource {
+	return &NetworkOVSIntPortResource{}
+}
+
+// NetworkOVSIntPortResource defines the resource implementation.
+type NetworkOVSIntPortResource struct {
+	client *ProxmoxClient
+}
+
+// NetworkOVSIntPortResourceModel describes the resource data model.
+type NetworkOVSIntPortResourceModel struct {
+	NetworkInterfaceModel
+	Bridge     types.String `tfsdk:"bridge"`
+	VLANTag    types.Int64  `tfsdk:"vlan_tag"`
+	OVSOptions types.String `tfsdk:"ovs_options"`
+}
+
+func (r *NetworkOVSIntPortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_ovs_int_port"
+}
+
+func (r *NetworkOVSIntPortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := networkInterfaceAttributes()
+	maps.Copy(attributes, ovsAttributes(true))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Open vSwitch internal port of a Proxmox VE node, typically used to give the " +
+			"node an address in a VLAN of an OVS bridge. Changes are staged until the network configuration of the node is applied with `proxmox_network_apply`.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *NetworkOVSIntPortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkOVSIntPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkOVSIntPortResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := r.params(ctx, data, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	if err := r.client.Post(ctx, networkPath(node), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OVS internal port %s on node %s, got error: %s", data.Iface.ValueString(), node, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, data.Iface.ValueString()))
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS internal port %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created OVS internal port", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSIntPortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkOVSIntPortResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.read(ctx, &data)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS internal port %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSIntPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkOVSIntPortResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := r.params(ctx, data, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OVS internal port %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OVS internal port %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkOVSIntPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkOVSIntPortResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, networkInterfacePath(data.Node.ValueString(), data.Iface.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OVS internal port %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *NetworkOVSIntPortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the port after verifying that its
+// bridge is an OVS bridge.
+func (r *NetworkOVSIntPortResource) params(ctx context.Context, data NetworkOVSIntPortResourceModel, update bool, diags *diag.Diagnostics) apiParams {
+	node := data.Node.ValueString()
+	if err := checkNetworkMembers(ctx, r.client, node, []string{data.Bridge.ValueString()}, "OVSBridge"); err != nil {
+		diags.AddAttributeError(path.Root("bridge"), "Invalid Bridge", fmt.Sprintf("Unable to attach the port to the bridge: %s", err))
+		return nil
+	}
+
+	params := data.params("OVSIntPort", update)
+	params.setString("ovs_bridge", data.Bridge)
+
+	setString, setInt64 := params.setString, params.setInt64
+	if update {
+		setString, setInt64 = params.updateString, params.updateInt64
+	}
+	setInt64("ovs_tag", data.VLANTag)
+	setString("ovs_options", data.OVSOptions)
+
+	return params
+}
+
+// read refreshes data from the API.
+func (r *NetworkOVSIntPortResource) read(ctx context.Context, data *NetworkOVSIntPortResourceModel) error {
+	node, iface, err := parseNetworkInterfaceID(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var port map[string]interface{}
+	if err := r.client.Get(ctx, networkInterfacePath(node, iface), &port); err != nil {
+		return err
+	}
+
+	data.Node = types.StringValue(node)
+	data.Iface = types.StringValue(iface)
+	data.NetworkInterfaceModel.read(port)
+	data.Bridge = stringValue(port, "ovs_bridge")
+	data.VLANTag = int64Value(port, "ovs_tag")
+	data.OVSOptions = stringValue(port, "ovs_options")
+
+	return nil
+}
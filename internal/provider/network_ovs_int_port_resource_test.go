@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkOVSIntPortResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkOVSIntPortResourceConfig(10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_int_port.test", "id", testNode()+"/vlan99"),
+					resource.TestCheckResourceAttr("proxmox_network_ovs_int_port.test", "bridge", "vmbr99"),
+					resource.TestCheckResourceAttr("proxmox_network_ovs_int_port.test", "vlan_tag", "10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_network_ovs_int_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNetworkOVSIntPortResourceConfig(20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_network_ovs_int_port.test", "vlan_tag", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkOVSIntPortResourceConfig(tag int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_network_ovs_bridge" "test" {
+  node  = %[1]q
+  iface = "vmbr99"
+}
+
+resource "proxmox_network_ovs_int_port" "test" {
+  node     = %[1]q
+  iface    = "vlan99"
+  bridge   = proxmox_network_ovs_bridge.test.iface
+  vlan_tag = %[2]d
+  cidr     = "10.99.0.2/24"
+}
+`, testNode(), tag)
+}
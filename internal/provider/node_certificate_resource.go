@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// nodeCertificateFile is the file name of the custom pveproxy certificate as
+// reported by the certificate info API.
+const nodeCertificateFile = "pveproxy-ssl.pem"
+
+// proxyRestartTimeout limits how long the node may be unreachable after
+// pveproxy has been restarted to load a new certificate.
+const proxyRestartTimeout = 2 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeCertificateResource{}
+var _ resource.ResourceWithImportState = &NodeCertificateResource{}
+
+func NewNodeCertificateResource() resource.Resource {
+	return &NodeCertificateResource{}
+}
+
+// NodeCertificateResource defines the resource implementation.
+type NodeCertificateResource struct {
+	client *ProxmoxClient
+}
+
+// NodeCertificateResourceModel describes the resource data model.
+type NodeCertificateResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Node                    types.String `tfsdk:"node"`
+	Certificate             types.String `tfsdk:"certificate"`
+	PrivateKey              types.String `tfsdk:"private_key"`
+	Restart                 types.Bool   `tfsdk:"restart"`
+	Fingerprint             types.String `tfsdk:"fingerprint"`
+	Subject                 types.String `tfsdk:"subject"`
+	Issuer                  types.String `tfsdk:"issuer"`
+	NotBefore               types.Int64  `tfsdk:"not_before"`
+	NotAfter                types.Int64  `tfsdk:"not_after"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+}
+
+func (r *NodeCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_certificate"
+}
+
+func (r *NodeCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+	computedInt64 := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the custom TLS certificate of the Proxmox VE API and web interface of a node. " +
+			"An existing custom certificate is replaced. Destroying the resource removes the custom certificate, " +
+			"so the node falls back to its self-signed certificate. While pveproxy restarts the node is briefly " +
+			"unreachable, the provider waits for it to come back.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate, optionally followed by the intermediate certificates",
+				Required:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the certificate. The key is write-only and sent " +
+					"whenever the certificate changes",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"restart": schema.BoolAttribute{
+				MarkdownDescription: "Restart pveproxy to load the certificate. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"fingerprint": computedString("SHA-256 fingerprint of the certificate"),
+			"subject":     computedString("Subject of the certificate"),
+			"issuer":      computedString("Issuer of the certificate"),
+			"not_before":  computedInt64("Start of the validity period as Unix timestamp"),
+			"not_after":   computedInt64("End of the validity period as Unix timestamp"),
+			"subject_alternative_names": schema.ListAttribute{
+				MarkdownDescription: "Subject alternative names of the certificate",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NodeCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NodeCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &data.PrivateKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.upload(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload certificate of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Node
+	data.read(info)
+
+	tflog.Trace(ctx, "uploaded node certificate", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	info, err := nodeCustomCertificate(ctx, r.client, data.ID.ValueString())
+	if isNotFound(err) || (err == nil && info == nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificate of node %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// The key cannot be read back and the configured certificate may include
+	// intermediates, so the certificate is only refreshed when it has been
+	// replaced outside of Terraform.
+	if !stringValue(info, "fingerprint").Equal(data.Fingerprint) {
+		data.Certificate = stringValue(info, "pem")
+	}
+	data.Node = data.ID
+	if data.Restart.IsNull() {
+		data.Restart = types.BoolValue(true)
+	}
+	data.read(info)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &data.PrivateKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	info, err := r.upload(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload certificate of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.read(info)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	node := data.Node.ValueString()
+	customPath := "/nodes/" + url.PathEscape(node) + "/certificates/custom"
+	if data.Restart.ValueBool() {
+		customPath += "?restart=1"
+	}
+
+	err := r.client.Delete(ctx, customPath, nil)
+	if err == nil && data.Restart.ValueBool() {
+		_, err = waitForProxyRestart(ctx, r.client, node, "")
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete certificate of node %s, got error: %s", node, err))
+		return
+	}
+}
+
+func (r *NodeCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upload uploads the certificate, replacing an existing custom certificate,
+// and returns the information about the new certificate.
+func (r *NodeCertificateResource) upload(ctx context.Context, data NodeCertificateResourceModel) (map[string]interface{}, error) {
+	node := data.Node.ValueString()
+
+	params := apiParams{}
+	params.setString("certificates", data.Certificate)
+	params.setString("key", data.PrivateKey)
+	params.setBool("restart", data.Restart)
+	params["force"] = 1
+
+	var info map[string]interface{}
+	if err := r.client.Post(ctx, "/nodes/"+url.PathEscape(node)+"/certificates/custom", params, &info); err != nil {
+		return nil, err
+	}
+	if !data.Restart.ValueBool() {
+		return info, nil
+	}
+
+	return waitForProxyRestart(ctx, r.client, node, stringValue(info, "fingerprint").ValueString())
+}
+
+// nodeCustomCertificate returns the information about the certificate
+// pveproxy uses instead of the self-signed one, or nil if there is none.
+func nodeCustomCertificate(ctx context.Context, client *ProxmoxClient, node string) (map[string]interface{}, error) {
+	var infos []map[string]interface{}
+	if err := client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/certificates/info", &infos); err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info["filename"] == nodeCertificateFile {
+			return info, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForProxyRestart waits until the node answers API requests again after
+// pveproxy has been restarted. If fingerprint is set, it also waits until the
+// node reports the certificate with that fingerprint. Errors are expected
+// while pveproxy restarts and are therefore ignored until the timeout.
+func waitForProxyRestart(ctx context.Context, client *ProxmoxClient, node, fingerprint string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, proxyRestartTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for pveproxy on node %s to restart: %w", node, ctx.Err())
+		case <-time.After(client.pollInterval()):
+		}
+
+		info, err := nodeCustomCertificate(ctx, client, node)
+		if err == nil && (fingerprint == "" || strings.EqualFold(stringValue(info, "fingerprint").ValueString(), fingerprint)) {
+			return info, nil
+		}
+
+		tflog.Debug(ctx, "waiting for pveproxy to restart", map[string]interface{}{"node": node})
+	}
+}
+
+// read updates the computed attributes from the certificate information.
+func (m *NodeCertificateResourceModel) read(info map[string]interface{}) {
+	m.Fingerprint = stringValue(info, "fingerprint")
+	m.Subject = stringValue(info, "subject")
+	m.Issuer = stringValue(info, "issuer")
+	m.NotBefore = int64Value(info, "notbefore")
+	m.NotAfter = int64Value(info, "notafter")
+
+	var sans []attr.Value
+	if items, ok := info["san"].([]interface{}); ok {
+		for _, item := range items {
+			if san, ok := item.(string); ok {
+				sans = append(sans, types.StringValue(san))
+			}
+		}
+	}
+	m.SubjectAlternativeNames = types.ListValueMust(types.StringType, sans)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNodeCertificateResource(t *testing.T) {
+	cert1, key1 := testAccSelfSignedCertificate(t, "tfacc-1.example.com")
+	cert2, key2 := testAccSelfSignedCertificate(t, "tfacc-2.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNodeCertificateResourceConfig(cert1, key1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_certificate.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_node_certificate.test", "subject_alternative_names.0", "tfacc-1.example.com"),
+					resource.TestCheckResourceAttrSet("proxmox_node_certificate.test", "fingerprint"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_node_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNodeCertificateResourceConfig(cert2, key2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_certificate.test", "subject_alternative_names.0", "tfacc-2.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeCertificateResourceConfig(cert, key string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_node_certificate" "test" {
+  node        = %[1]q
+  certificate = %[2]q
+  private_key = %[3]q
+}
+`, testNode(), cert, key)
+}
+
+// testAccSelfSignedCertificate returns a PEM encoded self-signed certificate
+// for the given name and its private key.
+func testAccSelfSignedCertificate(t *testing.T, name string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("encoding key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeCertificatesDataSource{}
+
+func NewNodeCertificatesDataSource() datasource.DataSource {
+	return &NodeCertificatesDataSource{}
+}
+
+// NodeCertificatesDataSource defines the data source implementation.
+type NodeCertificatesDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeCertificatesDataSourceModel describes the data source data model.
+type NodeCertificatesDataSourceModel struct {
+	ID           types.String           `tfsdk:"id"`
+	Node         types.String           `tfsdk:"node"`
+	Certificates []NodeCertificateModel `tfsdk:"certificates"`
+}
+
+// NodeCertificateModel describes a certificate of a node.
+type NodeCertificateModel struct {
+	Filename                types.String `tfsdk:"filename"`
+	InUse                   types.Bool   `tfsdk:"in_use"`
+	Fingerprint             types.String `tfsdk:"fingerprint"`
+	Subject                 types.String `tfsdk:"subject"`
+	Issuer                  types.String `tfsdk:"issuer"`
+	NotBefore               types.Int64  `tfsdk:"not_before"`
+	NotAfter                types.Int64  `tfsdk:"not_after"`
+	SubjectAlternativeNames []string     `tfsdk:"subject_alternative_names"`
+	PublicKeyType           types.String `tfsdk:"public_key_type"`
+	PublicKeyBits           types.Int64  `tfsdk:"public_key_bits"`
+}
+
+func (d *NodeCertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_certificates"
+}
+
+func (d *NodeCertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the certificates of a Proxmox VE node: the cluster CA, the self-signed node " +
+			"certificate and the custom or ACME certificate, if any.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				MarkdownDescription: "Certificates of the node",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"filename": schema.StringAttribute{
+							MarkdownDescription: "File name of the certificate (e.g., `pveproxy-ssl.pem`)",
+							Computed:            true,
+						},
+						"in_use": schema.BoolAttribute{
+							MarkdownDescription: "Whether pveproxy serves the certificate",
+							Computed:            true,
+						},
+						"fingerprint": schema.StringAttribute{
+							MarkdownDescription: "SHA-256 fingerprint of the certificate",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "Subject of the certificate",
+							Computed:            true,
+						},
+						"issuer": schema.StringAttribute{
+							MarkdownDescription: "Issuer of the certificate",
+							Computed:            true,
+						},
+						"not_before": schema.Int64Attribute{
+							MarkdownDescription: "Start of the validity period as Unix timestamp",
+							Computed:            true,
+						},
+						"not_after": schema.Int64Attribute{
+							MarkdownDescription: "End of the validity period as Unix timestamp",
+							Computed:            true,
+						},
+						"subject_alternative_names": schema.ListAttribute{
+							MarkdownDescription: "Subject alternative names of the certificate",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"public_key_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the public key (e.g., `rsaEncryption`)",
+							Computed:            true,
+						},
+						"public_key_bits": schema.Int64Attribute{
+							MarkdownDescription: "Size of the public key in bits",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeCertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeCertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeCertificatesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox node certificates", map[string]interface{}{"node": node})
+
+	var infos []map[string]interface{}
+	if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/certificates/info", &infos); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificates of node %s, got error: %s", node, err))
+		return
+	}
+
+	// pveproxy serves the custom certificate if there is one and falls back
+	// to the self-signed node certificate otherwise.
+	served := "pve-ssl.pem"
+	for _, info := range infos {
+		if info["filename"] == nodeCertificateFile {
+			served = nodeCertificateFile
+		}
+	}
+
+	certificates := make([]NodeCertificateModel, len(infos))
+	for i, info := range infos {
+		sans := []string{}
+		if items, ok := info["san"].([]interface{}); ok {
+			for _, item := range items {
+				if san, ok := item.(string); ok {
+					sans = append(sans, san)
+				}
+			}
+		}
+		certificates[i] = NodeCertificateModel{
+			Filename:                stringValue(info, "filename"),
+			InUse:                   types.BoolValue(info["filename"] == served),
+			Fingerprint:             stringValue(info, "fingerprint"),
+			Subject:                 stringValue(info, "subject"),
+			Issuer:                  stringValue(info, "issuer"),
+			NotBefore:               int64Value(info, "notbefore"),
+			NotAfter:                int64Value(info, "notafter"),
+			SubjectAlternativeNames: sans,
+			PublicKeyType:           stringValue(info, "public-key-type"),
+			PublicKeyBits:           int64Value(info, "public-key-bits"),
+		}
+	}
+	sort.Slice(certificates, func(i, j int) bool {
+		return strings.Compare(certificates[i].Filename.ValueString(), certificates[j].Filename.ValueString()) < 0
+	})
+
+	data.ID = data.Node
+	data.Certificates = certificates
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d certificates", len(certificates)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
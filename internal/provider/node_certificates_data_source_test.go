@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeCertificatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_node_certificates" "test" {
+  node = %[1]q
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_node_certificates.test", "id", testNode()),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_node_certificates.test", "certificates.*", map[string]string{
+						"filename": "pve-root-ca.pem",
+						"in_use":   "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_node_certificates.test", "certificates.*", map[string]string{
+						"filename": "pve-ssl.pem",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeDataSource{}
+
+func NewNodeDataSource() datasource.DataSource {
+	return &NodeDataSource{}
+}
+
+// NodeDataSource defines the data source implementation.
+type NodeDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeDataSourceModel describes the data source data model.
+type NodeDataSourceModel struct {
+	ID            types.String    `tfsdk:"id"`
+	Node          types.String    `tfsdk:"node"`
+	Uptime        types.Int64     `tfsdk:"uptime"`
+	CPUUsage      types.Float64   `tfsdk:"cpu_usage"`
+	IOWait        types.Float64   `tfsdk:"io_wait"`
+	LoadAverage   []types.Float64 `tfsdk:"load_average"`
+	CPU           *NodeCPUModel   `tfsdk:"cpu"`
+	Memory        *NodeUsageModel `tfsdk:"memory"`
+	Swap          *NodeUsageModel `tfsdk:"swap"`
+	RootFS        *NodeUsageModel `tfsdk:"rootfs"`
+	KernelRelease types.String    `tfsdk:"kernel_release"`
+	KernelVersion types.String    `tfsdk:"kernel_version"`
+	Version       types.String    `tfsdk:"version"`
+	BootMode      types.String    `tfsdk:"boot_mode"`
+}
+
+// NodeCPUModel describes the CPUs of a node.
+type NodeCPUModel struct {
+	Model   types.String  `tfsdk:"model"`
+	Sockets types.Int64   `tfsdk:"sockets"`
+	Cores   types.Int64   `tfsdk:"cores"`
+	CPUs    types.Int64   `tfsdk:"cpus"`
+	MHz     types.Float64 `tfsdk:"mhz"`
+}
+
+// NodeUsageModel describes the usage of memory, swap or a filesystem.
+type NodeUsageModel struct {
+	Total types.Int64 `tfsdk:"total"`
+	Used  types.Int64 `tfsdk:"used"`
+	Free  types.Int64 `tfsdk:"free"`
+}
+
+func (d *NodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+func (d *NodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	usage := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"total": schema.Int64Attribute{
+					MarkdownDescription: "Total size in bytes",
+					Computed:            true,
+				},
+				"used": schema.Int64Attribute{
+					MarkdownDescription: "Used size in bytes",
+					Computed:            true,
+				},
+				"free": schema.Int64Attribute{
+					MarkdownDescription: "Available size in bytes",
+					Computed:            true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the detailed status of a Proxmox VE node, such as its CPU model, load, memory and " +
+			"root filesystem usage and kernel.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"uptime": schema.Int64Attribute{
+				MarkdownDescription: "Uptime of the node in seconds",
+				Computed:            true,
+			},
+			"cpu_usage": schema.Float64Attribute{
+				MarkdownDescription: "CPU usage of the node between `0` and `1`",
+				Computed:            true,
+			},
+			"io_wait": schema.Float64Attribute{
+				MarkdownDescription: "Share of CPU time spent waiting for I/O between `0` and `1`",
+				Computed:            true,
+			},
+			"load_average": schema.ListAttribute{
+				MarkdownDescription: "Load average over 1, 5 and 15 minutes",
+				ElementType:         types.Float64Type,
+				Computed:            true,
+			},
+			"cpu": schema.SingleNestedAttribute{
+				MarkdownDescription: "CPUs of the node",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"model": schema.StringAttribute{
+						MarkdownDescription: "CPU model",
+						Computed:            true,
+					},
+					"sockets": schema.Int64Attribute{
+						MarkdownDescription: "Number of CPU sockets",
+						Computed:            true,
+					},
+					"cores": schema.Int64Attribute{
+						MarkdownDescription: "Number of physical cores per socket",
+						Computed:            true,
+					},
+					"cpus": schema.Int64Attribute{
+						MarkdownDescription: "Number of logical CPUs",
+						Computed:            true,
+					},
+					"mhz": schema.Float64Attribute{
+						MarkdownDescription: "Clock speed in MHz",
+						Computed:            true,
+					},
+				},
+			},
+			"memory": usage("Memory usage of the node"),
+			"swap":   usage("Swap usage of the node"),
+			"rootfs": usage("Usage of the root filesystem of the node"),
+			"kernel_release": schema.StringAttribute{
+				MarkdownDescription: "Release of the running kernel (e.g., `6.8.12-1-pve`)",
+				Computed:            true,
+			},
+			"kernel_version": schema.StringAttribute{
+				MarkdownDescription: "Full version string of the running kernel",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Proxmox VE version of the node (e.g., `8.2.4`)",
+				Computed:            true,
+			},
+			"boot_mode": schema.StringAttribute{
+				MarkdownDescription: "Boot mode of the node, `efi` or `legacy-bios`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox node status", map[string]interface{}{"node": node})
+
+	var status map[string]interface{}
+	if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/status", &status); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status of node %s, got error: %s", node, err))
+		return
+	}
+
+	data.ID = data.Node
+	data.Uptime = int64Value(status, "uptime")
+	data.CPUUsage = float64Value(status, "cpu")
+	data.IOWait = float64Value(status, "wait")
+
+	data.LoadAverage = nil
+	loadavg, _ := status["loadavg"].([]interface{})
+	for _, load := range loadavg {
+		if f, err := strconv.ParseFloat(fmt.Sprint(load), 64); err == nil {
+			data.LoadAverage = append(data.LoadAverage, types.Float64Value(f))
+		}
+	}
+
+	cpuinfo, _ := status["cpuinfo"].(map[string]interface{})
+	data.CPU = &NodeCPUModel{
+		Model:   stringValue(cpuinfo, "model"),
+		Sockets: int64Value(cpuinfo, "sockets"),
+		Cores:   int64Value(cpuinfo, "cores"),
+		CPUs:    int64Value(cpuinfo, "cpus"),
+		MHz:     float64Value(cpuinfo, "mhz"),
+	}
+
+	memory, _ := status["memory"].(map[string]interface{})
+	data.Memory = newNodeUsageModel(memory, "free")
+	swap, _ := status["swap"].(map[string]interface{})
+	data.Swap = newNodeUsageModel(swap, "free")
+	rootfs, _ := status["rootfs"].(map[string]interface{})
+	data.RootFS = newNodeUsageModel(rootfs, "avail")
+
+	kernel, _ := status["current-kernel"].(map[string]interface{})
+	data.KernelRelease = stringValue(kernel, "release")
+	data.KernelVersion = stringValue(status, "kversion")
+
+	// pveversion has the pve-manager/8.2.4/faa83925c9641325 format.
+	data.Version = types.StringNull()
+	if parts := strings.Split(stringValue(status, "pveversion").ValueString(), "/"); len(parts) > 1 {
+		data.Version = types.StringValue(parts[1])
+	}
+
+	bootInfo, _ := status["boot-info"].(map[string]interface{})
+	data.BootMode = stringValue(bootInfo, "mode")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newNodeUsageModel converts a usage object of the node status. Filesystems
+// report the available space as avail instead of free.
+func newNodeUsageModel(usage map[string]interface{}, freeKey string) *NodeUsageModel {
+	if usage == nil {
+		return nil
+	}
+
+	return &NodeUsageModel{
+		Total: int64Value(usage, "total"),
+		Used:  int64Value(usage, "used"),
+		Free:  int64Value(usage, freeKey),
+	}
+}
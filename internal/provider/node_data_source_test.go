@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_node" "test" {
+  node = %[1]q
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_node.test", "id", testNode()),
+					resource.TestCheckResourceAttr("data.proxmox_node.test", "load_average.#", "3"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node.test", "cpu.model"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node.test", "memory.total"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node.test", "rootfs.free"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node.test", "kernel_release"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node.test", "version"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeDNSDataSource{}
+
+func NewNodeDNSDataSource() datasource.DataSource {
+	return &NodeDNSDataSource{}
+}
+
+// NodeDNSDataSource defines the data source implementation.
+type NodeDNSDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeDNSDataSourceModel describes the data source data model.
+type NodeDNSDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Node         types.String `tfsdk:"node"`
+	SearchDomain types.String `tfsdk:"search_domain"`
+	Servers      []string     `tfsdk:"servers"`
+}
+
+func (d *NodeDNSDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_dns"
+}
+
+func (d *NodeDNSDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the DNS settings of a Proxmox VE node from its `/etc/resolv.conf`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"search_domain": schema.StringAttribute{
+				MarkdownDescription: "Search domain of the node",
+				Computed:            true,
+			},
+			"servers": schema.ListAttribute{
+				MarkdownDescription: "DNS servers of the node in order of preference, up to three",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *NodeDNSDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeDNSDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeDNSDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox node DNS settings", map[string]interface{}{"node": node})
+
+	var dns map[string]interface{}
+	if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/dns", &dns); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS settings of node %s, got error: %s", node, err))
+		return
+	}
+
+	data.ID = data.Node
+	data.SearchDomain = stringValue(dns, "search")
+	data.Servers = []string{}
+	for _, key := range []string{"dns1", "dns2", "dns3"} {
+		if server := stringValue(dns, key).ValueString(); server != "" {
+			data.Servers = append(data.Servers, server)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
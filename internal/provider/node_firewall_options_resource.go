@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeFirewallOptionsResource{}
+var _ resource.ResourceWithImportState = &NodeFirewallOptionsResource{}
+
+func NewNodeFirewallOptionsResource() resource.Resource {
+	return &NodeFirewallOptionsResource{}
+}
+
+// NodeFirewallOptionsResource defines the resource implementation.
+type NodeFirewallOptionsResource struct {
+	client *ProxmoxClient
+}
+
+// NodeFirewallOptionsResourceModel describes the resource data model.
+type NodeFirewallOptionsResourceModel struct {
+	ID                               types.String `tfsdk:"id"`
+	Node                             types.String `tfsdk:"node"`
+	Enabled                          types.Bool   `tfsdk:"enabled"`
+	LogLevelIn                       types.String `tfsdk:"log_level_in"`
+	LogLevelOut                      types.String `tfsdk:"log_level_out"`
+	LogNFConntrack                   types.Bool   `tfsdk:"log_nf_conntrack"`
+	NDP                              types.Bool   `tfsdk:"ndp"`
+	NFConntrackAllowInvalid          types.Bool   `tfsdk:"nf_conntrack_allow_invalid"`
+	NFConntrackMax                   types.Int64  `tfsdk:"nf_conntrack_max"`
+	NFConntrackTCPTimeoutEstablished types.Int64  `tfsdk:"nf_conntrack_tcp_timeout_established"`
+	NoSmurfs                         types.Bool   `tfsdk:"nosmurfs"`
+	SmurfLogLevel                    types.String `tfsdk:"smurf_log_level"`
+	TCPFlags                         types.Bool   `tfsdk:"tcpflags"`
+	TCPFlagsLogLevel                 types.String `tfsdk:"tcp_flags_log_level"`
+	ProtectionSynflood               types.Bool   `tfsdk:"protection_synflood"`
+	ProtectionSynfloodBurst          types.Int64  `tfsdk:"protection_synflood_burst"`
+	ProtectionSynfloodRate           types.Int64  `tfsdk:"protection_synflood_rate"`
+}
+
+// nodeFirewallOptionsKeys lists the node firewall options managed by this
+// resource, which are reset to their defaults on delete.
+var nodeFirewallOptionsKeys = []string{
+	"enable", "log_level_in", "log_level_out", "log_nf_conntrack", "ndp", "nf_conntrack_allow_invalid",
+	"nf_conntrack_max", "nf_conntrack_tcp_timeout_established", "nosmurfs", "smurf_log_level", "tcpflags",
+	"tcp_flags_log_level", "protection_synflood", "protection_synflood_burst", "protection_synflood_rate",
+}
+
+func (r *NodeFirewallOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_firewall_options"
+}
+
+func (r *NodeFirewallOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	logLevel := "Log level (`emerg`, `alert`, `crit`, `err`, `warning`, `notice`, `info`, `debug` or `nolog`) "
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the firewall options of a Proxmox VE node. Destroying the resource resets the " +
+			"options to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the host firewall rules",
+				Optional:            true,
+			},
+			"log_level_in": schema.StringAttribute{
+				MarkdownDescription: logLevel + "for incoming traffic",
+				Optional:            true,
+			},
+			"log_level_out": schema.StringAttribute{
+				MarkdownDescription: logLevel + "for outgoing traffic",
+				Optional:            true,
+			},
+			"log_nf_conntrack": schema.BoolAttribute{
+				MarkdownDescription: "Enable logging of conntrack information",
+				Optional:            true,
+			},
+			"ndp": schema.BoolAttribute{
+				MarkdownDescription: "Enable NDP (Neighbor Discovery Protocol)",
+				Optional:            true,
+			},
+			"nf_conntrack_allow_invalid": schema.BoolAttribute{
+				MarkdownDescription: "Allow invalid packets on connection tracking",
+				Optional:            true,
+			},
+			"nf_conntrack_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of tracked connections",
+				Optional:            true,
+			},
+			"nf_conntrack_tcp_timeout_established": schema.Int64Attribute{
+				MarkdownDescription: "Conntrack established timeout in seconds",
+				Optional:            true,
+			},
+			"nosmurfs": schema.BoolAttribute{
+				MarkdownDescription: "Enable the SMURFS filter",
+				Optional:            true,
+			},
+			"smurf_log_level": schema.StringAttribute{
+				MarkdownDescription: logLevel + "for the SMURFS filter",
+				Optional:            true,
+			},
+			"tcpflags": schema.BoolAttribute{
+				MarkdownDescription: "Filter illegal combinations of TCP flags",
+				Optional:            true,
+			},
+			"tcp_flags_log_level": schema.StringAttribute{
+				MarkdownDescription: logLevel + "for the illegal TCP flags filter",
+				Optional:            true,
+			},
+			"protection_synflood": schema.BoolAttribute{
+				MarkdownDescription: "Enable SYN flood protection",
+				Optional:            true,
+			},
+			"protection_synflood_burst": schema.Int64Attribute{
+				MarkdownDescription: "SYN flood protection burst by source IP",
+				Optional:            true,
+			},
+			"protection_synflood_rate": schema.Int64Attribute{
+				MarkdownDescription: "SYN flood protection rate in SYN/s by source IP",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *NodeFirewallOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NodeFirewallOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, r.path(data.Node.ValueString()), data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall options of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Node
+
+	tflog.Trace(ctx, "created node firewall options", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeFirewallOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var options map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &options)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall options of node %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Node = data.ID
+	data.Enabled = boolValue(options, "enable")
+	data.LogLevelIn = stringValue(options, "log_level_in")
+	data.LogLevelOut = stringValue(options, "log_level_out")
+	data.LogNFConntrack = boolValue(options, "log_nf_conntrack")
+	data.NDP = boolValue(options, "ndp")
+	data.NFConntrackAllowInvalid = boolValue(options, "nf_conntrack_allow_invalid")
+	data.NFConntrackMax = int64Value(options, "nf_conntrack_max")
+	data.NFConntrackTCPTimeoutEstablished = int64Value(options, "nf_conntrack_tcp_timeout_established")
+	data.NoSmurfs = boolValue(options, "nosmurfs")
+	data.SmurfLogLevel = stringValue(options, "smurf_log_level")
+	data.TCPFlags = boolValue(options, "tcpflags")
+	data.TCPFlagsLogLevel = stringValue(options, "tcp_flags_log_level")
+	data.ProtectionSynflood = boolValue(options, "protection_synflood")
+	data.ProtectionSynfloodBurst = int64Value(options, "protection_synflood_burst")
+	data.ProtectionSynfloodRate = int64Value(options, "protection_synflood_rate")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeFirewallOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.Node.ValueString()), data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall options of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeFirewallOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeFirewallOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := apiParams{}
+	for _, key := range nodeFirewallOptionsKeys {
+		params.remove(key)
+	}
+
+	err := r.client.Put(ctx, r.path(data.Node.ValueString()), params, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset firewall options of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+func (r *NodeFirewallOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NodeFirewallOptionsResource) path(node string) string {
+	return "/nodes/" + url.PathEscape(node) + "/firewall/options"
+}
+
+// params returns the update parameters for the options. Options missing from
+// the configuration are reset to their defaults.
+func (m NodeFirewallOptionsResourceModel) params() apiParams {
+	params := apiParams{}
+	params.updateBool("enable", m.Enabled)
+	params.updateString("log_level_in", m.LogLevelIn)
+	params.updateString("log_level_out", m.LogLevelOut)
+	params.updateBool("log_nf_conntrack", m.LogNFConntrack)
+	params.updateBool("ndp", m.NDP)
+	params.updateBool("nf_conntrack_allow_invalid", m.NFConntrackAllowInvalid)
+	params.updateInt64("nf_conntrack_max", m.NFConntrackMax)
+	params.updateInt64("nf_conntrack_tcp_timeout_established", m.NFConntrackTCPTimeoutEstablished)
+	params.updateBool("nosmurfs", m.NoSmurfs)
+	params.updateString("smurf_log_level", m.SmurfLogLevel)
+	params.updateBool("tcpflags", m.TCPFlags)
+	params.updateString("tcp_flags_log_level", m.TCPFlagsLogLevel)
+	params.updateBool("protection_synflood", m.ProtectionSynflood)
+	params.updateInt64("protection_synflood_burst", m.ProtectionSynfloodBurst)
+	params.updateInt64("protection_synflood_rate", m.ProtectionSynfloodRate)
+	return params
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeFirewallOptionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNodeFirewallOptionsResourceConfig("info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_firewall_options.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_node_firewall_options.test", "tcpflags", "true"),
+					resource.TestCheckResourceAttr("proxmox_node_firewall_options.test", "log_level_in", "info"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_node_firewall_options.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNodeFirewallOptionsResourceConfig("warning"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_firewall_options.test", "log_level_in", "warning"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeFirewallOptionsResourceConfig(logLevel string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_node_firewall_options" "test" {
+  node         = %[1]q
+  nosmurfs     = true
+  tcpflags     = true
+  log_level_in = %[2]q
+}
+`, testNode(), logLevel)
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeHostsResource{}
+var _ resource.ResourceWithImportState = &NodeHostsResource{}
+
+func NewNodeHostsResource() resource.Resource {
+	return &NodeHostsResource{}
+}
+
+// NodeHostsResource defines the resource implementation.
+type NodeHostsResource struct {
+	client *ProxmoxClient
+}
+
+// NodeHostsResourceModel describes the resource data model.
+type NodeHostsResourceModel struct {
+	ID      types.String          `tfsdk:"id"`
+	Node    types.String          `tfsdk:"node"`
+	Entries []NodeHostsEntryModel `tfsdk:"entry"`
+}
+
+// NodeHostsEntryModel describes a single line of the hosts file.
+type NodeHostsEntryModel struct {
+	Address   types.String `tfsdk:"address"`
+	Hostnames types.List   `tfsdk:"hostnames"`
+}
+
+func (r *NodeHostsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_hosts"
+}
+
+func (r *NodeHostsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages entries of the `/etc/hosts` file of a Proxmox VE node. Only the lines of the " +
+			"configured addresses are managed, other lines are left untouched. The file is updated using the digest " +
+			"of its current content, so concurrent modifications are detected instead of being overwritten.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"entry": schema.ListNestedBlock{
+				MarkdownDescription: "Hosts file entry",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "IP address",
+							Required:            true,
+						},
+						"hostnames": schema.ListAttribute{
+							MarkdownDescription: "Host names of the address, the canonical name first",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators:          []validator.List{validators.DNSName()},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *NodeHostsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NodeHostsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeHostsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries := hostsEntries(ctx, data.Entries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, data.Node.ValueString(), entries, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hosts file of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Node
+
+	tflog.Trace(ctx, "created node hosts entries", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeHostsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeHostsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	hosts, err := r.read(ctx, data.ID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hosts file of node %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// After import nothing is known about the managed entries, so adopt all
+	// of them. Otherwise only keep the addresses this resource is managing.
+	importing := data.Entries == nil
+	var managed []string
+	for _, entry := range data.Entries {
+		managed = append(managed, entry.Address.ValueString())
+	}
+
+	entries := []NodeHostsEntryModel{}
+	for _, entry := range parseHostsFile(hosts.Data) {
+		if !importing && !slices.Contains(managed, entry.address) {
+			continue
+		}
+		hostnames, diags := types.ListValueFrom(ctx, types.StringType, entry.hostnames)
+		resp.Diagnostics.Append(diags...)
+		entries = append(entries, NodeHostsEntryModel{
+			Address:   types.StringValue(entry.address),
+			Hostnames: hostnames,
+		})
+	}
+
+	data.Node = data.ID
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeHostsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NodeHostsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	entries := hostsEntries(ctx, data.Entries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+	for _, entry := range state.Entries {
+		removed = append(removed, entry.Address.ValueString())
+	}
+
+	if err := r.update(ctx, data.Node.ValueString(), entries, removed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hosts file of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeHostsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeHostsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var removed []string
+	for _, entry := range data.Entries {
+		removed = append(removed, entry.Address.ValueString())
+	}
+
+	err := r.update(ctx, data.Node.ValueString(), nil, removed)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hosts file of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+func (r *NodeHostsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// nodeHosts is the hosts file as returned by the API.
+type nodeHosts struct {
+	Data   string `json:"data"`
+	Digest string `json:"digest"`
+}
+
+func (r *NodeHostsResource) read(ctx context.Context, node string) (nodeHosts, error) {
+	var hosts nodeHosts
+	err := r.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/hosts", &hosts)
+	return hosts, err
+}
+
+// update writes entries to the hosts file and drops the lines of the removed
+// addresses that are not part of entries. The digest of the file read before
+// makes the write fail if the file has been modified in the meantime.
+func (r *NodeHostsResource) update(ctx context.Context, node string, entries []hostsEntry, removed []string) error {
+	hosts, err := r.read(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Post(ctx, "/nodes/"+url.PathEscape(node)+"/hosts", map[string]interface{}{
+		"data":   updateHostsFile(hosts.Data, entries, removed),
+		"digest": hosts.Digest,
+	}, nil)
+}
+
+// hostsEntry is a single entry of a hosts file.
+type hostsEntry struct {
+	address   string
+	hostnames []string
+}
+
+// hostsEntries converts the configured entries.
+func hostsEntries(ctx context.Context, models []NodeHostsEntryModel, diags *diag.Diagnostics) []hostsEntry {
+	entries := make([]hostsEntry, len(models))
+	for i, model := range models {
+		entries[i].address = model.Address.ValueString()
+		diags.Append(model.Hostnames.ElementsAs(ctx, &entries[i].hostnames, false)...)
+	}
+	return entries
+}
+
+// parseHostsFile returns the entries of a hosts file, ignoring comments.
+func parseHostsFile(content string) []hostsEntry {
+	var entries []hostsEntry
+	for _, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		entries = append(entries, hostsEntry{address: fields[0], hostnames: fields[1:]})
+	}
+	return entries
+}
+
+// updateHostsFile replaces the lines of the addresses in entries and removed
+// in content. Entries replace the first line of their address in place and
+// are appended if the address is not in the file yet. All other lines of
+// these addresses are dropped.
+func updateHostsFile(content string, entries []hostsEntry, removed []string) string {
+	pending := map[string]hostsEntry{}
+	for _, entry := range entries {
+		pending[entry.address] = entry
+	}
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		value, _, _ := strings.Cut(line, "#")
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			lines = append(lines, line)
+			continue
+		}
+
+		address := fields[0]
+		if entry, ok := pending[address]; ok {
+			lines = append(lines, entry.String())
+			delete(pending, address)
+			continue
+		}
+		if slices.Contains(removed, address) || slices.ContainsFunc(entries, func(e hostsEntry) bool { return e.address == address }) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	for _, entry := range entries {
+		if _, ok := pending[entry.address]; ok {
+			lines = append(lines, entry.String())
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (e hostsEntry) String() string {
+	return e.address + " " + strings.Join(e.hostnames, " ")
+}
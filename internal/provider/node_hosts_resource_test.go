@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeHostsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNodeHostsResourceConfig("ceph-a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_hosts.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_node_hosts.test", "entry.#", "1"),
+					resource.TestCheckResourceAttr("proxmox_node_hosts.test", "entry.0.hostnames.0", "ceph-a.test"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNodeHostsResourceConfig("ceph-b"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_hosts.test", "entry.0.hostnames.0", "ceph-b.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeHostsResourceConfig(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_node_hosts" "test" {
+  node = %[1]q
+
+  entry {
+    address   = "192.0.2.250"
+    hostnames = ["%[2]s.test", %[2]q]
+  }
+}
+`, testNode(), name)
+}
+
+func TestUpdateHostsFile(t *testing.T) {
+	content := `127.0.0.1 localhost.localdomain localhost
+# cluster
+10.0.0.11 pve1.example.com pve1
+10.0.0.12 pve2.example.com pve2
+10.0.0.12 old-pve2
+10.0.0.13 pve3.example.com pve3
+`
+	entries := []hostsEntry{
+		{address: "10.0.0.12", hostnames: []string{"pve2.example.com", "pve2", "ceph2"}},
+		{address: "10.0.0.14", hostnames: []string{"pve4.example.com", "pve4"}},
+	}
+
+	got := updateHostsFile(content, entries, []string{"10.0.0.13"})
+	want := `127.0.0.1 localhost.localdomain localhost
+# cluster
+10.0.0.11 pve1.example.com pve1
+10.0.0.12 pve2.example.com pve2 ceph2
+10.0.0.14 pve4.example.com pve4
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	parsed := parseHostsFile(got)
+	if len(parsed) != 4 || parsed[2].String() != entries[0].String() {
+		t.Errorf("unexpected entries: %v", parsed)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeMetricsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &NodeMetricsDataSource{}
+
+// rrdTimeframes lists the timeframes of the Proxmox VE RRD databases.
+var rrdTimeframes = []string{"hour", "day", "week", "month", "year"}
+
+// rrdConsolidations lists the consolidation functions of the RRD databases.
+var rrdConsolidations = []string{"AVERAGE", "MAX"}
+
+func NewNodeMetricsDataSource() datasource.DataSource {
+	return &NodeMetricsDataSource{}
+}
+
+// NodeMetricsDataSource defines the data source implementation.
+type NodeMetricsDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeMetricsDataSourceModel describes the data source data model.
+type NodeMetricsDataSourceModel struct {
+	ID                types.String       `tfsdk:"id"`
+	Node              types.String       `tfsdk:"node"`
+	Timeframe         types.String       `tfsdk:"timeframe"`
+	Consolidation     types.String       `tfsdk:"consolidation"`
+	CPUAverage        types.Float64      `tfsdk:"cpu_average"`
+	CPUPeak           types.Float64      `tfsdk:"cpu_peak"`
+	IOWaitAverage     types.Float64      `tfsdk:"io_wait_average"`
+	MemoryUsedAverage types.Float64      `tfsdk:"memory_used_average"`
+	MemoryUsedPeak    types.Float64      `tfsdk:"memory_used_peak"`
+	MemoryTotal       types.Float64      `tfsdk:"memory_total"`
+	Points            []NodeMetricsModel `tfsdk:"points"`
+}
+
+// NodeMetricsModel describes a sample of the node RRD database.
+type NodeMetricsModel struct {
+	Time        types.Int64   `tfsdk:"time"`
+	CPU         types.Float64 `tfsdk:"cpu"`
+	IOWait      types.Float64 `tfsdk:"io_wait"`
+	LoadAverage types.Float64 `tfsdk:"load_average"`
+	MemoryUsed  types.Float64 `tfsdk:"memory_used"`
+	MemoryTotal types.Float64 `tfsdk:"memory_total"`
+	SwapUsed    types.Float64 `tfsdk:"swap_used"`
+	RootfsUsed  types.Float64 `tfsdk:"rootfs_used"`
+	NetworkIn   types.Float64 `tfsdk:"network_in"`
+	NetworkOut  types.Float64 `tfsdk:"network_out"`
+}
+
+func (d *NodeMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_metrics"
+}
+
+func (d *NodeMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads recent resource usage of a Proxmox VE node from its RRD database, e.g. to place " +
+			"guests on the least loaded node. Samples without data, such as while the node was down, are null and " +
+			"ignored by the aggregates.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"timeframe": schema.StringAttribute{
+				MarkdownDescription: "Period to return, one of `hour`, `day`, `week`, `month` or `year`. Defaults to `hour`",
+				Optional:            true,
+			},
+			"consolidation": schema.StringAttribute{
+				MarkdownDescription: "How samples are consolidated over their interval, `AVERAGE` or `MAX`. Defaults " +
+					"to `AVERAGE`",
+				Optional: true,
+			},
+			"cpu_average": schema.Float64Attribute{
+				MarkdownDescription: "Average CPU usage over the period, between 0 and 1",
+				Computed:            true,
+			},
+			"cpu_peak": schema.Float64Attribute{
+				MarkdownDescription: "Highest CPU usage of a sample, between 0 and 1",
+				Computed:            true,
+			},
+			"io_wait_average": schema.Float64Attribute{
+				MarkdownDescription: "Average fraction of CPU time spent waiting for IO, between 0 and 1",
+				Computed:            true,
+			},
+			"memory_used_average": schema.Float64Attribute{
+				MarkdownDescription: "Average used memory in bytes",
+				Computed:            true,
+			},
+			"memory_used_peak": schema.Float64Attribute{
+				MarkdownDescription: "Highest used memory of a sample in bytes",
+				Computed:            true,
+			},
+			"memory_total": schema.Float64Attribute{
+				MarkdownDescription: "Total memory in bytes, as of the latest sample",
+				Computed:            true,
+			},
+			"points": schema.ListNestedAttribute{
+				MarkdownDescription: "Samples, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.Int64Attribute{
+							MarkdownDescription: "Time of the sample as Unix timestamp",
+							Computed:            true,
+						},
+						"cpu": schema.Float64Attribute{
+							MarkdownDescription: "CPU usage, between 0 and 1",
+							Computed:            true,
+						},
+						"io_wait": schema.Float64Attribute{
+							MarkdownDescription: "Fraction of CPU time spent waiting for IO, between 0 and 1",
+							Computed:            true,
+						},
+						"load_average": schema.Float64Attribute{
+							MarkdownDescription: "One minute load average",
+							Computed:            true,
+						},
+						"memory_used": schema.Float64Attribute{
+							MarkdownDescription: "Used memory in bytes",
+							Computed:            true,
+						},
+						"memory_total": schema.Float64Attribute{
+							MarkdownDescription: "Total memory in bytes",
+							Computed:            true,
+						},
+						"swap_used": schema.Float64Attribute{
+							MarkdownDescription: "Used swap in bytes",
+							Computed:            true,
+						},
+						"rootfs_used": schema.Float64Attribute{
+							MarkdownDescription: "Used space of the root file system in bytes",
+							Computed:            true,
+						},
+						"network_in": schema.Float64Attribute{
+							MarkdownDescription: "Received network traffic in bytes per second",
+							Computed:            true,
+						},
+						"network_out": schema.Float64Attribute{
+							MarkdownDescription: "Sent network traffic in bytes per second",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeMetricsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data NodeMetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := data.Timeframe; !v.IsNull() && !v.IsUnknown() && !slices.Contains(rrdTimeframes, v.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeframe"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The timeframe must be one of %v.", rrdTimeframes),
+		)
+	}
+	if v := data.Consolidation; !v.IsNull() && !v.IsUnknown() && !slices.Contains(rrdConsolidations, v.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("consolidation"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The consolidation must be one of %v.", rrdConsolidations),
+		)
+	}
+}
+
+func (d *NodeMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeMetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	query := url.Values{"timeframe": {"hour"}, "cf": {"AVERAGE"}}
+	if !data.Timeframe.IsNull() {
+		query.Set("timeframe", data.Timeframe.ValueString())
+	}
+	if !data.Consolidation.IsNull() {
+		query.Set("cf", data.Consolidation.ValueString())
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox node metrics", map[string]interface{}{"node": node, "timeframe": query.Get("timeframe")})
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/rrddata?"+query.Encode(), &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metrics of node %s, got error: %s", node, err))
+		return
+	}
+
+	points := make([]NodeMetricsModel, len(entries))
+	for i, entry := range entries {
+		points[i] = NodeMetricsModel{
+			Time:        int64Value(entry, "time"),
+			CPU:         float64Value(entry, "cpu"),
+			IOWait:      float64Value(entry, "iowait"),
+			LoadAverage: float64Value(entry, "loadavg"),
+			MemoryUsed:  float64Value(entry, "memused"),
+			MemoryTotal: float64Value(entry, "memtotal"),
+			SwapUsed:    float64Value(entry, "swapused"),
+			RootfsUsed:  float64Value(entry, "rootused"),
+			NetworkIn:   float64Value(entry, "netin"),
+			NetworkOut:  float64Value(entry, "netout"),
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Time.ValueInt64() < points[j].Time.ValueInt64()
+	})
+
+	data.ID = data.Node
+	data.Points = points
+	data.CPUAverage, data.CPUPeak = aggregateMetric(points, func(p NodeMetricsModel) types.Float64 { return p.CPU })
+	data.IOWaitAverage, _ = aggregateMetric(points, func(p NodeMetricsModel) types.Float64 { return p.IOWait })
+	data.MemoryUsedAverage, data.MemoryUsedPeak = aggregateMetric(points, func(p NodeMetricsModel) types.Float64 { return p.MemoryUsed })
+	data.MemoryTotal = types.Float64Null()
+	for _, point := range points {
+		if !point.MemoryTotal.IsNull() {
+			data.MemoryTotal = point.MemoryTotal
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d metric samples", len(points)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// aggregateMetric returns the average and the maximum of a metric, skipping
+// samples without data. Both are null if no sample has data.
+func aggregateMetric(points []NodeMetricsModel, metric func(NodeMetricsModel) types.Float64) (types.Float64, types.Float64) {
+	var sum, peak float64
+	var n int
+	for _, point := range points {
+		value := metric(point)
+		if value.IsNull() {
+			continue
+		}
+		if n == 0 || value.ValueFloat64() > peak {
+			peak = value.ValueFloat64()
+		}
+		sum += value.ValueFloat64()
+		n++
+	}
+	if n == 0 {
+		return types.Float64Null(), types.Float64Null()
+	}
+	return types.Float64Value(sum / float64(n)), types.Float64Value(peak)
+}
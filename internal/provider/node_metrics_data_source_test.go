@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeMetricsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_node_metrics" "test" {
+  node      = %[1]q
+  timeframe = "day"
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_node_metrics.test", "id", testNode()),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_metrics.test", "cpu_average"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_metrics.test", "memory_total"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_metrics.test", "points.0.time"),
+				),
+			},
+			// Invalid timeframe
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_node_metrics" "test" {
+  node      = %[1]q
+  timeframe = "decade"
+}
+`, testNode()),
+				ExpectError: regexp.MustCompile(`The timeframe must be one of`),
+			},
+		},
+	})
+}
+
+func TestAggregateMetric(t *testing.T) {
+	points := []NodeMetricsModel{
+		{CPU: types.Float64Value(0.2)},
+		{CPU: types.Float64Null()},
+		{CPU: types.Float64Value(0.6)},
+	}
+	cpu := func(p NodeMetricsModel) types.Float64 { return p.CPU }
+
+	average, peak := aggregateMetric(points, cpu)
+	if average.ValueFloat64() < 0.39 || average.ValueFloat64() > 0.41 || peak.ValueFloat64() != 0.6 {
+		t.Errorf("got average %s and peak %s", average, peak)
+	}
+
+	average, peak = aggregateMetric(points[1:2], cpu)
+	if !average.IsNull() || !peak.IsNull() {
+		t.Errorf("expected null aggregates, got %s and %s", average, peak)
+	}
+}
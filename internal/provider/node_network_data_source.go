@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeNetworkDataSource{}
+
+func NewNodeNetworkDataSource() datasource.DataSource {
+	return &NodeNetworkDataSource{}
+}
+
+// NodeNetworkDataSource defines the data source implementation.
+type NodeNetworkDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeNetworkDataSourceModel describes the data source data model.
+type NodeNetworkDataSourceModel struct {
+	ID         types.String                `tfsdk:"id"`
+	Node       types.String                `tfsdk:"node"`
+	Type       types.String                `tfsdk:"type"`
+	Interfaces []NodeNetworkInterfaceModel `tfsdk:"interfaces"`
+}
+
+// NodeNetworkInterfaceModel describes a network interface of a node.
+type NodeNetworkInterfaceModel struct {
+	Iface       types.String `tfsdk:"iface"`
+	Type        types.String `tfsdk:"type"`
+	Active      types.Bool   `tfsdk:"active"`
+	Autostart   types.Bool   `tfsdk:"autostart"`
+	CIDR        types.String `tfsdk:"cidr"`
+	Gateway     types.String `tfsdk:"gateway"`
+	CIDR6       types.String `tfsdk:"cidr6"`
+	Gateway6    types.String `tfsdk:"gateway6"`
+	MTU         types.Int64  `tfsdk:"mtu"`
+	BridgePorts []string     `tfsdk:"bridge_ports"`
+	Slaves      []string     `tfsdk:"slaves"`
+	Comments    types.String `tfsdk:"comments"`
+}
+
+func (d *NodeNetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_network"
+}
+
+func (d *NodeNetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the network interfaces of a Proxmox VE node, including staged interfaces that " +
+			"have not been applied yet.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list interfaces of this type (e.g., `eth`, `bridge`, `bond`, `vlan`, `OVSBridge`), " +
+					"or `any_bridge` for Linux and OVS bridges",
+				Optional: true,
+			},
+			"interfaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Network interfaces, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"iface": schema.StringAttribute{
+							MarkdownDescription: "Name of the interface",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the interface",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the interface is active",
+							Computed:            true,
+						},
+						"autostart": schema.BoolAttribute{
+							MarkdownDescription: "Whether the interface is brought up on boot",
+							Computed:            true,
+						},
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "IPv4 address in CIDR notation",
+							Computed:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "IPv4 default gateway",
+							Computed:            true,
+						},
+						"cidr6": schema.StringAttribute{
+							MarkdownDescription: "IPv6 address in CIDR notation",
+							Computed:            true,
+						},
+						"gateway6": schema.StringAttribute{
+							MarkdownDescription: "IPv6 default gateway",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the interface",
+							Computed:            true,
+						},
+						"bridge_ports": schema.ListAttribute{
+							MarkdownDescription: "Ports of Linux and OVS bridges",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"slaves": schema.ListAttribute{
+							MarkdownDescription: "Members of Linux and OVS bonds",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"comments": schema.StringAttribute{
+							MarkdownDescription: "Interface comment",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeNetworkDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	listPath := networkPath(node)
+	if !data.Type.IsNull() {
+		listPath += "?type=" + url.QueryEscape(data.Type.ValueString())
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox node network interfaces", map[string]interface{}{"node": node})
+
+	var ifaces []map[string]interface{}
+	if err := d.client.GetCached(ctx, listPath, &ifaces); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network interfaces of node %s, got error: %s", node, err))
+		return
+	}
+
+	interfaces := make([]NodeNetworkInterfaceModel, len(ifaces))
+	for i, iface := range ifaces {
+		var model NetworkInterfaceModel
+		model.read(iface)
+
+		interfaces[i] = NodeNetworkInterfaceModel{
+			Iface:       stringValue(iface, "iface"),
+			Type:        stringValue(iface, "type"),
+			Active:      boolValue(iface, "active"),
+			Autostart:   model.Autostart,
+			CIDR:        model.CIDR.StringValue,
+			Gateway:     model.Gateway.StringValue,
+			CIDR6:       model.CIDR6.StringValue,
+			Gateway6:    model.Gateway6.StringValue,
+			MTU:         model.MTU,
+			BridgePorts: splitList(stringValue(iface, "bridge_ports").ValueString() + " " + stringValue(iface, "ovs_ports").ValueString()),
+			Slaves:      splitList(stringValue(iface, "slaves").ValueString() + " " + stringValue(iface, "ovs_bonds").ValueString()),
+			Comments:    model.Comments,
+		}
+		if interfaces[i].Active.IsNull() {
+			interfaces[i].Active = types.BoolValue(false)
+		}
+	}
+	sort.Slice(interfaces, func(i, j int) bool {
+		return strings.Compare(interfaces[i].Iface.ValueString(), interfaces[j].Iface.ValueString()) < 0
+	})
+
+	data.Interfaces = interfaces
+	data.ID = data.Node
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d network interfaces", len(interfaces)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeNetworkDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_node_network" "test" {
+  node = %[1]q
+}
+
+data "proxmox_node_network" "bridges" {
+  node = %[1]q
+  type = "bridge"
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_node_network.test", "id", testNode()),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_network.test", "interfaces.0.iface"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_network.test", "interfaces.0.type"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_node_network.bridges", "interfaces.*", map[string]string{
+						"type": "bridge",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeOptionsResource{}
+var _ resource.ResourceWithImportState = &NodeOptionsResource{}
+
+func NewNodeOptionsResource() resource.Resource {
+	return &NodeOptionsResource{}
+}
+
+// NodeOptionsResource defines the resource implementation.
+type NodeOptionsResource struct {
+	client *ProxmoxClient
+}
+
+// NodeOptionsResourceModel describes the resource data model.
+type NodeOptionsResourceModel struct {
+	ID                  types.String        `tfsdk:"id"`
+	Node                types.String        `tfsdk:"node"`
+	Description         types.String        `tfsdk:"description"`
+	StartAllOnBootDelay types.Int64         `tfsdk:"start_all_on_boot_delay"`
+	WakeOnLAN           *NodeWakeOnLANModel `tfsdk:"wake_on_lan"`
+}
+
+// NodeWakeOnLANModel describes the wakeonlan property string.
+type NodeWakeOnLANModel struct {
+	MAC              NormalizedStringValue `tfsdk:"mac"`
+	BindInterface    types.String          `tfsdk:"bind_interface"`
+	BroadcastAddress types.String          `tfsdk:"broadcast_address"`
+}
+
+// nodeOptionsKeys lists the node options managed by this resource. The ACME
+// options of the same configuration are managed by proxmox_acme_certificate.
+var nodeOptionsKeys = []string{"description", "startall-onboot-delay", "wakeonlan"}
+
+func (r *NodeOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_options"
+}
+
+func (r *NodeOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the options of a Proxmox VE node. Only one instance of this resource should exist " +
+			"per node. Destroying it resets the options to their defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the node name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Notes of the node, shown in the summary panel of the web interface",
+				Optional:            true,
+			},
+			"start_all_on_boot_delay": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait after boot before starting the guests marked to start on boot",
+				Optional:            true,
+			},
+			"wake_on_lan": schema.SingleNestedAttribute{
+				MarkdownDescription: "Wake-on-LAN settings used to power on the node through another node",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"mac": schema.StringAttribute{
+						MarkdownDescription: "MAC address the magic packet is sent to",
+						CustomType:          macAddressType,
+						Required:            true,
+					},
+					"bind_interface": schema.StringAttribute{
+						MarkdownDescription: "Interface the magic packet is sent from",
+						Optional:            true,
+						Validators:          []validator.String{validators.BridgeName()},
+					},
+					"broadcast_address": schema.StringAttribute{
+						MarkdownDescription: "IPv4 broadcast address the magic packet is sent to",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *NodeOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NodeOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Put(ctx, nodeConfigPath(data.Node.ValueString()), data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update options of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Node
+
+	tflog.Trace(ctx, "created node options", map[string]interface{}{"node": data.Node.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var options map[string]interface{}
+	err := r.client.Get(ctx, nodeConfigPath(data.ID.ValueString()), &options)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read options of node %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Node = data.ID
+	data.Description = stringValue(options, "description")
+	data.StartAllOnBootDelay = int64Value(options, "startall-onboot-delay")
+	data.WakeOnLAN = newNodeWakeOnLANModel(options)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeOptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, nodeConfigPath(data.Node.ValueString()), data.params(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update options of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeOptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := apiParams{}
+	for _, key := range nodeOptionsKeys {
+		params.remove(key)
+	}
+
+	err := r.client.Put(ctx, nodeConfigPath(data.Node.ValueString()), params, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset options of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+func (r *NodeOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the options. Unset options are
+// removed, as the resource manages them all.
+func (m NodeOptionsResourceModel) params() apiParams {
+	params := apiParams{}
+	params.updateString("description", m.Description)
+	params.updateInt64("startall-onboot-delay", m.StartAllOnBootDelay)
+	params.updateString("wakeonlan", m.WakeOnLAN.value())
+	return params
+}
+
+func (m *NodeWakeOnLANModel) value() types.String {
+	if m == nil {
+		return types.StringNull()
+	}
+
+	var props propertyString
+	props.addString("mac", m.MAC.StringValue)
+	props.addString("bind-interface", m.BindInterface)
+	props.addString("broadcast-address", m.BroadcastAddress)
+	return types.StringValue(props.String())
+}
+
+func newNodeWakeOnLANModel(options map[string]interface{}) *NodeWakeOnLANModel {
+	props := propertyStringValues(options, "wakeonlan", "mac")
+	if len(props) == 0 {
+		return nil
+	}
+
+	return &NodeWakeOnLANModel{
+		MAC:              macAddressType.value(propertyStringValue(props, "mac")),
+		BindInterface:    propertyStringValue(props, "bind-interface"),
+		BroadcastAddress: propertyStringValue(props, "broadcast-address"),
+	}
+}
+
+// nodeConfigPath returns the API path of the configuration of a node.
+func nodeConfigPath(node string) string {
+	return "/nodes/" + url.PathEscape(node) + "/config"
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeOptionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNodeOptionsResourceConfig("Managed by Terraform", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_options.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_node_options.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("proxmox_node_options.test", "start_all_on_boot_delay", "10"),
+					resource.TestCheckResourceAttr("proxmox_node_options.test", "wake_on_lan.mac", "02:00:00:00:00:01"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_node_options.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNodeOptionsResourceConfig("Updated by Terraform", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_options.test", "description", "Updated by Terraform"),
+					resource.TestCheckResourceAttr("proxmox_node_options.test", "start_all_on_boot_delay", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeOptionsResourceConfig(description string, delay int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_node_options" "test" {
+  node                    = %[1]q
+  description             = %[2]q
+  start_all_on_boot_delay = %[3]d
+
+  wake_on_lan = {
+    mac = "02:00:00:00:00:01"
+  }
+}
+`, testNode(), description, delay)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodePCIDevicesDataSource{}
+
+func NewNodePCIDevicesDataSource() datasource.DataSource {
+	return &NodePCIDevicesDataSource{}
+}
+
+// NodePCIDevicesDataSource defines the data source implementation.
+type NodePCIDevicesDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodePCIDevicesDataSourceModel describes the data source data model.
+type NodePCIDevicesDataSourceModel struct {
+	ID         types.String     `tfsdk:"id"`
+	Node       types.String     `tfsdk:"node"`
+	AllClasses types.Bool       `tfsdk:"all_classes"`
+	Devices    []PCIDeviceModel `tfsdk:"devices"`
+}
+
+// PCIDeviceModel describes a PCI device of a node.
+type PCIDeviceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Class               types.String `tfsdk:"class"`
+	VendorID            types.String `tfsdk:"vendor_id"`
+	VendorName          types.String `tfsdk:"vendor_name"`
+	DeviceID            types.String `tfsdk:"device_id"`
+	DeviceName          types.String `tfsdk:"device_name"`
+	SubsystemVendorID   types.String `tfsdk:"subsystem_vendor_id"`
+	SubsystemVendorName types.String `tfsdk:"subsystem_vendor_name"`
+	SubsystemDeviceID   types.String `tfsdk:"subsystem_device_id"`
+	SubsystemDeviceName types.String `tfsdk:"subsystem_device_name"`
+	IOMMUGroup          types.Int64  `tfsdk:"iommu_group"`
+	Mdev                types.Bool   `tfsdk:"mdev"`
+}
+
+func (d *NodePCIDevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_pci_devices"
+}
+
+func (d *NodePCIDevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the PCI devices of a Proxmox VE node, e.g. to pass a device through to a virtual " +
+			"machine.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"all_classes": schema.BoolAttribute{
+				MarkdownDescription: "Also list memory controllers, bridges and processors, which cannot be passed " +
+					"through. Defaults to `false`",
+				Optional: true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "PCI devices, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "PCI address of the device (e.g., `0000:01:00.0`)",
+							Computed:            true,
+						},
+						"class": schema.StringAttribute{
+							MarkdownDescription: "PCI class of the device (e.g., `0x030000`)",
+							Computed:            true,
+						},
+						"vendor_id": schema.StringAttribute{
+							MarkdownDescription: "Vendor ID (e.g., `0x10de`)",
+							Computed:            true,
+						},
+						"vendor_name": schema.StringAttribute{
+							MarkdownDescription: "Vendor name",
+							Computed:            true,
+						},
+						"device_id": schema.StringAttribute{
+							MarkdownDescription: "Device ID",
+							Computed:            true,
+						},
+						"device_name": schema.StringAttribute{
+							MarkdownDescription: "Device name",
+							Computed:            true,
+						},
+						"subsystem_vendor_id": schema.StringAttribute{
+							MarkdownDescription: "Subsystem vendor ID",
+							Computed:            true,
+						},
+						"subsystem_vendor_name": schema.StringAttribute{
+							MarkdownDescription: "Subsystem vendor name",
+							Computed:            true,
+						},
+						"subsystem_device_id": schema.StringAttribute{
+							MarkdownDescription: "Subsystem device ID",
+							Computed:            true,
+						},
+						"subsystem_device_name": schema.StringAttribute{
+							MarkdownDescription: "Subsystem device name",
+							Computed:            true,
+						},
+						"iommu_group": schema.Int64Attribute{
+							MarkdownDescription: "IOMMU group of the device, `-1` if IOMMU is not enabled",
+							Computed:            true,
+						},
+						"mdev": schema.BoolAttribute{
+							MarkdownDescription: "Whether the device supports mediated devices, e.g. vGPUs",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodePCIDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodePCIDevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodePCIDevicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	listPath := "/nodes/" + url.PathEscape(node) + "/hardware/pci"
+	if data.AllClasses.ValueBool() {
+		// An empty blacklist replaces the default one of the API.
+		listPath += "?pci-class-blacklist="
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox node PCI devices", map[string]interface{}{"node": node})
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, listPath, &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PCI devices of node %s, got error: %s", node, err))
+		return
+	}
+
+	devices := make([]PCIDeviceModel, len(entries))
+	for i, entry := range entries {
+		devices[i] = PCIDeviceModel{
+			ID:                  stringValue(entry, "id"),
+			Class:               stringValue(entry, "class"),
+			VendorID:            stringValue(entry, "vendor"),
+			VendorName:          stringValue(entry, "vendor_name"),
+			DeviceID:            stringValue(entry, "device"),
+			DeviceName:          stringValue(entry, "device_name"),
+			SubsystemVendorID:   stringValue(entry, "subsystem_vendor"),
+			SubsystemVendorName: stringValue(entry, "subsystem_vendor_name"),
+			SubsystemDeviceID:   stringValue(entry, "subsystem_device"),
+			SubsystemDeviceName: stringValue(entry, "subsystem_device_name"),
+			IOMMUGroup:          int64Value(entry, "iommugroup"),
+			Mdev:                types.BoolValue(boolValue(entry, "mdev").ValueBool()),
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return strings.Compare(devices[i].ID.ValueString(), devices[j].ID.ValueString()) < 0
+	})
+
+	data.Devices = devices
+	data.ID = data.Node
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d PCI devices", len(devices)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
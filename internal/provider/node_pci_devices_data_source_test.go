@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodePCIDevicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_node_pci_devices" "test" {
+  node        = %[1]q
+  all_classes = true
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_node_pci_devices.test", "id", testNode()),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_pci_devices.test", "devices.0.id"),
+					resource.TestCheckResourceAttrSet("data.proxmox_node_pci_devices.test", "devices.0.vendor_id"),
+				),
+			},
+		},
+	})
+}
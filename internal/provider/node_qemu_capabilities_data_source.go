@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeQEMUCapabilitiesDataSource{}
+
+func NewNodeQEMUCapabilitiesDataSource() datasource.DataSource {
+	return &NodeQEMUCapabilitiesDataSource{}
+}
+
+// NodeQEMUCapabilitiesDataSource defines the data source implementation.
+type NodeQEMUCapabilitiesDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeQEMUCapabilitiesDataSourceModel describes the data source data model.
+type NodeQEMUCapabilitiesDataSourceModel struct {
+	ID        types.String        `tfsdk:"id"`
+	Node      types.String        `tfsdk:"node"`
+	CPUModels []QEMUCPUModelModel `tfsdk:"cpu_models"`
+	Machines  []QEMUMachineModel  `tfsdk:"machines"`
+}
+
+// QEMUCPUModelModel describes a CPU model supported by a node.
+type QEMUCPUModelModel struct {
+	Name   types.String `tfsdk:"name"`
+	Vendor types.String `tfsdk:"vendor"`
+	Custom types.Bool   `tfsdk:"custom"`
+}
+
+// QEMUMachineModel describes a machine type supported by a node.
+type QEMUMachineModel struct {
+	ID      types.String `tfsdk:"id"`
+	Type    types.String `tfsdk:"type"`
+	Version types.String `tfsdk:"version"`
+}
+
+func (d *NodeQEMUCapabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_qemu_capabilities"
+}
+
+func (d *NodeQEMUCapabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the CPU models and machine types the QEMU version of a Proxmox VE node supports.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"cpu_models": schema.ListNestedAttribute{
+				MarkdownDescription: "CPU models, including custom models, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the CPU model (e.g., `x86-64-v2-AES`), custom models are " +
+								"prefixed with `custom-`",
+							Computed: true,
+						},
+						"vendor": schema.StringAttribute{
+							MarkdownDescription: "CPU vendor (e.g., `GenuineIntel` or `AuthenticAMD`)",
+							Computed:            true,
+						},
+						"custom": schema.BoolAttribute{
+							MarkdownDescription: "Whether the model is a custom CPU model",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"machines": schema.ListNestedAttribute{
+				MarkdownDescription: "Machine types, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Full name of the machine type (e.g., `pc-q35-8.1`)",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Machine type, `i440fx` or `q35`",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "QEMU version of the machine type (e.g., `8.1`)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeQEMUCapabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeQEMUCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeQEMUCapabilitiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+	capabilitiesPath := "/nodes/" + url.PathEscape(node) + "/capabilities/qemu"
+
+	tflog.Debug(ctx, "Reading Proxmox node QEMU capabilities", map[string]interface{}{"node": node})
+
+	var cpus, machines []map[string]interface{}
+	if err := d.client.GetCached(ctx, capabilitiesPath+"/cpu", &cpus); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CPU models of node %s, got error: %s", node, err))
+		return
+	}
+	if err := d.client.GetCached(ctx, capabilitiesPath+"/machines", &machines); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read machine types of node %s, got error: %s", node, err))
+		return
+	}
+
+	data.CPUModels = make([]QEMUCPUModelModel, len(cpus))
+	for i, cpu := range cpus {
+		data.CPUModels[i] = QEMUCPUModelModel{
+			Name:   stringValue(cpu, "name"),
+			Vendor: stringValue(cpu, "vendor"),
+			Custom: types.BoolValue(boolValue(cpu, "custom").ValueBool()),
+		}
+	}
+	sort.Slice(data.CPUModels, func(i, j int) bool {
+		return strings.Compare(data.CPUModels[i].Name.ValueString(), data.CPUModels[j].Name.ValueString()) < 0
+	})
+
+	data.Machines = make([]QEMUMachineModel, len(machines))
+	for i, machine := range machines {
+		data.Machines[i] = QEMUMachineModel{
+			ID:      stringValue(machine, "id"),
+			Type:    stringValue(machine, "type"),
+			Version: stringValue(machine, "version"),
+		}
+	}
+	sort.Slice(data.Machines, func(i, j int) bool {
+		return strings.Compare(data.Machines[i].ID.ValueString(), data.Machines[j].ID.ValueString()) < 0
+	})
+
+	data.ID = data.Node
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	nodeServiceRunning = "running"
+	nodeServiceStopped = "stopped"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeServiceResource{}
+var _ resource.ResourceWithImportState = &NodeServiceResource{}
+
+func NewNodeServiceResource() resource.Resource {
+	return &NodeServiceResource{}
+}
+
+// NodeServiceResource defines the resource implementation.
+type NodeServiceResource struct {
+	client *ProxmoxClient
+}
+
+// NodeServiceResourceModel describes the resource data model.
+type NodeServiceResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Node      types.String `tfsdk:"node"`
+	Service   types.String `tfsdk:"service"`
+	State     types.String `tfsdk:"state"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	UnitState types.String `tfsdk:"unit_state"`
+}
+
+func (r *NodeServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_service"
+}
+
+func (r *NodeServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages whether a system service of a Proxmox VE node is running, and restarts it when " +
+			"`triggers` change. Only the services exposed by the Proxmox VE API can be managed (e.g., `pveproxy`, " +
+			"`pvedaemon`, `corosync` or `chrony`). The API cannot enable or disable services at boot, so " +
+			"`unit_state` is only reported. Destroying the resource leaves the service in its current state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier in the `node/service` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Name of the service",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Desired state of the service, either `running` or `stopped`. Defaults to `running`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(nodeServiceRunning),
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, restarts the running service",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"unit_state": schema.StringAttribute{
+				MarkdownDescription: "Boot state of the systemd unit (e.g., `enabled` or `disabled`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NodeServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NodeServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeServiceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, service := data.Node.ValueString(), data.Service.ValueString()
+
+	status, err := r.status(ctx, node, service)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service %s of node %s, got error: %s", service, node, err))
+		return
+	}
+
+	if nodeServiceState(status) != data.State.ValueString() {
+		if err := r.command(ctx, node, service, nodeServiceCommand(data.State.ValueString())); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change state of service %s of node %s, got error: %s", service, node, err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(formatID(node, service))
+	data.UnitState = stringValue(status, "unit-state")
+
+	tflog.Trace(ctx, "created node service", map[string]interface{}{"node": node, "service": service})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeServiceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	node, service, err := parseNodeServiceID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	status, err := r.status(ctx, node, service)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service %s of node %s, got error: %s", service, node, err))
+		return
+	}
+
+	data.Node = types.StringValue(node)
+	data.Service = types.StringValue(service)
+	data.State = types.StringValue(nodeServiceState(status))
+	data.UnitState = stringValue(status, "unit-state")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NodeServiceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	node, service := data.Node.ValueString(), data.Service.ValueString()
+
+	// A running service whose triggers changed is restarted, otherwise it is
+	// only started or stopped as needed.
+	command := ""
+	switch {
+	case !data.State.Equal(state.State):
+		command = nodeServiceCommand(data.State.ValueString())
+	case !data.Triggers.Equal(state.Triggers) && data.State.ValueString() == nodeServiceRunning:
+		command = "restart"
+	}
+
+	if command != "" {
+		if err := r.command(ctx, node, service, command); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s service %s of node %s, got error: %s", command, service, node, err))
+			return
+		}
+	}
+
+	status, err := r.status(ctx, node, service)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service %s of node %s, got error: %s", service, node, err))
+		return
+	}
+	data.UnitState = stringValue(status, "unit-state")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Services cannot be removed, so removing the resource only drops it
+	// from the Terraform state.
+}
+
+func (r *NodeServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, err := parseNodeServiceID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NodeServiceResource) path(node, service string) string {
+	return "/nodes/" + url.PathEscape(node) + "/services/" + url.PathEscape(service)
+}
+
+func (r *NodeServiceResource) status(ctx context.Context, node, service string) (map[string]interface{}, error) {
+	var status map[string]interface{}
+	err := r.client.Get(ctx, r.path(node, service)+"/state", &status)
+	return status, err
+}
+
+// command runs start, stop or restart on a service and waits for the task.
+func (r *NodeServiceResource) command(ctx context.Context, node, service, command string) error {
+	var upid string
+	if err := r.client.Post(ctx, r.path(node, service)+"/"+command, nil, &upid); err != nil {
+		return err
+	}
+	return r.client.waitForTask(ctx, upid)
+}
+
+// nodeServiceState maps the systemd state reported by the API to the state
+// attribute.
+func nodeServiceState(status map[string]interface{}) string {
+	if stringValue(status, "state").ValueString() == "running" {
+		return nodeServiceRunning
+	}
+	return nodeServiceStopped
+}
+
+// nodeServiceCommand returns the command reaching the given state.
+func nodeServiceCommand(state string) string {
+	if state == nodeServiceStopped {
+		return "stop"
+	}
+	return "start"
+}
+
+// parseNodeServiceID splits a `node/service` resource identifier.
+func parseNodeServiceID(id string) (string, string, error) {
+	parts, err := parseID(id, 2, "node/service")
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
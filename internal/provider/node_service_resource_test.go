@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeServiceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNodeServiceResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_service.test", "id", testNode()+"/chrony"),
+					resource.TestCheckResourceAttr("proxmox_node_service.test", "state", "running"),
+					resource.TestCheckResourceAttrSet("proxmox_node_service.test", "unit_state"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_node_service.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNodeServiceResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_service.test", "state", "running"),
+					resource.TestCheckResourceAttr("proxmox_node_service.test", "triggers.config", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeServiceResourceConfig(trigger string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_node_service" "test" {
+  node    = %[1]q
+  service = "chrony"
+
+  triggers = {
+    config = %[2]q
+  }
+}
+`, testNode(), trigger)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeServicesDataSource{}
+
+func NewNodeServicesDataSource() datasource.DataSource {
+	return &NodeServicesDataSource{}
+}
+
+// NodeServicesDataSource defines the data source implementation.
+type NodeServicesDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeServicesDataSourceModel describes the data source data model.
+type NodeServicesDataSourceModel struct {
+	ID       types.String       `tfsdk:"id"`
+	Node     types.String       `tfsdk:"node"`
+	Services []NodeServiceModel `tfsdk:"services"`
+}
+
+// NodeServiceModel describes a service of a node.
+type NodeServiceModel struct {
+	Service     types.String `tfsdk:"service"`
+	Description types.String `tfsdk:"description"`
+	State       types.String `tfsdk:"state"`
+	ActiveState types.String `tfsdk:"active_state"`
+	SubState    types.String `tfsdk:"sub_state"`
+	UnitState   types.String `tfsdk:"unit_state"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *NodeServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_services"
+}
+
+func (d *NodeServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the services of a Proxmox VE node exposed by the API (e.g., `pveproxy`, " +
+			"`corosync` or `chrony`) with their state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "Services of the node",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							MarkdownDescription: "Name of the service",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the service",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the service, either `running` or `stopped`",
+							Computed:            true,
+						},
+						"active_state": schema.StringAttribute{
+							MarkdownDescription: "Active state of the systemd unit (e.g., `active` or `failed`)",
+							Computed:            true,
+						},
+						"sub_state": schema.StringAttribute{
+							MarkdownDescription: "Sub state of the systemd unit (e.g., `running` or `dead`)",
+							Computed:            true,
+						},
+						"unit_state": schema.StringAttribute{
+							MarkdownDescription: "Boot state of the systemd unit (e.g., `enabled` or `disabled`)",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the service is started at boot",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeServicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox node services", map[string]interface{}{"node": node})
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/services", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services of node %s, got error: %s", node, err))
+		return
+	}
+
+	services := make([]NodeServiceModel, len(entries))
+	for i, entry := range entries {
+		services[i] = NodeServiceModel{
+			Service:     stringValue(entry, "service"),
+			Description: stringValue(entry, "desc"),
+			State:       types.StringValue(nodeServiceState(entry)),
+			ActiveState: stringValue(entry, "active-state"),
+			SubState:    stringValue(entry, "sub-state"),
+			UnitState:   stringValue(entry, "unit-state"),
+			Enabled:     types.BoolValue(stringValue(entry, "unit-state").ValueString() == "enabled"),
+		}
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return strings.Compare(services[i].Service.ValueString(), services[j].Service.ValueString()) < 0
+	})
+
+	data.ID = data.Node
+	data.Services = services
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d services", len(services)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
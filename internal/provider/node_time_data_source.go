@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeTimeDataSource{}
+
+func NewNodeTimeDataSource() datasource.DataSource {
+	return &NodeTimeDataSource{}
+}
+
+// NodeTimeDataSource defines the data source implementation.
+type NodeTimeDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeTimeDataSourceModel describes the data source data model.
+type NodeTimeDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Node      types.String `tfsdk:"node"`
+	Timezone  types.String `tfsdk:"timezone"`
+	Time      types.Int64  `tfsdk:"time"`
+	LocalTime types.Int64  `tfsdk:"localtime"`
+	UTCOffset types.Int64  `tfsdk:"utc_offset"`
+	ClockSkew types.Int64  `tfsdk:"clock_skew"`
+}
+
+func (d *NodeTimeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_time"
+}
+
+func (d *NodeTimeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current time and timezone of a Proxmox VE node, e.g. to detect clock drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Timezone of the node",
+				Computed:            true,
+			},
+			"time": schema.Int64Attribute{
+				MarkdownDescription: "Current time of the node as Unix timestamp",
+				Computed:            true,
+			},
+			"localtime": schema.Int64Attribute{
+				MarkdownDescription: "Current time of the node in its timezone, as seconds since the epoch",
+				Computed:            true,
+			},
+			"utc_offset": schema.Int64Attribute{
+				MarkdownDescription: "Offset of the node timezone from UTC in seconds",
+				Computed:            true,
+			},
+			"clock_skew": schema.Int64Attribute{
+				MarkdownDescription: "Difference between the node clock and the clock of the machine running Terraform " +
+					"in seconds, positive if the node is ahead",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *NodeTimeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeTimeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeTimeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox node time", map[string]interface{}{"node": node})
+
+	var nodeTime map[string]interface{}
+	if err := d.client.Get(ctx, nodeTimePath(node), &nodeTime); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read time of node %s, got error: %s", node, err))
+		return
+	}
+	now := time.Now().Unix()
+
+	data.ID = data.Node
+	data.Timezone = stringValue(nodeTime, "timezone")
+	data.Time = int64Value(nodeTime, "time")
+	data.LocalTime = int64Value(nodeTime, "localtime")
+	data.UTCOffset = types.Int64Value(data.LocalTime.ValueInt64() - data.Time.ValueInt64())
+	data.ClockSkew = types.Int64Value(data.Time.ValueInt64() - now)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeTimeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNodeTimeResourceConfig("Europe/Vienna"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_time.test", "id", testNode()),
+					resource.TestCheckResourceAttr("proxmox_node_time.test", "timezone", "Europe/Vienna"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_node_time.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNodeTimeResourceConfig("UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_node_time.test", "timezone", "UTC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeTimeResourceConfig(timezone string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_node_time" "test" {
+  node     = %[1]q
+  timezone = %[2]q
+}
+`, testNode(), timezone)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeUSBDevicesDataSource{}
+
+func NewNodeUSBDevicesDataSource() datasource.DataSource {
+	return &NodeUSBDevicesDataSource{}
+}
+
+// NodeUSBDevicesDataSource defines the data source implementation.
+type NodeUSBDevicesDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodeUSBDevicesDataSourceModel describes the data source data model.
+type NodeUSBDevicesDataSourceModel struct {
+	ID      types.String     `tfsdk:"id"`
+	Node    types.String     `tfsdk:"node"`
+	Devices []USBDeviceModel `tfsdk:"devices"`
+}
+
+// USBDeviceModel describes a USB device of a node.
+type USBDeviceModel struct {
+	VendorID     types.String `tfsdk:"vendor_id"`
+	ProductID    types.String `tfsdk:"product_id"`
+	HostID       types.String `tfsdk:"host_id"`
+	Port         types.String `tfsdk:"port"`
+	Bus          types.Int64  `tfsdk:"bus"`
+	Device       types.Int64  `tfsdk:"device"`
+	Manufacturer types.String `tfsdk:"manufacturer"`
+	Product      types.String `tfsdk:"product"`
+	Serial       types.String `tfsdk:"serial"`
+	Speed        types.String `tfsdk:"speed"`
+	Class        types.Int64  `tfsdk:"class"`
+}
+
+func (d *NodeUSBDevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_usb_devices"
+}
+
+func (d *NodeUSBDevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the USB devices of a Proxmox VE node, e.g. to pass a device through to a virtual " +
+			"machine.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "USB devices, sorted by port",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vendor_id": schema.StringAttribute{
+							MarkdownDescription: "Vendor ID (e.g., `0x046d`)",
+							Computed:            true,
+						},
+						"product_id": schema.StringAttribute{
+							MarkdownDescription: "Product ID (e.g., `0xc52b`)",
+							Computed:            true,
+						},
+						"host_id": schema.StringAttribute{
+							MarkdownDescription: "Device in the `vendor:product` format used to pass it through by ID " +
+								"(e.g., `046d:c52b`)",
+							Computed: true,
+						},
+						"port": schema.StringAttribute{
+							MarkdownDescription: "Port path used to pass the device through by port (e.g., `1-2.3`)",
+							Computed:            true,
+						},
+						"bus": schema.Int64Attribute{
+							MarkdownDescription: "Bus number",
+							Computed:            true,
+						},
+						"device": schema.Int64Attribute{
+							MarkdownDescription: "Device number on the bus",
+							Computed:            true,
+						},
+						"manufacturer": schema.StringAttribute{
+							MarkdownDescription: "Manufacturer reported by the device",
+							Computed:            true,
+						},
+						"product": schema.StringAttribute{
+							MarkdownDescription: "Product name reported by the device",
+							Computed:            true,
+						},
+						"serial": schema.StringAttribute{
+							MarkdownDescription: "Serial number reported by the device",
+							Computed:            true,
+						},
+						"speed": schema.StringAttribute{
+							MarkdownDescription: "Speed of the device in Mbit/s",
+							Computed:            true,
+						},
+						"class": schema.Int64Attribute{
+							MarkdownDescription: "USB class of the device, `9` for hubs",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeUSBDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeUSBDevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeUSBDevicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox node USB devices", map[string]interface{}{"node": node})
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/nodes/"+url.PathEscape(node)+"/hardware/usb", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read USB devices of node %s, got error: %s", node, err))
+		return
+	}
+
+	devices := make([]USBDeviceModel, len(entries))
+	for i, entry := range entries {
+		vendorID, productID := stringValue(entry, "vendid"), stringValue(entry, "prodid")
+		devices[i] = USBDeviceModel{
+			VendorID:  vendorID,
+			ProductID: productID,
+			HostID: types.StringValue(strings.TrimPrefix(vendorID.ValueString(), "0x") + ":" +
+				strings.TrimPrefix(productID.ValueString(), "0x")),
+			Port:         stringValue(entry, "usbpath"),
+			Bus:          int64Value(entry, "busnum"),
+			Device:       int64Value(entry, "devnum"),
+			Manufacturer: stringValue(entry, "manufacturer"),
+			Product:      stringValue(entry, "product"),
+			Serial:       stringValue(entry, "serial"),
+			Speed:        stringValue(entry, "speed"),
+			Class:        int64Value(entry, "class"),
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return strings.Compare(devices[i].Port.ValueString(), devices[j].Port.ValueString()) < 0
+	})
+
+	data.Devices = devices
+	data.ID = data.Node
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d USB devices", len(devices)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodesDataSource{}
+
+func NewNodesDataSource() datasource.DataSource {
+	return &NodesDataSource{}
+}
+
+// NodesDataSource defines the data source implementation.
+type NodesDataSource struct {
+	client *ProxmoxClient
+}
+
+// NodesDataSourceModel describes the data source data model.
+type NodesDataSourceModel struct {
+	ID      types.String       `tfsdk:"id"`
+	Filters []FilterModel      `tfsdk:"filter"`
+	Nodes   []NodeSummaryModel `tfsdk:"nodes"`
+}
+
+// NodeSummaryModel describes a cluster node.
+type NodeSummaryModel struct {
+	Node           types.String  `tfsdk:"node"`
+	Status         types.String  `tfsdk:"status"`
+	Online         types.Bool    `tfsdk:"online"`
+	Uptime         types.Int64   `tfsdk:"uptime"`
+	CPUUsage       types.Float64 `tfsdk:"cpu_usage"`
+	CPUs           types.Int64   `tfsdk:"cpus"`
+	Memory         types.Int64   `tfsdk:"memory"`
+	MaxMemory      types.Int64   `tfsdk:"max_memory"`
+	Disk           types.Int64   `tfsdk:"disk"`
+	MaxDisk        types.Int64   `tfsdk:"max_disk"`
+	Version        types.String  `tfsdk:"version"`
+	SSLFingerprint types.String  `tfsdk:"ssl_fingerprint"`
+}
+
+// nodeSummaryAttributes returns the schema attributes of NodeSummaryModel.
+func nodeSummaryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"node": schema.StringAttribute{
+			MarkdownDescription: "Name of the node",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the node, `online`, `offline` or `unknown`",
+			Computed:            true,
+		},
+		"online": schema.BoolAttribute{
+			MarkdownDescription: "Whether the node is online",
+			Computed:            true,
+		},
+		"uptime": schema.Int64Attribute{
+			MarkdownDescription: "Uptime of the node in seconds",
+			Computed:            true,
+		},
+		"cpu_usage": schema.Float64Attribute{
+			MarkdownDescription: "CPU usage of the node between `0` and `1`",
+			Computed:            true,
+		},
+		"cpus": schema.Int64Attribute{
+			MarkdownDescription: "Number of logical CPUs of the node",
+			Computed:            true,
+		},
+		"memory": schema.Int64Attribute{
+			MarkdownDescription: "Used memory in bytes",
+			Computed:            true,
+		},
+		"max_memory": schema.Int64Attribute{
+			MarkdownDescription: "Total memory in bytes",
+			Computed:            true,
+		},
+		"disk": schema.Int64Attribute{
+			MarkdownDescription: "Used space of the root filesystem in bytes",
+			Computed:            true,
+		},
+		"max_disk": schema.Int64Attribute{
+			MarkdownDescription: "Size of the root filesystem in bytes",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Proxmox VE version of the node (e.g., `8.2.4`), null if the node is offline",
+			Computed:            true,
+		},
+		"ssl_fingerprint": schema.StringAttribute{
+			MarkdownDescription: "SHA-256 fingerprint of the API certificate of the node",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *NodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+func (d *NodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the nodes of the Proxmox VE cluster with their status, resource usage and version.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Cluster nodes, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nodeSummaryAttributes(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("nodes"),
+		},
+	}
+}
+
+func (d *NodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox nodes")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/nodes", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read nodes, got error: %s", err))
+		return
+	}
+
+	nodes := make([]NodeSummaryModel, len(entries))
+	for i, entry := range entries {
+		nodes[i] = newNodeSummaryModel(entry)
+		if !nodes[i].Online.ValueBool() {
+			continue
+		}
+
+		version, err := nodeVersion(ctx, d.client, nodes[i].Node.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read version of node %s, got error: %s", nodes[i].Node.ValueString(), err))
+			return
+		}
+		nodes[i].Version = version
+	}
+	nodes, diags := applyFilters(data.Filters, nodes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return strings.Compare(nodes[i].Node.ValueString(), nodes[j].Node.ValueString()) < 0
+	})
+
+	data.Nodes = nodes
+	data.ID = types.StringValue("nodes")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d nodes", len(nodes)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newNodeSummaryModel converts an entry of the node list. The version is read
+// separately.
+func newNodeSummaryModel(entry map[string]interface{}) NodeSummaryModel {
+	status := stringValue(entry, "status")
+	return NodeSummaryModel{
+		Node:           stringValue(entry, "node"),
+		Status:         status,
+		Online:         types.BoolValue(status.ValueString() == "online"),
+		Uptime:         int64Value(entry, "uptime"),
+		CPUUsage:       float64Value(entry, "cpu"),
+		CPUs:           int64Value(entry, "maxcpu"),
+		Memory:         int64Value(entry, "mem"),
+		MaxMemory:      int64Value(entry, "maxmem"),
+		Disk:           int64Value(entry, "disk"),
+		MaxDisk:        int64Value(entry, "maxdisk"),
+		Version:        types.StringNull(),
+		SSLFingerprint: stringValue(entry, "ssl_fingerprint"),
+	}
+}
+
+// nodeVersion returns the Proxmox VE version of a node.
+func nodeVersion(ctx context.Context, client *ProxmoxClient, node string) (types.String, error) {
+	var version map[string]interface{}
+	if err := client.GetCached(ctx, "/nodes/"+url.PathEscape(node)+"/version", &version); err != nil {
+		return types.StringNull(), err
+	}
+	return stringValue(version, "version"), nil
+}
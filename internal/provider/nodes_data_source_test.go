@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_nodes" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_nodes.test", "id", "nodes"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_nodes.test", "nodes.*", map[string]string{
+						"node":   testNode(),
+						"status": "online",
+						"online": "true",
+					}),
+					resource.TestCheckResourceAttrSet("data.proxmox_nodes.test", "nodes.0.version"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// This file contains the string attribute type for values that Proxmox VE
+// returns in a different but equivalent form than they were configured in,
+// e.g. IPv6 addresses compressed or MAC addresses in lower case. Values that
+// are semantically equal keep their configured form in the state, so reading
+// them back does not cause a diff.
+
+// normalization identifies how values of a NormalizedStringType are
+// compared.
+type normalization string
+
+const (
+	normalizeMACAddress normalization = "MAC address"
+	normalizeIPAddress  normalization = "IP address"
+	normalizeCIDR       normalization = "CIDR"
+)
+
+// normalize returns the canonical form of value.
+func (n normalization) normalize(value string) (string, error) {
+	switch n {
+	case normalizeMACAddress:
+		mac, err := net.ParseMAC(value)
+		if err != nil {
+			return "", err
+		}
+		return mac.String(), nil
+	case normalizeIPAddress:
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return "", err
+		}
+		return addr.String(), nil
+	case normalizeCIDR:
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return "", err
+		}
+		return prefix.String(), nil
+	}
+	return value, nil
+}
+
+// Attribute types of the supported normalizations.
+var (
+	macAddressType = NormalizedStringType{normalization: normalizeMACAddress}
+	ipAddressType  = NormalizedStringType{normalization: normalizeIPAddress}
+	cidrType       = NormalizedStringType{normalization: normalizeCIDR}
+)
+
+var _ basetypes.StringTypable = NormalizedStringType{}
+
+// NormalizedStringType is the attribute type of NormalizedStringValue.
+type NormalizedStringType struct {
+	basetypes.StringType
+	normalization normalization
+}
+
+func (t NormalizedStringType) String() string {
+	return fmt.Sprintf("NormalizedStringType(%s)", t.normalization)
+}
+
+func (t NormalizedStringType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedStringType)
+	return ok && other.normalization == t.normalization
+}
+
+func (t NormalizedStringType) ValueType(ctx context.Context) attr.Value {
+	return NormalizedStringValue{normalization: t.normalization}
+}
+
+func (t NormalizedStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return t.value(in), nil
+}
+
+func (t NormalizedStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+	return t.value(stringValue), nil
+}
+
+// value converts a string value, e.g. one read from the API.
+func (t NormalizedStringType) value(in types.String) NormalizedStringValue {
+	return NormalizedStringValue{StringValue: in, normalization: t.normalization}
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = NormalizedStringValue{}
+var _ xattr.ValidateableAttribute = NormalizedStringValue{}
+
+// NormalizedStringValue is a string that is compared by its canonical form.
+// Values that cannot be normalized are invalid.
+type NormalizedStringValue struct {
+	basetypes.StringValue
+	normalization normalization
+}
+
+func (v NormalizedStringValue) Type(ctx context.Context) attr.Type {
+	return NormalizedStringType{normalization: v.normalization}
+}
+
+func (v NormalizedStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(NormalizedStringValue)
+	return ok && other.normalization == v.normalization && v.StringValue.Equal(other.StringValue)
+}
+
+func (v NormalizedStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NormalizedStringValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+
+	oldNormalized, err := v.normalization.normalize(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newNormalized, err := v.normalization.normalize(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return oldNormalized == newNormalized, diags
+}
+
+func (v NormalizedStringValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := v.normalization.normalize(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Invalid %s %q: %s.", v.normalization, v.ValueString(), err))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		valueType NormalizedStringType
+		old, new  string
+		want      bool
+	}{
+		{macAddressType, "BC:24:11:AA:BB:CC", "bc:24:11:aa:bb:cc", true},
+		{macAddressType, "bc-24-11-aa-bb-cc", "bc:24:11:aa:bb:cc", true},
+		{macAddressType, "bc:24:11:aa:bb:cc", "bc:24:11:aa:bb:cd", false},
+		{ipAddressType, "fd00:0:0::0001", "fd00::1", true},
+		{ipAddressType, "FD00::A", "fd00::a", true},
+		{ipAddressType, "10.0.0.1", "10.0.0.2", false},
+		{cidrType, "2001:DB8:0::1/64", "2001:db8::1/64", true},
+		{cidrType, "10.0.0.5/24", "10.0.0.5/24", true},
+		{cidrType, "10.0.0.5/24", "10.0.0.0/24", false},
+		{ipAddressType, "not an address", "not an address", false},
+	} {
+		got, diags := test.valueType.value(types.StringValue(test.old)).StringSemanticEquals(ctx, test.valueType.value(types.StringValue(test.new)))
+		if diags.HasError() {
+			t.Fatalf("%s %q: unexpected error: %v", test.valueType, test.old, diags)
+		}
+		if got != test.want {
+			t.Errorf("%s %q and %q: got %t, want %t", test.valueType, test.old, test.new, got, test.want)
+		}
+	}
+}
+
+func TestNormalizedStringValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		value NormalizedStringValue
+		valid bool
+	}{
+		{macAddressType.value(types.StringValue("bc:24:11:aa:bb:cc")), true},
+		{macAddressType.value(types.StringValue("bc:24:11")), false},
+		{ipAddressType.value(types.StringValue("192.0.2.1")), true},
+		{ipAddressType.value(types.StringValue("192.0.2.1/24")), false},
+		{cidrType.value(types.StringValue("192.0.2.1/24")), true},
+		{cidrType.value(types.StringValue("192.0.2.1")), false},
+		{cidrType.value(types.StringNull()), true},
+	} {
+		var resp xattr.ValidateAttributeResponse
+		test.value.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("test")}, &resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("%s %s: got diagnostics %v, want valid %t", test.value.Type(ctx), test.value, resp.Diagnostics, test.valid)
+		}
+	}
+}
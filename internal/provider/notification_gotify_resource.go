@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationGotifyResource{}
+var _ resource.ResourceWithImportState = &NotificationGotifyResource{}
+
+func NewNotificationGotifyResource() resource.Resource {
+	return &NotificationGotifyResource{}
+}
+
+// NotificationGotifyResource defines the resource implementation.
+type NotificationGotifyResource struct {
+	client *ProxmoxClient
+}
+
+// NotificationGotifyResourceModel describes the resource data model.
+type NotificationGotifyResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Server       types.String `tfsdk:"server"`
+	Token        types.String `tfsdk:"token"`
+	TokenVersion types.Int64  `tfsdk:"token_version"`
+	Comment      types.String `tfsdk:"comment"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *NotificationGotifyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_gotify"
+}
+
+func (r *NotificationGotifyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Gotify notification target, which pushes notifications to a Gotify server. " +
+			"The application token of the Gotify server is write-only and requires Terraform 1.11 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the target name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the notification target",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Gotify server",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Application token notifications are pushed with",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"token_version": secretVersionAttribute("application token"),
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the notification target",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the notification target. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *NotificationGotifyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationGotifyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("name", data.Name)
+	params.setString("token", token)
+
+	if err := r.client.Post(ctx, notificationEndpointPath("gotify", ""), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Gotify notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Name
+
+	tflog.Trace(ctx, "created Gotify notification target", map[string]interface{}{"name": data.Name.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationGotifyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var target map[string]interface{}
+	err := r.client.Get(ctx, notificationEndpointPath("gotify", data.ID.ValueString()), &target)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Gotify notification target %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Name = data.ID
+	data.Server = stringValue(target, "server")
+	data.Comment = stringValue(target, "comment")
+	data.Enabled = types.BoolValue(!boolValue(target, "disable").ValueBool())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NotificationGotifyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := data.params(true)
+
+	if secretVersionChanged(data.TokenVersion, state.TokenVersion) {
+		params.setString("token", token)
+	}
+
+	if err := r.client.Put(ctx, notificationEndpointPath("gotify", data.Name.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Gotify notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationGotifyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, notificationEndpointPath("gotify", data.Name.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Gotify notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+}
+
+func (r *NotificationGotifyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the target. When update is set, unset
+// optional fields are removed from the existing target.
+func (m NotificationGotifyResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("server", m.Server)
+
+	// The API only knows the inverted disable flag.
+	if !m.Enabled.ValueBool() {
+		params["disable"] = 1
+	} else if update {
+		params.remove("disable")
+	}
+
+	setString := params.setString
+	if update {
+		setString = params.updateString
+	}
+	setString("comment", m.Comment)
+
+	return params
+}
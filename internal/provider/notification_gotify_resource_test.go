@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNotificationGotifyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationGotifyResourceConfig("https://gotify.example.com", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_notification_gotify.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_notification_gotify.test", "server", "https://gotify.example.com"),
+					resource.TestCheckNoResourceAttr("proxmox_notification_gotify.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_notification_gotify.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationGotifyResourceConfig("https://push.example.com", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_notification_gotify.test", "server", "https://push.example.com"),
+					resource.TestCheckResourceAttr("proxmox_notification_gotify.test", "token_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationGotifyResourceConfig(server string, version int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_notification_gotify" "test" {
+  name          = "tfacc"
+  server        = %[1]q
+  token         = "AbCdEf-%[2]d"
+  token_version = %[2]d
+}
+`, server, version)
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationSMTPResource{}
+var _ resource.ResourceWithImportState = &NotificationSMTPResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationSMTPResource{}
+
+func NewNotificationSMTPResource() resource.Resource {
+	return &NotificationSMTPResource{}
+}
+
+// NotificationSMTPResource defines the resource implementation.
+type NotificationSMTPResource struct {
+	client *ProxmoxClient
+}
+
+// NotificationSMTPResourceModel describes the resource data model.
+type NotificationSMTPResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Server          types.String `tfsdk:"server"`
+	Port            types.Int64  `tfsdk:"port"`
+	Mode            types.String `tfsdk:"mode"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	FromAddress     types.String `tfsdk:"from_address"`
+	MailTo          types.Set    `tfsdk:"mail_to"`
+	MailToUser      types.Set    `tfsdk:"mail_to_user"`
+	Author          types.String `tfsdk:"author"`
+	Comment         types.String `tfsdk:"comment"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *NotificationSMTPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_smtp"
+}
+
+func (r *NotificationSMTPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an SMTP notification target, which sends notifications through an external mail " +
+			"server. Requires Proxmox VE 8.1 or later, and Terraform 1.11 or later for the write-only `password`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the target name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the notification target",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Address of the SMTP relay",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the SMTP relay. Proxmox VE defaults to the standard port of `mode`",
+				Optional:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Encryption of the connection, one of `insecure`, `starttls` or `tls`. Defaults to `tls`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("tls"),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for SMTP authentication",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password for SMTP authentication",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": secretVersionAttribute("SMTP password"),
+			"from_address": schema.StringAttribute{
+				MarkdownDescription: "Sender address of the notifications",
+				Required:            true,
+			},
+			"mail_to": schema.SetAttribute{
+				MarkdownDescription: "Email addresses notifications are sent to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"mail_to_user": schema.SetAttribute{
+				MarkdownDescription: "Users notifications are sent to, using the email address configured for the user",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Author of the notifications. Proxmox VE defaults to `Proxmox VE`",
+				Optional:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the notification target",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the notification target. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *NotificationSMTPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationSMTPResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationSMTPResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.MailTo.IsUnknown() || data.MailToUser.IsUnknown() {
+		return
+	}
+
+	if data.MailTo.IsNull() && data.MailToUser.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Attribute Configuration",
+			"At least one of the mail_to and mail_to_user attributes must be set.",
+		)
+	}
+}
+
+func (r *NotificationSMTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationSMTPResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("name", data.Name)
+	params.setString("password", password)
+
+	if err := r.client.Post(ctx, notificationEndpointPath("smtp", ""), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SMTP notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Name
+
+	tflog.Trace(ctx, "created SMTP notification target", map[string]interface{}{"name": data.Name.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationSMTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationSMTPResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var target map[string]interface{}
+	err := r.client.Get(ctx, notificationEndpointPath("smtp", data.ID.ValueString()), &target)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SMTP notification target %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Name = data.ID
+	data.Server = stringValue(target, "server")
+	data.Port = int64Value(target, "port")
+	data.Mode = stringValue(target, "mode")
+	if data.Mode.IsNull() {
+		data.Mode = types.StringValue("tls")
+	}
+	data.Username = stringValue(target, "username")
+	data.FromAddress = stringValue(target, "from-address")
+	data.MailTo = stringSetValue(target, "mailto")
+	data.MailToUser = stringSetValue(target, "mailto-user")
+	data.Author = stringValue(target, "author")
+	data.Comment = stringValue(target, "comment")
+	data.Enabled = types.BoolValue(!boolValue(target, "disable").ValueBool())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationSMTPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NotificationSMTPResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := data.params(true)
+
+	if secretVersionChanged(data.PasswordVersion, state.PasswordVersion) {
+		params.updateString("password", password)
+	}
+
+	if err := r.client.Put(ctx, notificationEndpointPath("smtp", data.Name.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SMTP notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationSMTPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationSMTPResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, notificationEndpointPath("smtp", data.Name.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SMTP notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+}
+
+func (r *NotificationSMTPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the target. When update is set, unset
+// optional fields are removed from the existing target.
+func (m NotificationSMTPResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("server", m.Server)
+	params.setString("mode", m.Mode)
+	params.setString("from-address", m.FromAddress)
+
+	// The API only knows the inverted disable flag.
+	if !m.Enabled.ValueBool() {
+		params["disable"] = 1
+	} else if update {
+		params.remove("disable")
+	}
+
+	setString, setInt64, setStringArray := params.setString, params.setInt64, params.setStringArray
+	if update {
+		setString, setInt64, setStringArray = params.updateString, params.updateInt64, params.updateStringArray
+	}
+	setInt64("port", m.Port)
+	setString("username", m.Username)
+	setStringArray("mailto", m.MailTo)
+	setStringArray("mailto-user", m.MailToUser)
+	setString("author", m.Author)
+	setString("comment", m.Comment)
+
+	return params
+}
+
+// notificationEndpointPath returns the API path of a notification target of
+// the given kind, or of the collection when name is empty.
+func notificationEndpointPath(kind, name string) string {
+	p := "/cluster/notifications/endpoints/" + kind
+	if name != "" {
+		p += "/" + url.PathEscape(name)
+	}
+	return p
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNotificationSMTPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationSMTPResourceConfig("admin@example.com", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_notification_smtp.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_notification_smtp.test", "mode", "starttls"),
+					resource.TestCheckResourceAttr("proxmox_notification_smtp.test", "mail_to.#", "1"),
+					resource.TestCheckNoResourceAttr("proxmox_notification_smtp.test", "password"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_notification_smtp.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationSMTPResourceConfig("ops@example.com", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("proxmox_notification_smtp.test", "mail_to.*", "ops@example.com"),
+					resource.TestCheckResourceAttr("proxmox_notification_smtp.test", "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationSMTPResourceConfig(mailTo string, version int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_notification_smtp" "test" {
+  name             = "tfacc"
+  server           = "smtp.example.com"
+  port             = 587
+  mode             = "starttls"
+  username         = "pve"
+  password         = "Passw0rd-%[2]d"
+  password_version = %[2]d
+  from_address     = "pve@example.com"
+  mail_to          = [%[1]q]
+}
+`, mailTo, version)
+}
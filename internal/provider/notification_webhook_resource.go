@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationWebhookResource{}
+var _ resource.ResourceWithImportState = &NotificationWebhookResource{}
+
+func NewNotificationWebhookResource() resource.Resource {
+	return &NotificationWebhookResource{}
+}
+
+// NotificationWebhookResource defines the resource implementation.
+type NotificationWebhookResource struct {
+	client *ProxmoxClient
+}
+
+// NotificationWebhookResourceModel describes the resource data model.
+type NotificationWebhookResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	URL            types.String `tfsdk:"url"`
+	Method         types.String `tfsdk:"method"`
+	Headers        types.Map    `tfsdk:"headers"`
+	Body           types.String `tfsdk:"body"`
+	Secrets        types.Map    `tfsdk:"secrets"`
+	SecretsVersion types.Int64  `tfsdk:"secrets_version"`
+	Comment        types.String `tfsdk:"comment"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *NotificationWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_webhook"
+}
+
+func (r *NotificationWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a webhook notification target, which sends notifications as HTTP requests to " +
+			"an arbitrary URL. Requires Proxmox VE 8.3 or later. The URL, headers and body are Handlebars templates " +
+			"with access to the notification (e.g., `{{ title }}`, `{{ message }}` or `{{ severity }}`) and to the " +
+			"secrets (e.g., `{{ secrets.token }}`). Secrets are write-only, which requires " +
+			"Terraform 1.11 or later, and are substituted by Proxmox VE when sending the notification.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the target name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the notification target",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL template the request is sent to",
+				Required:            true,
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "HTTP method of the request, one of `post`, `put` or `get`. Defaults to `post`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("post"),
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "HTTP header templates of the request, keyed by header name",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "Body template of the request",
+				Optional:            true,
+			},
+			"secrets": schema.MapAttribute{
+				MarkdownDescription: "Secret values available to the templates as `{{ secrets.<name> }}`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"secrets_version": secretVersionAttribute("secrets"),
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the notification target",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the notification target. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *NotificationWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationWebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var secrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secrets"), &secrets)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("name", data.Name)
+	if pairs := webhookPairs(secrets); pairs != nil {
+		params["secret"] = pairs
+	}
+
+	if err := r.client.Post(ctx, notificationEndpointPath("webhook", ""), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Name
+
+	tflog.Trace(ctx, "created webhook notification target", map[string]interface{}{"name": data.Name.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationWebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var target map[string]interface{}
+	err := r.client.Get(ctx, notificationEndpointPath("webhook", data.ID.ValueString()), &target)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook notification target %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Name = data.ID
+	data.URL = stringValue(target, "url")
+	data.Method = stringValue(target, "method")
+	if data.Method.IsNull() {
+		data.Method = types.StringValue("post")
+	}
+	data.Headers = webhookPairsValue(target, "header")
+	data.Body = types.StringNull()
+	if body := stringValue(target, "body"); !body.IsNull() {
+		decoded, err := base64.StdEncoding.DecodeString(body.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode body of webhook notification target %s, got error: %s", data.ID.ValueString(), err))
+			return
+		}
+		data.Body = types.StringValue(string(decoded))
+	}
+	data.Comment = stringValue(target, "comment")
+	data.Enabled = types.BoolValue(!boolValue(target, "disable").ValueBool())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NotificationWebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var secrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secrets"), &secrets)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := data.params(true)
+
+	if secretVersionChanged(data.SecretsVersion, state.SecretsVersion) {
+		if pairs := webhookPairs(secrets); pairs != nil {
+			params["secret"] = pairs
+		} else {
+			params.remove("secret")
+		}
+	}
+
+	if err := r.client.Put(ctx, notificationEndpointPath("webhook", data.Name.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationWebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, notificationEndpointPath("webhook", data.Name.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook notification target %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+}
+
+func (r *NotificationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// params returns the API parameters of the target. When update is set, unset
+// optional fields are removed from the existing target.
+func (m NotificationWebhookResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("url", m.URL)
+	params.setString("method", m.Method)
+
+	// The API only knows the inverted disable flag.
+	if !m.Enabled.ValueBool() {
+		params["disable"] = 1
+	} else if update {
+		params.remove("disable")
+	}
+
+	// Header values and the body are base64 encoded so templates may
+	// contain any characters.
+	if pairs := webhookPairs(m.Headers); pairs != nil {
+		params["header"] = pairs
+	} else if update {
+		params.remove("header")
+	}
+	if !m.Body.IsNull() && !m.Body.IsUnknown() {
+		params["body"] = base64.StdEncoding.EncodeToString([]byte(m.Body.ValueString()))
+	} else if update {
+		params.remove("body")
+	}
+
+	setString := params.setString
+	if update {
+		setString = params.updateString
+	}
+	setString("comment", m.Comment)
+
+	return params
+}
+
+// webhookPairs encodes a map as the name/value property strings used for
+// webhook headers and secrets, with base64 encoded values sorted by name. It
+// returns nil for null and empty maps.
+func webhookPairs(m types.Map) []string {
+	if m.IsNull() || m.IsUnknown() || len(m.Elements()) == 0 {
+		return nil
+	}
+
+	var pairs []string
+	for name, elem := range m.Elements() {
+		if value, ok := elem.(types.String); ok {
+			pairs = append(pairs, fmt.Sprintf("name=%s,value=%s", name, base64.StdEncoding.EncodeToString([]byte(value.ValueString()))))
+		}
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// webhookPairsValue decodes the name/value property strings in data[key].
+// Missing and empty lists are returned as a null map.
+func webhookPairsValue(data map[string]interface{}, key string) types.Map {
+	elems := map[string]attr.Value{}
+	items, _ := data[key].([]interface{})
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			continue
+		}
+		props := parsePropertyString(s, "name")
+		value, err := base64.StdEncoding.DecodeString(props["value"])
+		if props["name"] == "" || err != nil {
+			continue
+		}
+		elems[props["name"]] = types.StringValue(string(value))
+	}
+	if len(elems) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
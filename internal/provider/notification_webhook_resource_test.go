@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNotificationWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationWebhookResourceConfig("{{ title }}", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_notification_webhook.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_notification_webhook.test", "method", "post"),
+					resource.TestCheckResourceAttr("proxmox_notification_webhook.test", "headers.Content-Type", "application/json"),
+					resource.TestCheckResourceAttr("proxmox_notification_webhook.test", "body", `{"text": "{{ title }}"}`),
+					resource.TestCheckNoResourceAttr("proxmox_notification_webhook.test", "secrets"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_notification_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationWebhookResourceConfig("{{ message }}", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_notification_webhook.test", "body", `{"text": "{{ message }}"}`),
+					resource.TestCheckResourceAttr("proxmox_notification_webhook.test", "secrets_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationWebhookResourceConfig(text string, version int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_notification_webhook" "test" {
+  name = "tfacc"
+  url  = "https://hooks.example.com/{{ secrets.channel }}"
+  body = jsonencode({ text = %[1]q })
+
+  headers = {
+    Content-Type  = "application/json"
+    Authorization = "Bearer {{ secrets.token }}"
+  }
+
+  secrets = {
+    channel = "alerts"
+    token   = "token-%[2]d"
+  }
+  secrets_version = %[2]d
+}
+`, text, version)
+}
+
+func TestWebhookPairs(t *testing.T) {
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Content-Type":  types.StringValue("application/json"),
+		"Authorization": types.StringValue("Bearer {{ secrets.token }}"),
+	})
+
+	pairs := webhookPairs(headers)
+	if len(pairs) != 2 || pairs[0] != "name=Authorization,value=QmVhcmVyIHt7IHNlY3JldHMudG9rZW4gfX0=" {
+		t.Fatalf("unexpected pairs %v", pairs)
+	}
+
+	data := map[string]interface{}{"header": []interface{}{pairs[0], pairs[1]}}
+	if got := webhookPairsValue(data, "header"); !got.Equal(headers) {
+		t.Errorf("got %s, want %s", got, headers)
+	}
+	if got := webhookPairs(types.MapNull(types.StringType)); got != nil {
+		t.Errorf("expected nil pairs, got %v", got)
+	}
+	if got := webhookPairsValue(map[string]interface{}{}, "header"); !got.IsNull() {
+		t.Errorf("expected null map, got %s", got)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PoolDataSource{}
+
+func NewPoolDataSource() datasource.DataSource {
+	return &PoolDataSource{}
+}
+
+// PoolDataSource defines the data source implementation.
+type PoolDataSource struct {
+	client *ProxmoxClient
+}
+
+// PoolDataSourceModel describes the data source data model.
+type PoolDataSourceModel struct {
+	ID       types.String     `tfsdk:"id"`
+	PoolID   types.String     `tfsdk:"pool_id"`
+	Comment  types.String     `tfsdk:"comment"`
+	Guests   []PoolGuestModel `tfsdk:"guests"`
+	Storages []string         `tfsdk:"storages"`
+}
+
+// PoolGuestModel describes a virtual machine or container of a pool.
+type PoolGuestModel struct {
+	VMID   types.Int64  `tfsdk:"vm_id"`
+	Type   types.String `tfsdk:"type"`
+	Name   types.String `tfsdk:"name"`
+	Node   types.String `tfsdk:"node"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (d *PoolDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool"
+}
+
+func (d *PoolDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a resource pool with its member guests and storages.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"pool_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the pool",
+				Required:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment of the pool",
+				Computed:            true,
+			},
+			"guests": schema.ListNestedAttribute{
+				MarkdownDescription: "Member virtual machines and containers, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vm_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the guest",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the guest, `qemu` for virtual machines or `lxc` for containers",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the guest",
+							Computed:            true,
+						},
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Node the guest is located on",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the guest (e.g., `running` or `stopped`)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"storages": schema.ListAttribute{
+				MarkdownDescription: "Member storages, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PoolDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoolDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.PoolID.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox pool", map[string]interface{}{"pool": id})
+
+	pool, err := getPool(ctx, d.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool %s, got error: %s", id, err))
+		return
+	}
+
+	data.ID = data.PoolID
+	data.Comment = types.StringNull()
+	if pool.Comment != "" {
+		data.Comment = types.StringValue(pool.Comment)
+	}
+
+	data.Guests, data.Storages = []PoolGuestModel{}, []string{}
+	for _, member := range pool.Members {
+		switch member.Type {
+		case guestTypeVM, guestTypeLXC:
+			guest := PoolGuestModel{
+				VMID:   types.Int64Value(member.VMID),
+				Type:   types.StringValue(member.Type),
+				Name:   types.StringNull(),
+				Node:   types.StringValue(member.Node),
+				Status: types.StringValue(member.Status),
+			}
+			if member.Name != "" {
+				guest.Name = types.StringValue(member.Name)
+			}
+			data.Guests = append(data.Guests, guest)
+		case "storage":
+			// Storages are listed once per node.
+			if !slices.Contains(data.Storages, member.Storage) {
+				data.Storages = append(data.Storages, member.Storage)
+			}
+		}
+	}
+	sort.Slice(data.Guests, func(i, j int) bool {
+		return data.Guests[i].VMID.ValueInt64() < data.Guests[j].VMID.ValueInt64()
+	})
+	sort.Strings(data.Storages)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
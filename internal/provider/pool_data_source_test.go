@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoolDataSource(t *testing.T) {
+	pool := testAccRequireEnv(t, "PROXMOX_POOL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_pool" "test" {
+  pool_id = %[1]q
+}
+`, pool),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_pool.test", "id", pool),
+					resource.TestCheckResourceAttrSet("data.proxmox_pool.test", "guests.#"),
+					resource.TestCheckResourceAttrSet("data.proxmox_pool.test", "storages.#"),
+				),
+			},
+		},
+	})
+}
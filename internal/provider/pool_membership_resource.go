@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PoolMembershipResource{}
+var _ resource.ResourceWithImportState = &PoolMembershipResource{}
+
+func NewPoolMembershipResource() resource.Resource {
+	return &PoolMembershipResource{}
+}
+
+// PoolMembershipResource defines the resource implementation.
+type PoolMembershipResource struct {
+	client *ProxmoxClient
+}
+
+// PoolMembershipResourceModel describes the resource data model.
+type PoolMembershipResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PoolID   types.String `tfsdk:"pool_id"`
+	VMIDs    types.Set    `tfsdk:"vm_ids"`
+	Storages types.Set    `tfsdk:"storages"`
+}
+
+// poolMember describes a single entry of the members list returned by
+// GET /pools/{poolid}.
+type poolMember struct {
+	Type    string `json:"type"`
+	VMID    int64  `json:"vmid"`
+	Storage string `json:"storage"`
+	Node    string `json:"node"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+}
+
+// poolDetails describes a pool returned by GET /pools/{poolid}.
+type poolDetails struct {
+	Comment string       `json:"comment"`
+	Members []poolMember `json:"members"`
+}
+
+// getPool reads a pool including its members.
+func getPool(ctx context.Context, client *ProxmoxClient, id string) (poolDetails, error) {
+	var pool poolDetails
+	err := client.Get(ctx, "/pools/"+url.PathEscape(id), &pool)
+	return pool, err
+}
+
+// memberIDs returns the IDs of the guests and storages of the pool. Storages
+// are listed once per node, so they are deduplicated.
+func (p poolDetails) memberIDs() ([]int64, []string) {
+	var vmIDs []int64
+	var storages []string
+	for _, member := range p.Members {
+		switch member.Type {
+		case guestTypeVM, guestTypeLXC:
+			vmIDs = append(vmIDs, member.VMID)
+		case "storage":
+			if !slices.Contains(storages, member.Storage) {
+				storages = append(storages, member.Storage)
+			}
+		}
+	}
+	return vmIDs, storages
+}
+
+func (r *PoolMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_membership"
+}
+
+func (r *PoolMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches existing guests and storages to a Proxmox VE resource pool. " +
+			"Only the listed members are managed; other members of the pool are left untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the pool ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pool_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the pool the members are attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the virtual machines and containers to attach to the pool",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Validators:          []validator.Set{validators.VMID()},
+			},
+			"storages": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the storages to attach to the pool",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          []validator.Set{validators.StorageID()},
+			},
+		},
+	}
+}
+
+func (r *PoolMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PoolMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PoolMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vmIDs, storages := r.members(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := data.PoolID.ValueString()
+	details, err := getPool(ctx, r.client, pool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool %s, got error: %s", pool, err))
+		return
+	}
+	poolVMIDs, poolStorages := details.memberIDs()
+
+	// Proxmox VE rejects adding members twice, so members that are already
+	// in the pool are only adopted.
+	if err := r.update(ctx, pool, subtract(vmIDs, poolVMIDs), subtract(storages, poolStorages), false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to pool %s, got error: %s", pool, err))
+		return
+	}
+
+	data.ID = data.PoolID
+
+	tflog.Trace(ctx, "created pool membership", map[string]interface{}{"pool": data.PoolID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PoolMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PoolMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	pool, err := getPool(ctx, r.client, data.ID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	wantVMIDs, wantStorages := r.members(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After import nothing is known about the managed members, so adopt all
+	// of them. Otherwise only keep the members this resource is managing.
+	importing := data.VMIDs.IsNull() && data.Storages.IsNull()
+
+	poolVMIDs, poolStorages := pool.memberIDs()
+	vmIDs, storages := poolVMIDs, poolStorages
+	if !importing {
+		vmIDs = intersect(poolVMIDs, wantVMIDs)
+		storages = intersect(poolStorages, wantStorages)
+	}
+
+	if len(vmIDs) > 0 || !data.VMIDs.IsNull() {
+		set, diags := types.SetValueFrom(ctx, types.Int64Type, vmIDs)
+		resp.Diagnostics.Append(diags...)
+		data.VMIDs = set
+	}
+	if len(storages) > 0 || !data.Storages.IsNull() {
+		set, diags := types.SetValueFrom(ctx, types.StringType, storages)
+		resp.Diagnostics.Append(diags...)
+		data.Storages = set
+	}
+	data.PoolID = data.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PoolMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PoolMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	planVMIDs, planStorages := r.members(ctx, data, &resp.Diagnostics)
+	stateVMIDs, stateStorages := r.members(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := data.PoolID.ValueString()
+	details, err := getPool(ctx, r.client, pool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool %s, got error: %s", pool, err))
+		return
+	}
+	poolVMIDs, poolStorages := details.memberIDs()
+
+	// Proxmox VE rejects adding members twice and removing members that are
+	// gone, so only send the changes that still apply to the pool.
+	removedVMIDs := intersect(subtract(stateVMIDs, planVMIDs), poolVMIDs)
+	removedStorages := intersect(subtract(stateStorages, planStorages), poolStorages)
+	addedVMIDs := subtract(subtract(planVMIDs, stateVMIDs), poolVMIDs)
+	addedStorages := subtract(subtract(planStorages, stateStorages), poolStorages)
+
+	if err := r.update(ctx, pool, removedVMIDs, removedStorages, true); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from pool %s, got error: %s", pool, err))
+		return
+	}
+	if err := r.update(ctx, pool, addedVMIDs, addedStorages, false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to pool %s, got error: %s", pool, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PoolMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PoolMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	vmIDs, storages := r.members(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := getPool(ctx, r.client, data.PoolID.ValueString())
+	if isNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool %s, got error: %s", data.PoolID.ValueString(), err))
+		return
+	}
+
+	// Members that were removed from the pool in the meantime are gone
+	// already.
+	poolVMIDs, poolStorages := pool.memberIDs()
+	err = r.update(ctx, data.PoolID.ValueString(), intersect(vmIDs, poolVMIDs), intersect(storages, poolStorages), true)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from pool %s, got error: %s", data.PoolID.ValueString(), err))
+		return
+	}
+}
+
+func (r *PoolMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// members extracts the configured member lists from the model.
+func (r *PoolMembershipResource) members(ctx context.Context, data PoolMembershipResourceModel, diags *diag.Diagnostics) ([]int64, []string) {
+	var vmIDs []int64
+	var storages []string
+
+	if !data.VMIDs.IsNull() && !data.VMIDs.IsUnknown() {
+		diags.Append(data.VMIDs.ElementsAs(ctx, &vmIDs, false)...)
+	}
+	if !data.Storages.IsNull() && !data.Storages.IsUnknown() {
+		diags.Append(data.Storages.ElementsAs(ctx, &storages, false)...)
+	}
+
+	return vmIDs, storages
+}
+
+// update adds the given members to the pool, or removes them when remove is
+// set. It is a no-op when there is nothing to change.
+func (r *PoolMembershipResource) update(ctx context.Context, pool string, vmIDs []int64, storages []string, remove bool) error {
+	if len(vmIDs) == 0 && len(storages) == 0 {
+		return nil
+	}
+
+	params := map[string]interface{}{}
+	if len(vmIDs) > 0 {
+		ids := make([]string, len(vmIDs))
+		for i, vmID := range vmIDs {
+			ids[i] = strconv.FormatInt(vmID, 10)
+		}
+		params["vms"] = strings.Join(ids, ",")
+	}
+	if len(storages) > 0 {
+		params["storage"] = strings.Join(storages, ",")
+	}
+	if remove {
+		params["delete"] = 1
+	}
+
+	return r.client.Put(ctx, "/pools/"+url.PathEscape(pool), params, nil)
+}
+
+// subtract returns the values that are not in exclude.
+func subtract[T comparable](values, exclude []T) []T {
+	var result []T
+	for _, value := range values {
+		if !slices.Contains(exclude, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// intersect returns the values that are also in other.
+func intersect[T comparable](values, other []T) []T {
+	var result []T
+	for _, value := range values {
+		if slices.Contains(other, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+func TestAccPoolMembershipResource(t *testing.T) {
+	pool := testAccRequireEnv(t, "PROXMOX_POOL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPoolMembershipResourceConfig(pool),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_pool_membership.test", "id", pool),
+					resource.TestCheckResourceAttr("proxmox_pool_membership.test", "storages.#", "1"),
+					resource.TestCheckTypeSetElemAttr("proxmox_pool_membership.test", "storages.*", "local"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_pool_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPoolMembershipResourceConfig(pool string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_pool_membership" "test" {
+  pool_id  = %[1]q
+  storages = ["local"]
+}
+`, pool)
+}
+
+// TestPoolMembershipResource adds members to a pool with members of its own
+// on a cluster of two nodes, which lists storages twice, and changes them.
+func TestPoolMembershipResource(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddNode("pve2")
+	h.Fake.AddStorage("backup", "dir", map[string]interface{}{"path": "/mnt/backup", "content": "backup"})
+	for _, vmID := range []int64{100, 101, 102} {
+		h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, vmID, nil)
+	}
+	h.Fake.AddPool("prod", "")
+	h.Fake.SetPoolMembers("prod", []int64{100}, nil)
+
+	membership, err := h.create("proxmox_pool_membership", map[string]interface{}{
+		"pool_id":  "prod",
+		"vm_ids":   []interface{}{101},
+		"storages": []interface{}{"local"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create pool membership: %s", err)
+	}
+	assertPoolMembers(t, h, "prod", []int64{100, 101}, []string{"local"})
+
+	if _, err := h.refresh(membership); err != nil {
+		t.Fatalf("unable to read pool membership: %s", err)
+	}
+	if got := membership.attributes()["storages"]; !reflect.DeepEqual(got, []interface{}{"local"}) {
+		t.Errorf("expected storages [local] once, got %v", got)
+	}
+
+	err = h.update(membership, map[string]interface{}{
+		"pool_id":  "prod",
+		"vm_ids":   []interface{}{101, 102},
+		"storages": []interface{}{"backup"},
+	})
+	if err != nil {
+		t.Fatalf("unable to update pool membership: %s", err)
+	}
+	assertPoolMembers(t, h, "prod", []int64{100, 101, 102}, []string{"backup"})
+
+	if err := h.destroy(membership); err != nil {
+		t.Fatalf("unable to delete pool membership: %s", err)
+	}
+	assertPoolMembers(t, h, "prod", []int64{100}, nil)
+}
+
+// TestPoolMembershipResourceMembersGone deletes a membership whose members
+// were removed from the pool outside of Terraform.
+func TestPoolMembershipResourceMembersGone(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 100, nil)
+	h.Fake.AddGuest(fakeproxmox.LXC, fakeproxmox.Node, 101, nil)
+	h.Fake.AddPool("prod", "")
+
+	membership, err := h.create("proxmox_pool_membership", map[string]interface{}{
+		"pool_id":  "prod",
+		"vm_ids":   []interface{}{100, 101},
+		"storages": []interface{}{"local"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create pool membership: %s", err)
+	}
+
+	h.Fake.SetPoolMembers("prod", []int64{101}, nil)
+	if err := h.destroy(membership); err != nil {
+		t.Fatalf("unable to delete pool membership: %s", err)
+	}
+	assertPoolMembers(t, h, "prod", nil, nil)
+}
+
+// TestPoolMembershipResourceMembersPresent creates a membership of members
+// that are already in the pool, which are adopted.
+func TestPoolMembershipResourceMembersPresent(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 100, nil)
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 101, nil)
+	h.Fake.AddPool("prod", "")
+	h.Fake.SetPoolMembers("prod", []int64{100}, []string{"local"})
+
+	membership, err := h.create("proxmox_pool_membership", map[string]interface{}{
+		"pool_id":  "prod",
+		"vm_ids":   []interface{}{100, 101},
+		"storages": []interface{}{"local"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create pool membership: %s", err)
+	}
+	assertPoolMembers(t, h, "prod", []int64{100, 101}, []string{"local"})
+
+	if err := h.destroy(membership); err != nil {
+		t.Fatalf("unable to delete pool membership: %s", err)
+	}
+	assertPoolMembers(t, h, "prod", nil, nil)
+}
+
+func assertPoolMembers(t *testing.T, h *testHarness, pool string, vmIDs []int64, storages []string) {
+	t.Helper()
+
+	gotVMIDs, gotStorages, ok := h.Fake.PoolMembers(pool)
+	if !ok {
+		t.Fatalf("pool %s does not exist", pool)
+	}
+	if len(gotVMIDs) != len(vmIDs) || (len(vmIDs) > 0 && !reflect.DeepEqual(gotVMIDs, vmIDs)) {
+		t.Errorf("expected guests %v in pool %s, got %v", vmIDs, pool, gotVMIDs)
+	}
+	if len(gotStorages) != len(storages) || (len(storages) > 0 && !reflect.DeepEqual(gotStorages, storages)) {
+		t.Errorf("expected storages %v in pool %s, got %v", storages, pool, gotStorages)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PoolsDataSource{}
+
+func NewPoolsDataSource() datasource.DataSource {
+	return &PoolsDataSource{}
+}
+
+// PoolsDataSource defines the data source implementation.
+type PoolsDataSource struct {
+	client *ProxmoxClient
+}
+
+// PoolsDataSourceModel describes the data source data model.
+type PoolsDataSourceModel struct {
+	ID    types.String       `tfsdk:"id"`
+	Pools []PoolSummaryModel `tfsdk:"pools"`
+}
+
+// PoolSummaryModel describes a pool and its members.
+type PoolSummaryModel struct {
+	PoolID   types.String `tfsdk:"pool_id"`
+	Comment  types.String `tfsdk:"comment"`
+	VMIDs    []int64      `tfsdk:"vm_ids"`
+	Storages []string     `tfsdk:"storages"`
+}
+
+func (d *PoolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pools"
+}
+
+func (d *PoolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the resource pools with their members.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"pools": schema.ListNestedAttribute{
+				MarkdownDescription: "Resource pools, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pool_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the pool",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment of the pool",
+							Computed:            true,
+						},
+						"vm_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the member virtual machines and containers, sorted",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"storages": schema.ListAttribute{
+							MarkdownDescription: "Member storages, sorted",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoolsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox pools")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/pools", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pools, got error: %s", err))
+		return
+	}
+
+	pools := make([]PoolSummaryModel, len(entries))
+	for i, entry := range entries {
+		id := stringValue(entry, "poolid").ValueString()
+
+		pool, err := getPool(ctx, d.client, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool %s, got error: %s", id, err))
+			return
+		}
+
+		pools[i] = PoolSummaryModel{
+			PoolID:   types.StringValue(id),
+			Comment:  stringValue(entry, "comment"),
+			VMIDs:    []int64{},
+			Storages: []string{},
+		}
+		for _, member := range pool.Members {
+			switch member.Type {
+			case guestTypeVM, guestTypeLXC:
+				pools[i].VMIDs = append(pools[i].VMIDs, member.VMID)
+			case "storage":
+				// Storages are listed once per node.
+				if !slices.Contains(pools[i].Storages, member.Storage) {
+					pools[i].Storages = append(pools[i].Storages, member.Storage)
+				}
+			}
+		}
+		slices.Sort(pools[i].VMIDs)
+		sort.Strings(pools[i].Storages)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return strings.Compare(pools[i].PoolID.ValueString(), pools[j].PoolID.ValueString()) < 0
+	})
+
+	data.Pools = pools
+	data.ID = types.StringValue("pools")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d pools", len(pools)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoolsDataSource(t *testing.T) {
+	pool := testAccRequireEnv(t, "PROXMOX_POOL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_pools" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_pools.test", "id", "pools"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_pools.test", "pools.*", map[string]string{
+						"pool_id": pool,
+					}),
+				),
+			},
+		},
+	})
+}
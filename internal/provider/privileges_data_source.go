@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PrivilegesDataSource{}
+
+func NewPrivilegesDataSource() datasource.DataSource {
+	return &PrivilegesDataSource{}
+}
+
+// PrivilegesDataSource defines the data source implementation.
+type PrivilegesDataSource struct {
+	client *ProxmoxClient
+}
+
+// PrivilegesDataSourceModel describes the data source data model.
+type PrivilegesDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Privileges []string     `tfsdk:"privileges"`
+}
+
+func (d *PrivilegesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privileges"
+}
+
+func (d *PrivilegesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the privileges known to the Proxmox VE cluster, derived from the built-in roles.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "Sorted list of privilege names (e.g., VM.Allocate, Datastore.Audit)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PrivilegesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PrivilegesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PrivilegesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox privileges")
+
+	var roles []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/access/roles", &roles); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read roles, got error: %s", err))
+		return
+	}
+
+	// Built-in roles are flagged as special. Together they cover every
+	// privilege of the running PVE version, as the Administrator role is
+	// granted all of them.
+	seen := map[string]bool{}
+	privileges := []string{}
+	for _, role := range roles {
+		if !boolValue(role, "special").ValueBool() {
+			continue
+		}
+		for _, priv := range splitList(stringValue(role, "privs").ValueString()) {
+			if !seen[priv] {
+				seen[priv] = true
+				privileges = append(privileges, priv)
+			}
+		}
+	}
+	sort.Strings(privileges)
+
+	data.Privileges = privileges
+	data.ID = types.StringValue("privileges")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d privileges", len(privileges)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -8,18 +8,24 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure ProxmoxProvider satisfies various provider interfaces.
 var _ provider.Provider = &ProxmoxProvider{}
+var _ provider.ProviderWithEphemeralResources = &ProxmoxProvider{}
 
 // ProxmoxClient wraps the HTTP client for Proxmox API communication.
 type ProxmoxClient struct {
@@ -27,10 +33,27 @@ type ProxmoxClient struct {
 	Endpoint    string
 	TokenID     string
 	TokenSecret string
+
+	responses *responseCache
+	vmIDs     *vmIDAllocator
+
+	// taskPollInterval and taskTimeout tune waiting for tasks, zero values
+	// select the defaults.
+	taskPollInterval time.Duration
+	taskTimeout      time.Duration
+
+	// requestRetryDelay is the delay before the first retry of a failed
+	// request, the zero value selects the default.
+	requestRetryDelay time.Duration
 }
 
 // DoRequest makes an HTTP request to the Proxmox API.
 func (c *ProxmoxClient) DoRequest(method, path string, body interface{}) (*http.Response, error) {
+	return c.DoRequestWithContext(context.Background(), method, path, body)
+}
+
+// DoRequestWithContext makes an HTTP request to the Proxmox API bound to ctx.
+func (c *ProxmoxClient) DoRequestWithContext(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	var buf bytes.Buffer
 	if body != nil {
 		if err := json.NewEncoder(&buf).Encode(body); err != nil {
@@ -39,7 +62,7 @@ func (c *ProxmoxClient) DoRequest(method, path string, body interface{}) (*http.
 	}
 
 	url := strings.TrimSuffix(c.Endpoint, "/") + "/api2/json" + path
-	req, err := http.NewRequest(method, url, &buf)
+	req, err := http.NewRequestWithContext(ctx, method, url, &buf)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +87,9 @@ type ProxmoxProviderModel struct {
 	TokenID     types.String `tfsdk:"token_id"`
 	TokenSecret types.String `tfsdk:"token_secret"`
 	SkipVerify  types.Bool   `tfsdk:"skip_verify"`
+
+	TaskPollInterval types.String `tfsdk:"task_poll_interval"`
+	TaskTimeout      types.String `tfsdk:"task_timeout"`
 }
 
 func (p *ProxmoxProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -91,6 +117,22 @@ func (p *ProxmoxProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				MarkdownDescription: "Skip TLS certificate verification",
 				Optional:            true,
 			},
+			"task_poll_interval": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Interval in which the status of running Proxmox VE tasks is checked "+
+					"(e.g., `10s`). Defaults to `%s`", formatTimeout(defaultTaskPollInterval)),
+				Optional: true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+			},
+			"task_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for any single Proxmox VE task (e.g., `3h`). By default " +
+					"tasks are only limited by the `timeouts` of the resource, where supported",
+				Optional: true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+			},
 		},
 	}
 }
@@ -149,19 +191,130 @@ func (p *ProxmoxProvider) Configure(ctx context.Context, req provider.ConfigureR
 		Endpoint:    data.Endpoint.ValueString(),
 		TokenID:     data.TokenID.ValueString(),
 		TokenSecret: data.TokenSecret.ValueString(),
+		responses:   &responseCache{},
+		vmIDs:       &vmIDAllocator{},
+	}
+
+	for _, setting := range []struct {
+		name   string
+		value  types.String
+		target *time.Duration
+	}{
+		{"task_poll_interval", data.TaskPollInterval, &client.taskPollInterval},
+		{"task_timeout", data.TaskTimeout, &client.taskTimeout},
+	} {
+		if setting.value.IsNull() || setting.value.IsUnknown() {
+			continue
+		}
+		duration, err := parseTimeout(setting.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(setting.name), "Invalid Attribute Value", err.Error())
+			return
+		}
+		*setting.target = duration
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *ProxmoxProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewACMEAccountResource,
+		NewACMECertificateResource,
+		NewACMEPluginResource,
+		NewAPITokenResource,
+		NewBackupJobResource,
+		NewClusterJoinResource,
+		NewClusterOptionsResource,
+		NewFirewallOptionsResource,
+		NewFirewallRulesResource,
+		NewGuestBackupResource,
+		NewHAGroupResource,
+		NewHAResourceResource,
+		NewMetricsServerResource,
+		NewNodeCertificateResource,
+		NewNodeFirewallOptionsResource,
+		NewNodeHostsResource,
+		NewNodeOptionsResource,
+		NewNodeServiceResource,
+		NewNodeTimeResource,
+		NewNotificationGotifyResource,
+		NewNotificationSMTPResource,
+		NewNotificationWebhookResource,
+		NewVMFirewallOptionsResource,
+		NewVMFirewallRulesResource,
+		NewLXCFirewallOptionsResource,
+		NewLXCFirewallRulesResource,
+		NewVMRestoreResource,
+		NewLXCRestoreResource,
+		NewNetworkBondResource,
+		NewNetworkOVSBridgeResource,
+		NewNetworkOVSBondResource,
+		NewNetworkOVSIntPortResource,
+		NewNetworkApplyResource,
+		NewSDNZoneResource,
+		NewSDNSubnetResource,
+		NewSDNIPAMResource,
+		NewSDNDNSResource,
+		NewSDNApplyResource,
+		NewPoolMembershipResource,
+		NewRealmSyncJobResource,
+		NewReplicationJobResource,
+		NewUserPasswordResource,
+	}
 }
 
 func (p *ProxmoxProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewStoragesDataSource,
+		NewPrivilegesDataSource,
+		NewFirewallLogDataSource,
+		NewFirewallRefsDataSource,
+		NewNodeNetworkDataSource,
+		NewNodeDataSource,
+		NewNodeTimeDataSource,
+		NewNodesDataSource,
+		NewVMDataSource,
+		NewVMsDataSource,
+		NewLXCDataSource,
+		NewClusterStatusDataSource,
+		NewVersionDataSource,
+		NewVMIDDataSource,
+		NewPoolDataSource,
+		NewPoolsDataSource,
+		NewRolesDataSource,
+		NewACLsDataSource,
+		NewRealmsDataSource,
+		NewNodePCIDevicesDataSource,
+		NewNodeUSBDevicesDataSource,
+		NewNodeQEMUCapabilitiesDataSource,
+		NewStorageStatusDataSource,
+		NewBackupsDataSource,
+		NewVMSnapshotsDataSource,
+		NewLXCSnapshotsDataSource,
+		NewReplicationStatusDataSource,
+		NewCephPoolsDataSource,
+		NewSDNZonesDataSource,
+		NewSDNVNetsDataSource,
+		NewSDNSubnetsDataSource,
+		NewNodeDNSDataSource,
+		NewNodeCertificatesDataSource,
+		NewISOImagesDataSource,
+		NewNodeServicesDataSource,
+		NewClusterJoinInfoDataSource,
+		NewVMConfigDataSource,
+		NewLXCConfigDataSource,
+		NewVMNetworkInterfacesDataSource,
+		NewSubscriptionsDataSource,
+		NewNodeMetricsDataSource,
+	}
+}
+
+func (p *ProxmoxProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPITokenEphemeralResource,
 	}
 }
 
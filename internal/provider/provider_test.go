@@ -4,10 +4,27 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -29,3 +46,260 @@ func testAccPreCheck(t *testing.T) {
 		t.Skip("PROXMOX_TOKEN_SECRET environment variable must be set for acceptance tests")
 	}
 }
+
+// testAccProviderConfig returns the provider block shared by acceptance test
+// configurations.
+func testAccProviderConfig() string {
+	return fmt.Sprintf(`
+provider "proxmox" {
+  endpoint     = "%s"
+  token_id     = "%s"
+  token_secret = "%s"
+  skip_verify  = true
+}
+`, testEndpoint(), testTokenID(), testTokenSecret())
+}
+
+// testAccRequireEnv skips the test unless the given environment variable is
+// set, returning its value. It is used for tests that depend on objects which
+// the provider cannot create itself.
+func testAccRequireEnv(t *testing.T, name string) string {
+	value := os.Getenv(name)
+	if value == "" {
+		t.Skipf("%s environment variable must be set for this acceptance test", name)
+	}
+	return value
+}
+
+// testNode returns the node acceptance tests run against.
+func testNode() string {
+	node := os.Getenv("PROXMOX_NODE")
+	if node == "" {
+		return "pve"
+	}
+	return node
+}
+
+// TestProviderResourcesImportable ensures every resource can be imported, so
+// existing objects can be adopted without recreating them, and documents its
+// import ID format in an import.sh example used for the generated docs.
+func TestProviderResourcesImportable(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "proxmox"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			if _, ok := r.(resource.ResourceWithImportState); !ok {
+				t.Errorf("%s does not implement ImportState", metadata.TypeName)
+			}
+
+			example, err := os.ReadFile(filepath.Join("..", "..", "examples", "resources", metadata.TypeName, "import.sh"))
+			if err != nil {
+				t.Fatalf("missing import example: %s", err)
+			}
+			if !strings.HasPrefix(string(example), "# ") || !strings.Contains(string(example), "terraform import "+metadata.TypeName+".") {
+				t.Errorf("import example must describe the ID format and show the import command, got:\n%s", example)
+			}
+		})
+	}
+}
+
+// resourceReplaceAttributes lists the configurable attributes of every
+// resource that destroy and recreate it when changed, with nested attributes
+// and blocks as dotted paths (e.g., `domain.name`). All other configurable
+// attributes are updated in place. Resources without entries never need to be
+// replaced.
+var resourceReplaceAttributes = map[string][]string{
+	"proxmox_acme_account":          {"accept_tos", "directory", "name"},
+	"proxmox_acme_certificate":      {"node"},
+	"proxmox_acme_plugin":           {"plugin"},
+	"proxmox_api_token":             {"token_id", "user_id"},
+	"proxmox_backup_job":            {"job_id"},
+	"proxmox_cluster_join":          {"node"},
+	"proxmox_cluster_options":       {},
+	"proxmox_firewall_options":      {},
+	"proxmox_firewall_rules":        {},
+	"proxmox_guest_backup":          {"compress", "mode", "node", "notes_template", "protected", "storage", "triggers", "vm_id"},
+	"proxmox_ha_group":              {"group"},
+	"proxmox_ha_resource":           {"type", "vm_id"},
+	"proxmox_metrics_server":        {"name", "type"},
+	"proxmox_node_certificate":      {"node"},
+	"proxmox_node_firewall_options": {"node"},
+	"proxmox_node_hosts":            {"node"},
+	"proxmox_node_options":          {"node"},
+	"proxmox_node_service":          {"node", "service"},
+	"proxmox_node_time":             {"node"},
+	"proxmox_notification_gotify":   {"name"},
+	"proxmox_notification_smtp":     {"name"},
+	"proxmox_notification_webhook":  {"name"},
+	"proxmox_vm_firewall_options":   {"node", "vm_id"},
+	"proxmox_vm_firewall_rules":     {"node", "vm_id"},
+	"proxmox_lxc_firewall_options":  {"node", "vm_id"},
+	"proxmox_lxc_firewall_rules":    {"node", "vm_id"},
+	"proxmox_vm_restore":            {"archive", "bwlimit", "force", "node", "pool", "start", "storage", "triggers", "unique", "vm_id"},
+	"proxmox_lxc_restore":           {"archive", "bwlimit", "force", "node", "pool", "start", "storage", "triggers", "unique", "vm_id"},
+	"proxmox_network_bond":          {"iface", "node"},
+	"proxmox_network_ovs_bridge":    {"iface", "node"},
+	"proxmox_network_ovs_bond":      {"iface", "node"},
+	"proxmox_network_ovs_int_port":  {"iface", "node"},
+	"proxmox_network_apply":         {"node"},
+	"proxmox_sdn_zone":              {"type", "zone"},
+	"proxmox_sdn_subnet":            {"cidr", "vnet"},
+	"proxmox_sdn_ipam":              {"ipam", "type"},
+	"proxmox_sdn_dns":               {"dns", "type"},
+	"proxmox_sdn_apply":             {},
+	"proxmox_pool_membership":       {"pool_id"},
+	"proxmox_realm_sync_job":        {"job_id", "realm"},
+	"proxmox_replication_job":       {"job_number", "target", "vm_id"},
+	"proxmox_user_password":         {"user_id"},
+}
+
+// TestProviderResourcesRequiresReplace ensures the attributes forcing a
+// replacement are a deliberate choice, so that destructive plans do not come
+// as a surprise. Changing the wiring of a resource requires updating
+// resourceReplaceAttributes.
+func TestProviderResourcesRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "proxmox"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			want, ok := resourceReplaceAttributes[metadata.TypeName]
+			if !ok {
+				t.Fatalf("%s is missing in resourceReplaceAttributes", metadata.TypeName)
+			}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			got := replacingAttributes(schemaResp.Schema.Attributes, schemaResp.Schema.Blocks, "")
+			sort.Strings(got)
+
+			if !slices.Equal(got, want) {
+				t.Errorf("got replacing attributes %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// requiresReplaceTypes are the types of the RequiresReplace, RequiresReplaceIf
+// and RequiresReplaceIfConfigured plan modifiers of the framework, which
+// share one type per package.
+var requiresReplaceTypes = []reflect.Type{
+	reflect.TypeOf(boolplanmodifier.RequiresReplace()),
+	reflect.TypeOf(float64planmodifier.RequiresReplace()),
+	reflect.TypeOf(int64planmodifier.RequiresReplace()),
+	reflect.TypeOf(listplanmodifier.RequiresReplace()),
+	reflect.TypeOf(mapplanmodifier.RequiresReplace()),
+	reflect.TypeOf(objectplanmodifier.RequiresReplace()),
+	reflect.TypeOf(setplanmodifier.RequiresReplace()),
+	reflect.TypeOf(stringplanmodifier.RequiresReplace()),
+}
+
+// replacingAttributes returns the configurable attributes and blocks that
+// require replacement, including nested ones as dotted paths below prefix.
+func replacingAttributes(attributes map[string]schema.Attribute, blocks map[string]schema.Block, prefix string) []string {
+	var names []string
+	for name, attribute := range attributes {
+		if !attribute.IsRequired() && !attribute.IsOptional() {
+			continue
+		}
+		if requiresReplace(attribute) {
+			names = append(names, prefix+name)
+		}
+
+		var nested map[string]schema.Attribute
+		switch attribute := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			nested = attribute.Attributes
+		case schema.ListNestedAttribute:
+			nested = attribute.NestedObject.Attributes
+		case schema.SetNestedAttribute:
+			nested = attribute.NestedObject.Attributes
+		case schema.MapNestedAttribute:
+			nested = attribute.NestedObject.Attributes
+		}
+		names = append(names, replacingAttributes(nested, nil, prefix+name+".")...)
+	}
+
+	for name, block := range blocks {
+		if requiresReplace(block) {
+			names = append(names, prefix+name)
+		}
+
+		var object schema.NestedBlockObject
+		switch block := block.(type) {
+		case schema.SingleNestedBlock:
+			object = schema.NestedBlockObject{Attributes: block.Attributes, Blocks: block.Blocks}
+		case schema.ListNestedBlock:
+			object = block.NestedObject
+		case schema.SetNestedBlock:
+			object = block.NestedObject
+		}
+		names = append(names, replacingAttributes(object.Attributes, object.Blocks, prefix+name+".")...)
+	}
+	return names
+}
+
+// requiresReplace reports whether any plan modifier of the attribute or
+// block is one of the RequiresReplace modifiers of the framework.
+func requiresReplace(attribute interface{}) bool {
+	modifiers := reflect.ValueOf(attribute).FieldByName("PlanModifiers")
+	if !modifiers.IsValid() {
+		return false
+	}
+
+	for i := 0; i < modifiers.Len(); i++ {
+		if slices.Contains(requiresReplaceTypes, reflect.TypeOf(modifiers.Index(i).Interface())) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestProviderResourcesUpgradeState ensures resources with a versioned
+// schema can upgrade the state of every prior version, so existing users do
+// not need to import their objects again after upgrading the provider.
+func TestProviderResourcesUpgradeState(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "proxmox"}, &metadata)
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Schema.Version == 0 {
+			continue
+		}
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			upgradable, ok := r.(resource.ResourceWithUpgradeState)
+			if !ok {
+				t.Fatalf("%s has schema version %d but does not implement UpgradeState", metadata.TypeName, schemaResp.Schema.Version)
+			}
+
+			upgraders := upgradable.UpgradeState(ctx)
+			for version := int64(0); version < schemaResp.Schema.Version; version++ {
+				upgrader, ok := upgraders[version]
+				if !ok {
+					t.Errorf("missing state upgrader from version %d", version)
+					continue
+				}
+				if upgrader.PriorSchema == nil {
+					t.Errorf("state upgrader from version %d has no prior schema", version)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RealmSyncJobResource{}
+var _ resource.ResourceWithImportState = &RealmSyncJobResource{}
+
+func NewRealmSyncJobResource() resource.Resource {
+	return &RealmSyncJobResource{}
+}
+
+// RealmSyncJobResource defines the resource implementation.
+type RealmSyncJobResource struct {
+	client *ProxmoxClient
+}
+
+// RealmSyncJobResourceModel describes the resource data model.
+type RealmSyncJobResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	JobID          types.String `tfsdk:"job_id"`
+	Realm          types.String `tfsdk:"realm"`
+	Schedule       types.String `tfsdk:"schedule"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Comment        types.String `tfsdk:"comment"`
+	Scope          types.String `tfsdk:"scope"`
+	EnableNew      types.Bool   `tfsdk:"enable_new"`
+	RemoveVanished types.Set    `tfsdk:"remove_vanished"`
+	NextRun        types.Int64  `tfsdk:"next_run"`
+}
+
+func (r *RealmSyncJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_realm_sync_job"
+}
+
+func (r *RealmSyncJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a scheduled job synchronizing the users and groups of an LDAP or Active Directory " +
+			"realm.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the job ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the job",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "Realm that is synchronized",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Job schedule in the Proxmox VE calendar event format (e.g., `daily`)",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the job. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the job",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "What is synchronized, one of `users`, `groups` or `both`. Defaults to the sync " +
+					"options of the realm",
+				Optional: true,
+			},
+			"enable_new": schema.BoolAttribute{
+				MarkdownDescription: "Enable newly synchronized users. Defaults to the sync options of the realm",
+				Optional:            true,
+			},
+			"remove_vanished": schema.SetAttribute{
+				MarkdownDescription: "What is removed for users and groups that vanished from the directory, any of " +
+					"`acl`, `entry` and `properties`. Defaults to the sync options of the realm",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"next_run": schema.Int64Attribute{
+				MarkdownDescription: "Next run of the job as Unix timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RealmSyncJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RealmSyncJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RealmSyncJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("realm", data.Realm)
+
+	if err := r.client.Post(ctx, r.path(data.JobID.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create realm sync job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+
+	data.ID = data.JobID
+
+	job, err := r.job(ctx, data.JobID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read realm sync job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+	data.NextRun = int64Value(job, "next-run")
+
+	tflog.Trace(ctx, "created realm sync job", map[string]interface{}{"id": data.JobID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RealmSyncJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RealmSyncJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	job, err := r.job(ctx, data.ID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read realm sync job %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.JobID = data.ID
+	data.Realm = stringValue(job, "realm")
+	data.Schedule = stringValue(job, "schedule")
+	data.Enabled = boolValue(job, "enabled")
+	if data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(true)
+	}
+	data.Comment = stringValue(job, "comment")
+	data.Scope = stringValue(job, "scope")
+	data.EnableNew = boolValue(job, "enable-new")
+	data.RemoveVanished = realmRemoveVanishedValue(stringValue(job, "remove-vanished").ValueString())
+	data.NextRun = int64Value(job, "next-run")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RealmSyncJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RealmSyncJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.JobID.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update realm sync job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+
+	job, err := r.job(ctx, data.JobID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read realm sync job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+	data.NextRun = int64Value(job, "next-run")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RealmSyncJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RealmSyncJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.JobID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete realm sync job %s, got error: %s", data.JobID.ValueString(), err))
+		return
+	}
+}
+
+func (r *RealmSyncJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *RealmSyncJobResource) path(id string) string {
+	return "/cluster/jobs/realm-sync/" + url.PathEscape(id)
+}
+
+func (r *RealmSyncJobResource) job(ctx context.Context, id string) (map[string]interface{}, error) {
+	var job map[string]interface{}
+	err := r.client.Get(ctx, r.path(id), &job)
+	return job, err
+}
+
+// params returns the API parameters of the job. When update is set, unset
+// optional fields are removed from the existing job.
+func (m RealmSyncJobResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("schedule", m.Schedule)
+	params.setBool("enabled", m.Enabled)
+
+	setString, setBool := params.setString, params.setBool
+	if update {
+		setString, setBool = params.updateString, params.updateBool
+	}
+	setString("comment", m.Comment)
+	setString("scope", m.Scope)
+	setBool("enable-new", m.EnableNew)
+	setString("remove-vanished", realmRemoveVanished(m.RemoveVanished))
+
+	return params
+}
+
+// realmRemoveVanished joins the remove_vanished set into the semicolon
+// separated list expected by the API.
+func realmRemoveVanished(v types.Set) types.String {
+	if v.IsNull() || v.IsUnknown() {
+		return types.StringNull()
+	}
+
+	var items []string
+	for _, elem := range v.Elements() {
+		if s, ok := elem.(types.String); ok {
+			items = append(items, s.ValueString())
+		}
+	}
+	sort.Strings(items)
+	return types.StringValue(strings.Join(items, ";"))
+}
+
+// realmRemoveVanishedValue parses the remove-vanished list returned by the
+// API. The API reports an empty list as `none`.
+func realmRemoveVanishedValue(s string) types.Set {
+	var elems []attr.Value
+	for _, item := range splitList(s) {
+		if item != "none" {
+			elems = append(elems, types.StringValue(item))
+		}
+	}
+	if len(elems) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
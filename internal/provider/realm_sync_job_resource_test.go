@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRealmSyncJobResource(t *testing.T) {
+	realm := testAccRequireEnv(t, "PROXMOX_LDAP_REALM")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRealmSyncJobResourceConfig(realm, "daily"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_realm_sync_job.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_realm_sync_job.test", "schedule", "daily"),
+					resource.TestCheckResourceAttr("proxmox_realm_sync_job.test", "remove_vanished.#", "2"),
+					resource.TestCheckResourceAttr("proxmox_realm_sync_job.test", "enabled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_realm_sync_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRealmSyncJobResourceConfig(realm, "weekly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_realm_sync_job.test", "schedule", "weekly"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRealmSyncJobResourceConfig(realm, schedule string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_realm_sync_job" "test" {
+  job_id          = "tfacc"
+  realm           = %[1]q
+  schedule        = %[2]q
+  enabled         = false
+  scope           = "both"
+  enable_new      = true
+  remove_vanished = ["acl", "entry"]
+}
+`, realm, schedule)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RealmsDataSource{}
+
+func NewRealmsDataSource() datasource.DataSource {
+	return &RealmsDataSource{}
+}
+
+// RealmsDataSource defines the data source implementation.
+type RealmsDataSource struct {
+	client *ProxmoxClient
+}
+
+// RealmsDataSourceModel describes the data source data model.
+type RealmsDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Realms []RealmModel `tfsdk:"realms"`
+}
+
+// RealmModel describes an authentication realm.
+type RealmModel struct {
+	Realm   types.String `tfsdk:"realm"`
+	Type    types.String `tfsdk:"type"`
+	Comment types.String `tfsdk:"comment"`
+	Default types.Bool   `tfsdk:"default"`
+	TFA     types.String `tfsdk:"tfa"`
+}
+
+func (d *RealmsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_realms"
+}
+
+func (d *RealmsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the authentication realms of the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"realms": schema.ListNestedAttribute{
+				MarkdownDescription: "Authentication realms, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"realm": schema.StringAttribute{
+							MarkdownDescription: "Name of the realm, used as suffix of user names (e.g., `pam`)",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the realm, `pam`, `pve`, `ldap`, `ad` or `openid`",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment of the realm",
+							Computed:            true,
+						},
+						"default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the realm is preselected on the login page",
+							Computed:            true,
+						},
+						"tfa": schema.StringAttribute{
+							MarkdownDescription: "Two-factor authentication enforced by the realm (e.g., `type=oath`)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RealmsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RealmsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RealmsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox authentication realms")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/access/domains", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication realms, got error: %s", err))
+		return
+	}
+
+	realms := make([]RealmModel, len(entries))
+	for i, entry := range entries {
+		realms[i] = RealmModel{
+			Realm:   stringValue(entry, "realm"),
+			Type:    stringValue(entry, "type"),
+			Comment: stringValue(entry, "comment"),
+			Default: types.BoolValue(boolValue(entry, "default").ValueBool()),
+			TFA:     stringValue(entry, "tfa"),
+		}
+	}
+	sort.Slice(realms, func(i, j int) bool {
+		return strings.Compare(realms[i].Realm.ValueString(), realms[j].Realm.ValueString()) < 0
+	})
+
+	data.Realms = realms
+	data.ID = types.StringValue("realms")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d authentication realms", len(realms)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
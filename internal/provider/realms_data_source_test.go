@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRealmsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_realms" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_realms.test", "id", "realms"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_realms.test", "realms.*", map[string]string{
+						"realm": "pam",
+						"type":  "pam",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_realms.test", "realms.*", map[string]string{
+						"realm": "pve",
+						"type":  "pve",
+					}),
+				),
+			},
+		},
+	})
+}
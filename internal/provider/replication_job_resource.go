@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReplicationJobResource{}
+var _ resource.ResourceWithImportState = &ReplicationJobResource{}
+
+func NewReplicationJobResource() resource.Resource {
+	return &ReplicationJobResource{}
+}
+
+// ReplicationJobResource defines the resource implementation.
+type ReplicationJobResource struct {
+	client *ProxmoxClient
+}
+
+// ReplicationJobResourceModel describes the resource data model.
+type ReplicationJobResourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	VMID      types.Int64   `tfsdk:"vm_id"`
+	JobNumber types.Int64   `tfsdk:"job_number"`
+	Target    types.String  `tfsdk:"target"`
+	Schedule  types.String  `tfsdk:"schedule"`
+	Rate      types.Float64 `tfsdk:"rate"`
+	Comment   types.String  `tfsdk:"comment"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+}
+
+func (r *ReplicationJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_job"
+}
+
+func (r *ReplicationJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Proxmox VE storage replication job, replicating the local ZFS volumes of a guest " +
+			"to another node.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, the job ID in the `vm_id-job_number` format (e.g., `100-0`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the replicated guest",
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"job_number": schema.Int64Attribute{
+				MarkdownDescription: "Number of the job, unique per guest. Defaults to `0`",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Node the guest is replicated to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Replication schedule in the Proxmox VE calendar event format. Defaults to `*/15`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("*/15"),
+			},
+			"rate": schema.Float64Attribute{
+				MarkdownDescription: "Rate limit in MB/s",
+				Optional:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the job",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the job. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *ReplicationJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ReplicationJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReplicationJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fmt.Sprintf("%d-%d", data.VMID.ValueInt64(), data.JobNumber.ValueInt64())
+
+	params := data.params(false)
+	params["id"] = id
+	params["type"] = "local"
+	params.setString("target", data.Target)
+
+	if err := r.client.Post(ctx, "/cluster/replication", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create replication job %s, got error: %s", id, err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	tflog.Trace(ctx, "created replication job", map[string]interface{}{"id": id})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReplicationJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReplicationJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	vmID, jobNumber, err := parseReplicationJobID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	var job map[string]interface{}
+	err = r.client.Get(ctx, r.path(data.ID.ValueString()), &job)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read replication job %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.VMID = types.Int64Value(vmID)
+	data.JobNumber = types.Int64Value(jobNumber)
+	data.Target = stringValue(job, "target")
+	data.Schedule = stringValue(job, "schedule")
+	if data.Schedule.IsNull() {
+		data.Schedule = types.StringValue("*/15")
+	}
+	data.Rate = float64Value(job, "rate")
+	data.Comment = stringValue(job, "comment")
+	data.Enabled = types.BoolValue(!boolValue(job, "disable").ValueBool())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReplicationJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReplicationJobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.ID.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update replication job %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReplicationJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReplicationJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	// The job is only marked for removal, the replication service removes
+	// the replicated volumes on the target asynchronously.
+	err := r.client.Delete(ctx, r.path(data.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete replication job %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *ReplicationJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, _, err := parseReplicationJobID(req.ID); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ReplicationJobResource) path(id string) string {
+	return "/cluster/replication/" + url.PathEscape(id)
+}
+
+// params returns the API parameters of the job. When update is set, unset
+// optional fields are removed from the existing job.
+func (m ReplicationJobResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("schedule", m.Schedule)
+
+	setString, setFloat64 := params.setString, params.setFloat64
+	if update {
+		setString, setFloat64 = params.updateString, params.updateFloat64
+	}
+	setString("comment", m.Comment)
+	setFloat64("rate", m.Rate)
+
+	// The API only knows the inverted disable flag.
+	if !m.Enabled.ValueBool() {
+		params["disable"] = 1
+	} else if update {
+		params.remove("disable")
+	}
+
+	return params
+}
+
+// parseReplicationJobID splits a replication job ID such as `100-0` into the
+// guest ID and job number.
+func parseReplicationJobID(id string) (int64, int64, error) {
+	guest, job, ok := strings.Cut(id, "-")
+	vmID, err := strconv.ParseInt(guest, 10, 64)
+	if !ok || err != nil {
+		return 0, 0, fmt.Errorf("unexpected ID %q, expected format vm_id-job_number", id)
+	}
+	jobNumber, err := strconv.ParseInt(job, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unexpected ID %q, expected format vm_id-job_number", id)
+	}
+	return vmID, jobNumber, nil
+}
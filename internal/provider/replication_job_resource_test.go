@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReplicationJobResource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+	target := testAccRequireEnv(t, "PROXMOX_REPLICATION_TARGET")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccReplicationJobResourceConfig(vmID, target, "*/30", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_replication_job.test", "id", vmID+"-1"),
+					resource.TestCheckResourceAttr("proxmox_replication_job.test", "rate", "50"),
+					resource.TestCheckResourceAttr("proxmox_replication_job.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_replication_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccReplicationJobResourceConfig(vmID, target, "hourly", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_replication_job.test", "schedule", "hourly"),
+					resource.TestCheckResourceAttr("proxmox_replication_job.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationJobResourceConfig(vmID, target, schedule string, enabled bool) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_replication_job" "test" {
+  vm_id      = %[1]s
+  job_number = 1
+  target     = %[2]q
+  schedule   = %[3]q
+  rate       = 50
+  enabled    = %[4]t
+}
+`, vmID, target, schedule, enabled)
+}
+
+func TestParseReplicationJobID(t *testing.T) {
+	vmID, jobNumber, err := parseReplicationJobID("100-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if vmID != 100 || jobNumber != 2 {
+		t.Errorf("got %d-%d", vmID, jobNumber)
+	}
+
+	for _, id := range []string{"100", "100-", "-2", "abc-1"} {
+		if _, _, err := parseReplicationJobID(id); err == nil {
+			t.Errorf("%q: expected error", id)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ReplicationStatusDataSource{}
+
+func NewReplicationStatusDataSource() datasource.DataSource {
+	return &ReplicationStatusDataSource{}
+}
+
+// ReplicationStatusDataSource defines the data source implementation.
+type ReplicationStatusDataSource struct {
+	client *ProxmoxClient
+}
+
+// ReplicationStatusDataSourceModel describes the data source data model.
+type ReplicationStatusDataSourceModel struct {
+	ID   types.String             `tfsdk:"id"`
+	Node types.String             `tfsdk:"node"`
+	VMID types.Int64              `tfsdk:"vm_id"`
+	Jobs []ReplicationStatusModel `tfsdk:"jobs"`
+}
+
+// ReplicationStatusModel describes the state of a replication job.
+type ReplicationStatusModel struct {
+	JobID     types.String  `tfsdk:"job_id"`
+	VMID      types.Int64   `tfsdk:"vm_id"`
+	Target    types.String  `tfsdk:"target"`
+	Schedule  types.String  `tfsdk:"schedule"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	LastSync  types.Int64   `tfsdk:"last_sync"`
+	LastTry   types.Int64   `tfsdk:"last_try"`
+	NextSync  types.Int64   `tfsdk:"next_sync"`
+	Duration  types.Float64 `tfsdk:"duration"`
+	FailCount types.Int64   `tfsdk:"fail_count"`
+	Error     types.String  `tfsdk:"error"`
+}
+
+func (d *ReplicationStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_status"
+}
+
+func (d *ReplicationStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the state of the storage replication jobs of the guests on a Proxmox VE node.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the replicated guests run on",
+				Required:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list the jobs of this guest",
+				Optional:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"jobs": schema.ListNestedAttribute{
+				MarkdownDescription: "Replication jobs",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the job in the `vm_id-job_number` format",
+							Computed:            true,
+						},
+						"vm_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the replicated guest",
+							Computed:            true,
+						},
+						"target": schema.StringAttribute{
+							MarkdownDescription: "Node the guest is replicated to",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							MarkdownDescription: "Replication schedule",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the job is enabled",
+							Computed:            true,
+						},
+						"last_sync": schema.Int64Attribute{
+							MarkdownDescription: "Time of the last successful sync as Unix timestamp, `0` if the job never succeeded",
+							Computed:            true,
+						},
+						"last_try": schema.Int64Attribute{
+							MarkdownDescription: "Time of the last sync attempt as Unix timestamp",
+							Computed:            true,
+						},
+						"next_sync": schema.Int64Attribute{
+							MarkdownDescription: "Time of the next scheduled sync as Unix timestamp",
+							Computed:            true,
+						},
+						"duration": schema.Float64Attribute{
+							MarkdownDescription: "Duration of the last sync in seconds",
+							Computed:            true,
+						},
+						"fail_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of consecutive failed syncs",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "Error of the last failed sync, null if it succeeded",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReplicationStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ReplicationStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReplicationStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := data.Node.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox replication status", map[string]interface{}{"node": node})
+
+	path := "/nodes/" + url.PathEscape(node) + "/replication"
+	if !data.VMID.IsNull() {
+		path += "?guest=" + strconv.FormatInt(data.VMID.ValueInt64(), 10)
+	}
+
+	var entries []map[string]interface{}
+	if err := d.client.Get(ctx, path, &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read replication status of node %s, got error: %s", node, err))
+		return
+	}
+
+	jobs := make([]ReplicationStatusModel, len(entries))
+	for i, entry := range entries {
+		jobs[i] = ReplicationStatusModel{
+			JobID:     stringValue(entry, "id"),
+			VMID:      int64Value(entry, "guest"),
+			Target:    stringValue(entry, "target"),
+			Schedule:  stringValue(entry, "schedule"),
+			Enabled:   types.BoolValue(!boolValue(entry, "disable").ValueBool()),
+			LastSync:  int64Value(entry, "last_sync"),
+			LastTry:   int64Value(entry, "last_try"),
+			NextSync:  int64Value(entry, "next_sync"),
+			Duration:  float64Value(entry, "duration"),
+			FailCount: types.Int64Value(int64Value(entry, "fail_count").ValueInt64()),
+			Error:     stringValue(entry, "error"),
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return strings.Compare(jobs[i].JobID.ValueString(), jobs[j].JobID.ValueString()) < 0
+	})
+
+	data.ID = data.Node
+	data.Jobs = jobs
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d replication jobs", len(jobs)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
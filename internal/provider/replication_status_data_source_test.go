@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReplicationStatusDataSource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+	target := testAccRequireEnv(t, "PROXMOX_REPLICATION_TARGET")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_replication_job" "test" {
+  vm_id      = %[2]s
+  job_number = 1
+  target     = %[3]q
+}
+
+data "proxmox_replication_status" "test" {
+  node  = %[1]q
+  vm_id = proxmox_replication_job.test.vm_id
+}
+`, testNode(), vmID, target),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_replication_status.test", "id", testNode()),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_replication_status.test", "jobs.*", map[string]string{
+						"job_id":  vmID + "-1",
+						"vm_id":   vmID,
+						"target":  target,
+						"enabled": "true",
+					}),
+				),
+			},
+		},
+	})
+}
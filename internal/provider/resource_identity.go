@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file contains the resource identities of guests, which Terraform 1.12
+// and later uses to import resources by `identity` and to match them across
+// refactors and list queries. Guests are identified by the cluster and their
+// ID, which, unlike the `node/vmid` ID of the state, do not change when a
+// guest is migrated.
+
+// guestIdentityModel describes the identity of a guest.
+type guestIdentityModel struct {
+	Cluster types.String `tfsdk:"cluster"`
+	VMID    types.Int64  `tfsdk:"vm_id"`
+}
+
+// guestIdentitySchema returns the identity schema of the guests described
+// by label, e.g. "virtual machine".
+func guestIdentitySchema(label string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster": identityschema.StringAttribute{
+				Description:       "Name of the cluster, null for standalone nodes",
+				OptionalForImport: true,
+			},
+			"vm_id": identityschema.Int64Attribute{
+				Description:       fmt.Sprintf("ID of the %s", label),
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// clusterName returns the name of the cluster, or null if the provider is
+// configured for a standalone node.
+func (c *ProxmoxClient) clusterName(ctx context.Context) (types.String, error) {
+	var entries []map[string]interface{}
+	if err := c.Get(ctx, "/cluster/status", &entries); err != nil {
+		return types.StringNull(), err
+	}
+	for _, entry := range entries {
+		if stringValue(entry, "type").ValueString() == "cluster" {
+			return stringValue(entry, "name"), nil
+		}
+	}
+	return types.StringNull(), nil
+}
+
+// guestIdentity returns the identity of a guest of the cluster.
+func (c *ProxmoxClient) guestIdentity(ctx context.Context, vmID int64) (guestIdentityModel, error) {
+	cluster, err := c.clusterName(ctx)
+	if err != nil {
+		return guestIdentityModel{}, fmt.Errorf("unable to read cluster name: %w", err)
+	}
+	return guestIdentityModel{Cluster: cluster, VMID: types.Int64Value(vmID)}, nil
+}
+
+// guestIDFromIdentity returns the `node/vmid` ID of the guest with an
+// identity given for import. The cluster, if given, must be the cluster the
+// provider is configured for.
+func (c *ProxmoxClient) guestIDFromIdentity(ctx context.Context, guestType string, identity guestIdentityModel) (string, error) {
+	vmID := identity.VMID.ValueInt64()
+
+	if !identity.Cluster.IsNull() {
+		cluster, err := c.clusterName(ctx)
+		if err != nil {
+			return "", fmt.Errorf("unable to read cluster name: %w", err)
+		}
+		if !identity.Cluster.Equal(cluster) {
+			return "", fmt.Errorf("%s %d belongs to cluster %s, but the provider is configured for %s",
+				guestLabel(guestType), vmID, identity.Cluster.ValueString(), clusterLabel(cluster))
+		}
+	}
+
+	entries, err := c.clusterResourcesOfType(ctx, guestType)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if int64Value(entry, "vmid").ValueInt64() == vmID {
+			return formatID(stringValue(entry, "node").ValueString(), fmt.Sprint(vmID)), nil
+		}
+	}
+	return "", fmt.Errorf("%s %d does not exist", guestLabel(guestType), vmID)
+}
+
+// clusterLabel describes a cluster name returned by clusterName in messages.
+func clusterLabel(cluster types.String) string {
+	if cluster.IsNull() {
+		return "a standalone node"
+	}
+	return "cluster " + cluster.ValueString()
+}
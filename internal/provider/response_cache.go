@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// responseCache holds the responses of GET requests made through GetCached,
+// so that many data sources or refreshes reading the same list, e.g.
+// /cluster/resources or /storage, cost a single request. Terraform starts a
+// provider process per operation, so responses are shared by all reads of
+// one plan or apply. Any write through the client and the end of any task
+// awaited with waitForTask drop all responses, so reads after changes see
+// their effects.
+type responseCache struct {
+	mu        sync.Mutex
+	responses map[string]*cachedResponse
+}
+
+// cachedResponse is a response that is requested or was received. done is
+// closed once body and err are set.
+type cachedResponse struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// GetCached performs a GET request like Get, but reuses the response of an
+// earlier or concurrent request for the same path. It must only be used for
+// lists and settings that only change through the provider's own requests
+// and tasks during an operation, not for status or task endpoints such as
+// /cluster/status. Clients without cache, like the ones created for other
+// endpoints, always perform the request.
+func (c *ProxmoxClient) GetCached(ctx context.Context, path string, out interface{}) error {
+	if c.responses == nil {
+		return c.Get(ctx, path, out)
+	}
+
+	respBody, err := c.responses.get(ctx, path, func() ([]byte, error) {
+		return c.request(ctx, http.MethodGet, path, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return decodeData(respBody, out)
+}
+
+// get returns the cached response for path, calling request if there is
+// none. Concurrent callers wait for a single request. Failed requests are
+// not cached.
+func (c *responseCache) get(ctx context.Context, path string, request func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	response, ok := c.responses[path]
+	if ok {
+		c.mu.Unlock()
+		select {
+		case <-response.done:
+			return response.body, response.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	response = &cachedResponse{done: make(chan struct{})}
+	if c.responses == nil {
+		c.responses = map[string]*cachedResponse{}
+	}
+	c.responses[path] = response
+	c.mu.Unlock()
+
+	response.body, response.err = request()
+	close(response.done)
+
+	if response.err != nil {
+		c.mu.Lock()
+		if c.responses[path] == response {
+			delete(c.responses, path)
+		}
+		c.mu.Unlock()
+		return nil, response.err
+	}
+
+	tflog.Debug(ctx, "cached response", map[string]interface{}{"path": path})
+	return response.body, nil
+}
+
+// invalidate drops all cached responses.
+func (c *responseCache) invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses = nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetCached(t *testing.T) {
+	const upid = "UPID:pve1:0001A2B3:0C4D5E6F:65A1B2C3:vzdump:100:root@pam:"
+	var requests sync.Map
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count, _ := requests.LoadOrStore(r.URL.String(), new(atomic.Int64))
+		count.(*atomic.Int64).Add(1)
+		if r.URL.Path == "/api2/json/nodes/pve1/tasks/"+upid+"/status" {
+			fmt.Fprint(w, `{"data":{"status":"stopped","exitstatus":"OK"}}`)
+			return
+		}
+		if r.URL.Path == "/api2/json/pools" && failing.Load() {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"data":null}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"storage":"local"}]}`)
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, responses: &responseCache{}}
+	ctx := context.Background()
+	countOf := func(path string) int64 {
+		count, ok := requests.Load("/api2/json" + path)
+		if !ok {
+			return 0
+		}
+		return count.(*atomic.Int64).Load()
+	}
+
+	for i := 0; i < 3; i++ {
+		var entries []map[string]interface{}
+		if err := client.GetCached(ctx, "/storage", &entries); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(entries) != 1 || entries[0]["storage"] != "local" {
+			t.Fatalf("unexpected entries: %v", entries)
+		}
+		if err := client.GetCached(ctx, "/storage?type=dir", nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if countOf("/storage") != 1 || countOf("/storage?type=dir") != 1 {
+		t.Errorf("expected one request per path, got %d and %d", countOf("/storage"), countOf("/storage?type=dir"))
+	}
+
+	if err := client.GetCached(ctx, "/pools", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	failing.Store(false)
+	if err := client.GetCached(ctx, "/pools", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := countOf("/pools"); got != 2 {
+		t.Errorf("expected failed responses not to be cached, got %d requests", got)
+	}
+
+	if err := client.Delete(ctx, "/storage/local", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.GetCached(ctx, "/storage", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := countOf("/storage"); got != 2 {
+		t.Errorf("expected a new request after a write, got %d requests", got)
+	}
+
+	if err := client.waitForTask(ctx, upid); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.GetCached(ctx, "/storage", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := countOf("/storage"); got != 3 {
+		t.Errorf("expected a new request after a task, got %d requests", got)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// This file contains the retries of requests that failed for temporary
+// reasons. Proxmox VE serializes changes of guests and configuration files
+// with file locks, and requests that wait too long for a lock held by e.g.
+// the web interface or a backup fail with "can't lock file ... got timeout".
+// pveproxy answers with status 596 or 599 or a "got timeout" message when it
+// momentarily cannot reach the daemon or node handling a request. Such
+// requests are repeated with increasing, jittered delays before the error is
+// returned, so that parallel requests do not retry in lockstep. Only lock
+// timeouts are known to happen before anything changed, so other requests
+// than GET are only repeated after lock timeouts.
+
+// maxRequestRetries is the number of times a failed request is repeated.
+const maxRequestRetries = 5
+
+// defaultRetryDelay is the delay before the first retry of a request. It
+// doubles with every further retry up to maxRetryDelay.
+const (
+	defaultRetryDelay = time.Second
+	maxRetryDelay     = 10 * time.Second
+)
+
+// Status codes pveproxy uses for errors of its connection to the daemon or
+// node handling a request.
+const (
+	statusProxyError   = 596
+	statusProxyTimeout = 599
+)
+
+// isLockError reports whether err indicates that Proxmox VE timed out
+// waiting for a lock held by another operation.
+func isLockError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return strings.Contains(apiErr.Body, "can't lock file") && strings.Contains(apiErr.Body, "got timeout")
+}
+
+// isTransientError reports whether err indicates a temporary problem of
+// Proxmox VE, including lock timeouts, after which the request may succeed.
+func isTransientError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == statusProxyError || apiErr.StatusCode == statusProxyTimeout {
+		return true
+	}
+	return strings.Contains(apiErr.Body, "got timeout")
+}
+
+// shouldRetry reports whether a request with the given method that failed
+// with err is repeated. A proxy error or timeout may occur after Proxmox VE
+// applied a change, and repeating e.g. a POST creating an object would fail
+// or apply it twice, so only GET requests are retried after them.
+func shouldRetry(method string, err error) bool {
+	if isLockError(err) {
+		return true
+	}
+	return method == http.MethodGet && isTransientError(err)
+}
+
+// retryDelay returns the delay before the given retry, starting at 0,
+// without jitter.
+func (c *ProxmoxClient) retryDelay(retry int) time.Duration {
+	delay := c.requestRetryDelay
+	if delay <= 0 {
+		delay = defaultRetryDelay
+	}
+	for i := 0; i < retry && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// withRetries calls send until it succeeds, fails for a reason that is not
+// temporary or maxRequestRetries retries are exhausted.
+func (c *ProxmoxClient) withRetries(ctx context.Context, method, path string, send func() ([]byte, error)) ([]byte, error) {
+	for retry := 0; ; retry++ {
+		respBody, err := send()
+		if err == nil || !shouldRetry(method, err) {
+			return respBody, err
+		}
+		if retry == maxRequestRetries {
+			return nil, fmt.Errorf("%w (gave up after %d attempts)", err, retry+1)
+		}
+
+		message := "retrying request after a temporary error"
+		if isLockError(err) {
+			message = "retrying request blocked by a lock"
+		}
+		delay := jitter(c.retryDelay(retry))
+		tflog.Debug(ctx, message, map[string]interface{}{
+			"method": method,
+			"path":   path,
+			"retry":  retry + 1,
+			"delay":  delay.String(),
+			"error":  err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// jitter returns a random delay between half of delay and delay.
+func jitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + rand.N(half+1)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const lockErrorBody = `{"data":null,"message":"can't lock file '/var/lock/qemu-server/lock-100.conf' - got timeout\n"}`
+
+func TestRequestRetriesLockErrors(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, lockErrorBody)
+			return
+		}
+		fmt.Fprint(w, `{"data":"ok"}`)
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, requestRetryDelay: time.Millisecond}
+
+	var result string
+	if err := client.Put(context.Background(), "/nodes/pve1/qemu/100/config", apiParams{"cores": 2}, &result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result != "ok" || requests.Load() != 3 {
+		t.Errorf("expected success after 3 requests, got %q after %d requests", result, requests.Load())
+	}
+}
+
+func TestRequestRetriesExhausted(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+		if r.URL.Path == "/api2/json/nodes/pve1/qemu/100/config" {
+			fmt.Fprint(w, lockErrorBody)
+			return
+		}
+		fmt.Fprint(w, `{"data":null,"message":"unable to parse value\n"}`)
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, requestRetryDelay: time.Millisecond}
+
+	err := client.Put(context.Background(), "/nodes/pve1/qemu/100/config", nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !isLockError(err) {
+		t.Fatalf("expected the lock error, got %v", err)
+	}
+	if got := requests.Load(); got != maxRequestRetries+1 {
+		t.Errorf("expected %d requests, got %d", maxRequestRetries+1, got)
+	}
+
+	requests.Store(0)
+	if err := client.Put(context.Background(), "/nodes/pve1/qemu/101/config", nil, nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected other errors not to be retried, got %d requests", got)
+	}
+}
+
+func TestRequestRetriesProxyErrors(t *testing.T) {
+	for _, status := range []int{statusProxyError, statusProxyTimeout} {
+		var requests atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				w.WriteHeader(status)
+				return
+			}
+			fmt.Fprint(w, `{"data":"ok"}`)
+		}))
+
+		client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, requestRetryDelay: time.Millisecond}
+
+		var result string
+		if err := client.Get(context.Background(), "/nodes/pve2/status", &result); err != nil {
+			t.Errorf("status %d: unexpected error: %s", status, err)
+		}
+		if got := requests.Load(); got != 2 {
+			t.Errorf("status %d: expected 2 requests, got %d", status, got)
+		}
+		server.Close()
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		var requests atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(statusProxyTimeout)
+		}))
+
+		client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, requestRetryDelay: time.Millisecond}
+
+		if err := client.do(context.Background(), method, "/nodes/pve2/qemu", nil, nil); err == nil {
+			t.Errorf("%s: expected an error", method)
+		}
+		if got := requests.Load(); got != 1 {
+			t.Errorf("%s: expected proxy errors not to be retried, got %d requests", method, got)
+		}
+		server.Close()
+	}
+
+	for _, test := range []struct {
+		err       error
+		transient bool
+	}{
+		{&APIError{StatusCode: http.StatusInternalServerError, Body: `{"message":"got timeout\n"}`}, true},
+		{&APIError{StatusCode: http.StatusInternalServerError, Body: lockErrorBody}, true},
+		{&APIError{StatusCode: http.StatusInternalServerError, Body: `{"message":"VM 100 is locked (backup)\n"}`}, false},
+		{&APIError{StatusCode: http.StatusBadRequest, Body: `{"errors":{"cores":"value must be at least 1"}}`}, false},
+		{errors.New("connection refused"), false},
+	} {
+		if got := isTransientError(test.err); got != test.transient {
+			t.Errorf("%v: got transient %t, want %t", test.err, got, test.transient)
+		}
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	timeout := &APIError{StatusCode: http.StatusInternalServerError, Body: `{"message":"got timeout\n"}`}
+	lock := &APIError{StatusCode: http.StatusInternalServerError, Body: lockErrorBody}
+	proxy := &APIError{StatusCode: statusProxyError}
+
+	for _, test := range []struct {
+		method string
+		err    error
+		retry  bool
+	}{
+		{http.MethodGet, timeout, true},
+		{http.MethodGet, proxy, true},
+		{http.MethodGet, lock, true},
+		{http.MethodPost, timeout, false},
+		{http.MethodPost, proxy, false},
+		{http.MethodPost, lock, true},
+		{http.MethodPut, lock, true},
+		{http.MethodDelete, proxy, false},
+	} {
+		if got := shouldRetry(test.method, test.err); got != test.retry {
+			t.Errorf("%s %v: got retry %t, want %t", test.method, test.err, got, test.retry)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	client := &ProxmoxClient{}
+	for retry, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, maxRetryDelay, maxRetryDelay} {
+		if got := client.retryDelay(retry); got != want {
+			t.Errorf("retry %d: got delay %s, want %s", retry, got, want)
+		}
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if got := jitter(time.Second); got < time.Second/2 || got > time.Second {
+			t.Fatalf("got delay %s, want between 500ms and 1s", got)
+		}
+	}
+}
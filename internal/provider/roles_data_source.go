@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *ProxmoxClient
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Roles []RoleModel  `tfsdk:"roles"`
+}
+
+// RoleModel describes a role and its privileges.
+type RoleModel struct {
+	RoleID     types.String `tfsdk:"role_id"`
+	Privileges []string     `tfsdk:"privileges"`
+	BuiltIn    types.Bool   `tfsdk:"built_in"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the built-in and custom roles with their privileges.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Roles, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the role (e.g., `PVEVMAdmin`)",
+							Computed:            true,
+						},
+						"privileges": schema.ListAttribute{
+							MarkdownDescription: "Sorted list of the privileges of the role",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"built_in": schema.BoolAttribute{
+							MarkdownDescription: "Whether the role is built into Proxmox VE and cannot be modified",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox roles")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/access/roles", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read roles, got error: %s", err))
+		return
+	}
+
+	roles := make([]RoleModel, len(entries))
+	for i, entry := range entries {
+		privileges := splitList(stringValue(entry, "privs").ValueString())
+		sort.Strings(privileges)
+
+		roles[i] = RoleModel{
+			RoleID:     stringValue(entry, "roleid"),
+			Privileges: privileges,
+			BuiltIn:    types.BoolValue(boolValue(entry, "special").ValueBool()),
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return strings.Compare(roles[i].RoleID.ValueString(), roles[j].RoleID.ValueString()) < 0
+	})
+
+	data.Roles = roles
+	data.ID = types.StringValue("roles")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d roles", len(roles)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
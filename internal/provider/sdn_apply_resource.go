@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SDNApplyResource{}
+var _ resource.ResourceWithImportState = &SDNApplyResource{}
+var _ resource.ResourceWithModifyPlan = &SDNApplyResource{}
+
+func NewSDNApplyResource() resource.Resource {
+	return &SDNApplyResource{}
+}
+
+// SDNApplyResource defines the resource implementation.
+type SDNApplyResource struct {
+	client *ProxmoxClient
+}
+
+// SDNApplyResourceModel describes the resource data model.
+type SDNApplyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	PendingChanges types.List   `tfsdk:"pending_changes"`
+}
+
+func (r *SDNApplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_apply"
+}
+
+func (r *SDNApplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies the pending SDN configuration cluster wide. The SDN resources only change the " +
+			"pending configuration, so this resource should depend on them and list them in `triggers`. Pending changes " +
+			"made outside of Terraform are detected as drift and applied on the next run. Only one instance of this " +
+			"resource should exist per cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, always `sdn`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, applies the SDN configuration again",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"pending_changes": schema.ListAttribute{
+				MarkdownDescription: "SDN objects with pending changes, in the `kind/name: state` format",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SDNApplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SDNApplyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// After applying nothing is pending anymore. Pending changes found on
+	// refresh therefore show up as a diff, which triggers an update.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_changes"), types.ListValueMust(types.StringType, nil))...)
+}
+
+func (r *SDNApplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SDNApplyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply SDN configuration, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("sdn")
+	data.PendingChanges = types.ListValueMust(types.StringType, nil)
+
+	tflog.Trace(ctx, "applied SDN configuration")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNApplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SDNApplyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	changes, err := r.pendingChanges(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pending SDN configuration, got error: %s", err))
+		return
+	}
+
+	pending, diags := types.ListValueFrom(ctx, types.StringType, changes)
+	resp.Diagnostics.Append(diags...)
+	data.PendingChanges = pending
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SDNApplyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.apply(ctx); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply SDN configuration, got error: %s", err))
+		return
+	}
+
+	data.PendingChanges = types.ListValueMust(types.StringType, nil)
+
+	tflog.Trace(ctx, "applied SDN configuration")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNApplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The applied configuration stays active, so removing the resource only
+	// drops it from the Terraform state.
+}
+
+func (r *SDNApplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply applies the pending SDN configuration and waits for it to be
+// deployed to the nodes.
+func (r *SDNApplyResource) apply(ctx context.Context) error {
+	var upid string
+	if err := r.client.Put(ctx, "/cluster/sdn", nil, &upid); err != nil {
+		return err
+	}
+	return r.client.waitForTask(ctx, upid)
+}
+
+// pendingChanges returns the SDN objects with pending changes, sorted.
+func (r *SDNApplyResource) pendingChanges(ctx context.Context) ([]string, error) {
+	changes := []string{}
+
+	collect := func(kind, idKey, listPath string) ([]map[string]interface{}, error) {
+		var objects []map[string]interface{}
+		if err := r.client.Get(ctx, listPath+"?pending=1", &objects); err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			if state := stringValue(object, "state").ValueString(); state != "" {
+				changes = append(changes, fmt.Sprintf("%s/%s: %s", kind, stringValue(object, idKey).ValueString(), state))
+			}
+		}
+		return objects, nil
+	}
+
+	if _, err := collect("zones", "zone", "/cluster/sdn/zones"); err != nil {
+		return nil, err
+	}
+	if _, err := collect("controllers", "controller", "/cluster/sdn/controllers"); err != nil {
+		return nil, err
+	}
+	vnets, err := collect("vnets", "vnet", "/cluster/sdn/vnets")
+	if err != nil {
+		return nil, err
+	}
+	for _, vnet := range vnets {
+		subnetsPath := "/cluster/sdn/vnets/" + url.PathEscape(stringValue(vnet, "vnet").ValueString()) + "/subnets"
+		if _, err := collect("subnets", "subnet", subnetsPath); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(changes)
+	return changes, nil
+}
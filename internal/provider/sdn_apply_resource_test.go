@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDNApplyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSDNApplyResourceConfig(1450),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_apply.test", "id", "sdn"),
+					resource.TestCheckResourceAttr("proxmox_sdn_apply.test", "pending_changes.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_sdn_apply.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSDNApplyResourceConfig(1400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_zone.test", "mtu", "1400"),
+					resource.TestCheckResourceAttr("proxmox_sdn_apply.test", "pending_changes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSDNApplyResourceConfig(mtu int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_sdn_zone" "test" {
+  zone = "tfapply"
+  type = "simple"
+  mtu  = %[1]d
+}
+
+resource "proxmox_sdn_apply" "test" {
+  triggers = {
+    zone = jsonencode(proxmox_sdn_zone.test)
+  }
+}
+`, mtu)
+}
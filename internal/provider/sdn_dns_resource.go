@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SDNDNSResource{}
+var _ resource.ResourceWithImportState = &SDNDNSResource{}
+
+func NewSDNDNSResource() resource.Resource {
+	return &SDNDNSResource{}
+}
+
+// SDNDNSResource defines the resource implementation.
+type SDNDNSResource struct {
+	client *ProxmoxClient
+}
+
+// SDNDNSResourceModel describes the resource data model.
+type SDNDNSResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	DNS           types.String `tfsdk:"dns"`
+	Type          types.String `tfsdk:"type"`
+	URL           types.String `tfsdk:"url"`
+	Key           types.String `tfsdk:"key"`
+	KeyVersion    types.Int64  `tfsdk:"key_version"`
+	TTL           types.Int64  `tfsdk:"ttl"`
+	ReverseV6Mask types.Int64  `tfsdk:"reverse_v6_mask"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
+}
+
+func (r *SDNDNSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_dns"
+}
+
+func (r *SDNDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Proxmox VE SDN DNS plugin, which registers the IPs of guests on a PowerDNS " +
+			"server. Its write-only API key requires Terraform 1.11 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the plugin name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns": schema.StringAttribute{
+				MarkdownDescription: "Name of the DNS plugin",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Plugin type, only `powerdns` is supported by Proxmox VE",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "API URL of the DNS server",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "API key of the DNS server",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"key_version": secretVersionAttribute("API key"),
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "TTL of the created records in seconds",
+				Optional:            true,
+			},
+			"reverse_v6_mask": schema.Int64Attribute{
+				MarkdownDescription: "Prefix length of the IPv6 reverse zones",
+				Optional:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 fingerprint of the certificate of the DNS server, for self-signed certificates",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SDNDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SDNDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SDNDNSResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var key types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &key)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("dns", data.DNS)
+	params.setString("type", data.Type)
+	params.setString("key", key)
+
+	if err := r.client.Post(ctx, "/cluster/sdn/dns", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SDN DNS %s, got error: %s", data.DNS.ValueString(), err))
+		return
+	}
+
+	data.ID = data.DNS
+
+	tflog.Trace(ctx, "created SDN DNS", map[string]interface{}{"dns": data.DNS.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SDNDNSResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var dns map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &dns)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SDN DNS %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.DNS = data.ID
+	data.Type = stringValue(dns, "type")
+	data.URL = stringValue(dns, "url")
+	data.TTL = int64Value(dns, "ttl")
+	data.ReverseV6Mask = int64Value(dns, "reversemaskv6")
+	data.Fingerprint = stringValue(dns, "fingerprint")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SDNDNSResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var key types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &key)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := data.params(true)
+	if secretVersionChanged(data.KeyVersion, state.KeyVersion) {
+		params.setString("key", key)
+	}
+
+	if err := r.client.Put(ctx, r.path(data.DNS.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SDN DNS %s, got error: %s", data.DNS.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SDNDNSResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.DNS.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SDN DNS %s, got error: %s", data.DNS.ValueString(), err))
+		return
+	}
+}
+
+func (r *SDNDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SDNDNSResource) path(dns string) string {
+	return "/cluster/sdn/dns/" + url.PathEscape(dns)
+}
+
+// params returns the API parameters of the plugin settings. When update is
+// set, settings missing from the configuration are removed.
+func (m SDNDNSResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+	params.setString("url", m.URL)
+
+	setString, setInt64 := params.setString, params.setInt64
+	if update {
+		setString, setInt64 = params.updateString, params.updateInt64
+	}
+	setInt64("ttl", m.TTL)
+	setInt64("reversemaskv6", m.ReverseV6Mask)
+	setString("fingerprint", m.Fingerprint)
+
+	return params
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSDNDNSResource(t *testing.T) {
+	powerDNSURL := testAccRequireEnv(t, "PROXMOX_POWERDNS_URL")
+	powerDNSKey := testAccRequireEnv(t, "PROXMOX_POWERDNS_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSDNDNSResourceConfig(powerDNSURL, powerDNSKey, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_dns.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_sdn_dns.test", "ttl", "3600"),
+					resource.TestCheckNoResourceAttr("proxmox_sdn_dns.test", "key"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_sdn_dns.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSDNDNSResourceConfig(powerDNSURL, powerDNSKey, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_dns.test", "ttl", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSDNDNSResourceConfig(url, key string, ttl int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_sdn_dns" "test" {
+  dns  = "tfacc"
+  type = "powerdns"
+  url  = %[1]q
+  key  = %[2]q
+  ttl  = %[3]d
+}
+`, url, key, ttl)
+}
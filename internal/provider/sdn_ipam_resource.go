@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SDNIPAMResource{}
+var _ resource.ResourceWithImportState = &SDNIPAMResource{}
+var _ resource.ResourceWithValidateConfig = &SDNIPAMResource{}
+
+func NewSDNIPAMResource() resource.Resource {
+	return &SDNIPAMResource{}
+}
+
+// SDNIPAMResource defines the resource implementation.
+type SDNIPAMResource struct {
+	client *ProxmoxClient
+}
+
+// SDNIPAMResourceModel describes the resource data model.
+type SDNIPAMResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	IPAM         types.String `tfsdk:"ipam"`
+	Type         types.String `tfsdk:"type"`
+	URL          types.String `tfsdk:"url"`
+	Token        types.String `tfsdk:"token"`
+	TokenVersion types.Int64  `tfsdk:"token_version"`
+	Section      types.Int64  `tfsdk:"section"`
+}
+
+func (r *SDNIPAMResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_ipam"
+}
+
+func (r *SDNIPAMResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Proxmox VE SDN IPAM plugin, either the built-in IPAM or an external " +
+			"phpIPAM or NetBox instance. The API token of external IPAMs is write-only and requires Terraform " +
+			"1.11 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the plugin name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipam": schema.StringAttribute{
+				MarkdownDescription: "Name of the IPAM plugin",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Plugin type, one of `pve`, `phpipam` or `netbox`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "API URL of the external IPAM. Required for `phpipam` and `netbox`",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "API token of the external IPAM. Required for `phpipam` and `netbox`",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"token_version": secretVersionAttribute("API token"),
+			"section": schema.Int64Attribute{
+				MarkdownDescription: "phpIPAM section the subnets are managed in. Required for `phpipam`",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SDNIPAMResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SDNIPAMResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SDNIPAMResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	switch data.Type.ValueString() {
+	case "pve":
+		if !data.URL.IsNull() || !data.Token.IsNull() || !data.Section.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Attribute Combination",
+				"The url, token and section attributes are only valid for external IPAM plugins.",
+			)
+		}
+	case "phpipam", "netbox":
+		if data.URL.IsNull() || data.Token.IsNull() {
+			resp.Diagnostics.AddError(
+				"Missing Required Attribute",
+				fmt.Sprintf("The url and token attributes are required for IPAM plugins of type %s.", data.Type.ValueString()),
+			)
+		}
+		if data.Type.ValueString() == "phpipam" && data.Section.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("section"), "Missing Required Attribute", "The section attribute is required for phpIPAM plugins.")
+		}
+		if data.Type.ValueString() == "netbox" && !data.Section.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("section"), "Invalid Attribute Combination", "The section attribute is only valid for phpIPAM plugins.")
+		}
+	}
+}
+
+func (r *SDNIPAMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SDNIPAMResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := apiParams{}
+	params.setString("ipam", data.IPAM)
+	params.setString("type", data.Type)
+	params.setString("url", data.URL)
+	params.setString("token", token)
+	params.setInt64("section", data.Section)
+
+	if err := r.client.Post(ctx, "/cluster/sdn/ipams", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SDN IPAM %s, got error: %s", data.IPAM.ValueString(), err))
+		return
+	}
+
+	data.ID = data.IPAM
+
+	tflog.Trace(ctx, "created SDN IPAM", map[string]interface{}{"ipam": data.IPAM.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNIPAMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SDNIPAMResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var ipam map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &ipam)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SDN IPAM %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.IPAM = data.ID
+	data.Type = stringValue(ipam, "type")
+	data.URL = stringValue(ipam, "url")
+	data.Section = int64Value(ipam, "section")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNIPAMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SDNIPAMResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var token types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	params := apiParams{}
+	params.setString("url", data.URL)
+	if data.Type.ValueString() == "phpipam" {
+		params.setInt64("section", data.Section)
+	}
+	if secretVersionChanged(data.TokenVersion, state.TokenVersion) {
+		params.setString("token", token)
+	}
+
+	if err := r.client.Put(ctx, r.path(data.IPAM.ValueString()), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SDN IPAM %s, got error: %s", data.IPAM.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNIPAMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SDNIPAMResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.IPAM.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SDN IPAM %s, got error: %s", data.IPAM.ValueString(), err))
+		return
+	}
+}
+
+func (r *SDNIPAMResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SDNIPAMResource) path(ipam string) string {
+	return "/cluster/sdn/ipams/" + url.PathEscape(ipam)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSDNIPAMResource(t *testing.T) {
+	netboxURL := testAccRequireEnv(t, "PROXMOX_NETBOX_URL")
+	netboxToken := testAccRequireEnv(t, "PROXMOX_NETBOX_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSDNIPAMResourceConfig(netboxURL, netboxToken, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_ipam.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_sdn_ipam.test", "url", netboxURL),
+					resource.TestCheckNoResourceAttr("proxmox_sdn_ipam.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "proxmox_sdn_ipam.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSDNIPAMResourceConfig(netboxURL, netboxToken, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_ipam.test", "token_version", "2"),
+					resource.TestCheckNoResourceAttr("proxmox_sdn_ipam.test", "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSDNIPAMResourceConfig(url, token string, version int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_sdn_ipam" "test" {
+  ipam          = "tfacc"
+  type          = "netbox"
+  url           = %[1]q
+  token         = %[2]q
+  token_version = %[3]d
+}
+`, url, token, version)
+}
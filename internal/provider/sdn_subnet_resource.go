@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SDNSubnetResource{}
+var _ resource.ResourceWithImportState = &SDNSubnetResource{}
+
+func NewSDNSubnetResource() resource.Resource {
+	return &SDNSubnetResource{}
+}
+
+// SDNSubnetResource defines the resource implementation.
+type SDNSubnetResource struct {
+	client *ProxmoxClient
+}
+
+// SDNSubnetResourceModel describes the resource data model.
+type SDNSubnetResourceModel struct {
+	ID            types.String          `tfsdk:"id"`
+	VNet          types.String          `tfsdk:"vnet"`
+	CIDR          NormalizedStringValue `tfsdk:"cidr"`
+	SubnetID      types.String          `tfsdk:"subnet_id"`
+	Gateway       NormalizedStringValue `tfsdk:"gateway"`
+	SNAT          types.Bool            `tfsdk:"snat"`
+	DNSZonePrefix types.String          `tfsdk:"dns_zone_prefix"`
+	DHCPDNSServer NormalizedStringValue `tfsdk:"dhcp_dns_server"`
+	DHCPRanges    []SDNDHCPRangeModel   `tfsdk:"dhcp_range"`
+}
+
+// SDNDHCPRangeModel describes a DHCP range of a subnet.
+type SDNDHCPRangeModel struct {
+	StartAddress NormalizedStringValue `tfsdk:"start_address"`
+	EndAddress   NormalizedStringValue `tfsdk:"end_address"`
+}
+
+func (r *SDNSubnetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_subnet"
+}
+
+func (r *SDNSubnetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a subnet of a Proxmox VE SDN VNet. Changes to the SDN configuration only take " +
+			"effect once it is applied cluster wide with `proxmox_sdn_apply`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier in the `vnet/subnet_id` format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vnet": schema.StringAttribute{
+				MarkdownDescription: "Name of the VNet",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "Subnet in CIDR notation",
+				CustomType:          cidrType,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the subnet assigned by Proxmox VE, derived from the zone and CIDR",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Gateway address of the subnet",
+				CustomType:          ipAddressType,
+				Optional:            true,
+			},
+			"snat": schema.BoolAttribute{
+				MarkdownDescription: "Enable source NAT for traffic leaving the subnet",
+				Optional:            true,
+			},
+			"dns_zone_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix added to the DNS zone of the SDN zone for records of this subnet",
+				Optional:            true,
+			},
+			"dhcp_dns_server": schema.StringAttribute{
+				MarkdownDescription: "DNS server announced by DHCP",
+				CustomType:          ipAddressType,
+				Optional:            true,
+			},
+			"dhcp_range": schema.ListNestedAttribute{
+				MarkdownDescription: "Address ranges handed out by the DHCP server of the zone",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_address": schema.StringAttribute{
+							MarkdownDescription: "First address of the range",
+							CustomType:          ipAddressType,
+							Required:            true,
+						},
+						"end_address": schema.StringAttribute{
+							MarkdownDescription: "Last address of the range",
+							CustomType:          ipAddressType,
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SDNSubnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SDNSubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SDNSubnetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vnet := data.VNet.ValueString()
+	params := data.params(false)
+	params["type"] = "subnet"
+	params.setString("subnet", data.CIDR.StringValue)
+
+	if err := r.client.Post(ctx, r.subnetsPath(vnet), params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subnet %s in VNet %s, got error: %s", data.CIDR.ValueString(), vnet, err))
+		return
+	}
+
+	// The subnet identifier is derived from the zone of the VNet, so it is
+	// looked up instead of being computed here.
+	var subnets []map[string]interface{}
+	if err := r.client.Get(ctx, r.subnetsPath(vnet), &subnets); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subnets of VNet %s, got error: %s", vnet, err))
+		return
+	}
+	for _, subnet := range subnets {
+		// Proxmox VE stores the CIDR in its canonical form.
+		if equal, _ := data.CIDR.StringSemanticEquals(ctx, cidrType.value(stringValue(subnet, "cidr"))); equal {
+			data.SubnetID = stringValue(subnet, "subnet")
+		}
+	}
+	if data.SubnetID.IsNull() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find subnet %s in VNet %s after creating it", data.CIDR.ValueString(), vnet))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(vnet, data.SubnetID.ValueString()))
+
+	tflog.Trace(ctx, "created SDN subnet", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNSubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SDNSubnetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	parts, err := parseID(data.ID.ValueString(), 2, "vnet/subnet_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Resource Identifier", err.Error())
+		return
+	}
+
+	var subnet map[string]interface{}
+	err = r.client.Get(ctx, r.subnetsPath(parts[0])+"/"+url.PathEscape(parts[1]), &subnet)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SDN subnet %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.VNet = types.StringValue(parts[0])
+	data.SubnetID = types.StringValue(parts[1])
+	data.CIDR = cidrType.value(stringValue(subnet, "cidr"))
+	data.Gateway = ipAddressType.value(stringValue(subnet, "gateway"))
+	data.SNAT = boolValue(subnet, "snat")
+	data.DNSZonePrefix = stringValue(subnet, "dnszoneprefix")
+	data.DHCPDNSServer = ipAddressType.value(stringValue(subnet, "dhcp-dns-server"))
+	data.DHCPRanges = newSDNDHCPRangeModels(subnet["dhcp-range"])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNSubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SDNSubnetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SDN subnet %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNSubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SDNSubnetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SDN subnet %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *SDNSubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SDNSubnetResource) subnetsPath(vnet string) string {
+	return "/cluster/sdn/vnets/" + url.PathEscape(vnet) + "/subnets"
+}
+
+func (r *SDNSubnetResource) path(data SDNSubnetResourceModel) string {
+	return r.subnetsPath(data.VNet.ValueString()) + "/" + url.PathEscape(data.SubnetID.ValueString())
+}
+
+// params returns the API parameters of the subnet settings. When update is
+// set, settings missing from the configuration are removed.
+func (m SDNSubnetResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+
+	setString, setBool := params.setString, params.setBool
+	if update {
+		setString, setBool = params.updateString, params.updateBool
+	}
+	setString("gateway", m.Gateway.StringValue)
+	setBool("snat", m.SNAT)
+	setString("dnszoneprefix", m.DNSZonePrefix)
+	setString("dhcp-dns-server", m.DHCPDNSServer.StringValue)
+
+	if len(m.DHCPRanges) > 0 {
+		ranges := make([]string, len(m.DHCPRanges))
+		for i, dhcpRange := range m.DHCPRanges {
+			var props propertyString
+			props.addString("start-address", dhcpRange.StartAddress.StringValue)
+			props.addString("end-address", dhcpRange.EndAddress.StringValue)
+			ranges[i] = props.String()
+		}
+		params["dhcp-range"] = ranges
+	} else if update {
+		params.remove("dhcp-range")
+	}
+
+	return params
+}
+
+// newSDNDHCPRangeModels converts the DHCP ranges returned by the API, which
+// are either property strings or already parsed objects.
+func newSDNDHCPRangeModels(value interface{}) []SDNDHCPRangeModel {
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil
+	}
+
+	ranges := make([]SDNDHCPRangeModel, 0, len(items))
+	for _, item := range items {
+		var props map[string]string
+		switch item := item.(type) {
+		case string:
+			props = parsePropertyString(item, "start-address")
+		case map[string]interface{}:
+			props = map[string]string{}
+			for key := range item {
+				props[key] = stringValue(item, key).ValueString()
+			}
+		default:
+			continue
+		}
+		ranges = append(ranges, SDNDHCPRangeModel{
+			StartAddress: ipAddressType.value(propertyStringValue(props, "start-address")),
+			EndAddress:   ipAddressType.value(propertyStringValue(props, "end-address")),
+		})
+	}
+	return ranges
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDNSubnetResource(t *testing.T) {
+	vnet := testAccRequireEnv(t, "PROXMOX_SDN_VNET")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSDNSubnetResourceConfig(vnet, "198.51.100.200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_subnet.test", "vnet", vnet),
+					resource.TestCheckResourceAttrSet("proxmox_sdn_subnet.test", "subnet_id"),
+					resource.TestCheckResourceAttr("proxmox_sdn_subnet.test", "gateway", "198.51.100.1"),
+					resource.TestCheckResourceAttr("proxmox_sdn_subnet.test", "dhcp_range.0.end_address", "198.51.100.200"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_sdn_subnet.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSDNSubnetResourceConfig(vnet, "198.51.100.150"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_subnet.test", "dhcp_range.0.end_address", "198.51.100.150"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSDNSubnetResourceConfig(vnet, rangeEnd string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_sdn_subnet" "test" {
+  vnet    = %[1]q
+  cidr    = "198.51.100.0/24"
+  gateway = "198.51.100.1"
+  snat    = true
+
+  dhcp_range = [{
+    start_address = "198.51.100.100"
+    end_address   = %[2]q
+  }]
+}
+`, vnet, rangeEnd)
+}
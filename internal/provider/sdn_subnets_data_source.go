@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SDNSubnetsDataSource{}
+
+func NewSDNSubnetsDataSource() datasource.DataSource {
+	return &SDNSubnetsDataSource{}
+}
+
+// SDNSubnetsDataSource defines the data source implementation.
+type SDNSubnetsDataSource struct {
+	client *ProxmoxClient
+}
+
+// SDNSubnetsDataSourceModel describes the data source data model.
+type SDNSubnetsDataSourceModel struct {
+	ID      types.String          `tfsdk:"id"`
+	VNet    types.String          `tfsdk:"vnet"`
+	Subnets []SDNSubnetStateModel `tfsdk:"subnets"`
+}
+
+// SDNSubnetStateModel describes a subnet of an SDN VNet and whether it is
+// applied.
+type SDNSubnetStateModel struct {
+	SubnetID      types.String        `tfsdk:"subnet_id"`
+	CIDR          types.String        `tfsdk:"cidr"`
+	Gateway       types.String        `tfsdk:"gateway"`
+	SNAT          types.Bool          `tfsdk:"snat"`
+	DNSZonePrefix types.String        `tfsdk:"dns_zone_prefix"`
+	DHCPDNSServer types.String        `tfsdk:"dhcp_dns_server"`
+	DHCPRanges    []SDNDHCPRangeModel `tfsdk:"dhcp_ranges"`
+	State         types.String        `tfsdk:"state"`
+	Applied       types.Bool          `tfsdk:"applied"`
+}
+
+func (d *SDNSubnetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_subnets"
+}
+
+func (d *SDNSubnetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the subnets of a Proxmox VE SDN VNet. The settings include pending changes, " +
+			"`applied` tells whether they are already in effect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier, equal to the VNet name",
+				Computed:            true,
+			},
+			"vnet": schema.StringAttribute{
+				MarkdownDescription: "Name of the VNet",
+				Required:            true,
+			},
+			"subnets": schema.ListNestedAttribute{
+				MarkdownDescription: "Subnets of the VNet",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subnet_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the subnet",
+							Computed:            true,
+						},
+						"cidr": schema.StringAttribute{
+							MarkdownDescription: "Subnet in CIDR notation",
+							Computed:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "Gateway address of the subnet",
+							Computed:            true,
+						},
+						"snat": schema.BoolAttribute{
+							MarkdownDescription: "Whether source NAT is enabled for traffic leaving the subnet",
+							Computed:            true,
+						},
+						"dns_zone_prefix": schema.StringAttribute{
+							MarkdownDescription: "Prefix added to the DNS zone of the SDN zone for records of this subnet",
+							Computed:            true,
+						},
+						"dhcp_dns_server": schema.StringAttribute{
+							MarkdownDescription: "DNS server announced by DHCP",
+							Computed:            true,
+						},
+						"dhcp_ranges": schema.ListNestedAttribute{
+							MarkdownDescription: "Address ranges handed out by the DHCP server of the zone",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start_address": schema.StringAttribute{
+										MarkdownDescription: "First address of the range",
+										CustomType:          ipAddressType,
+										Computed:            true,
+									},
+									"end_address": schema.StringAttribute{
+										MarkdownDescription: "Last address of the range",
+										CustomType:          ipAddressType,
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Pending change of the subnet, one of `new`, `changed` or `deleted`, null if " +
+								"it is applied",
+							Computed: true,
+						},
+						"applied": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subnet has no pending changes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SDNSubnetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SDNSubnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SDNSubnetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vnet := data.VNet.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox SDN subnets", map[string]interface{}{"vnet": vnet})
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/cluster/sdn/vnets/"+url.PathEscape(vnet)+"/subnets?pending=1", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subnets of VNet %s, got error: %s", vnet, err))
+		return
+	}
+
+	subnets := make([]SDNSubnetStateModel, len(entries))
+	for i, entry := range entries {
+		subnet := sdnPendingValues(entry)
+		subnets[i] = SDNSubnetStateModel{
+			SubnetID:      stringValue(subnet, "subnet"),
+			CIDR:          stringValue(subnet, "cidr"),
+			Gateway:       stringValue(subnet, "gateway"),
+			SNAT:          types.BoolValue(boolValue(subnet, "snat").ValueBool()),
+			DNSZonePrefix: stringValue(subnet, "dnszoneprefix"),
+			DHCPDNSServer: stringValue(subnet, "dhcp-dns-server"),
+			DHCPRanges:    newSDNDHCPRangeModels(subnet["dhcp-range"]),
+			State:         stringValue(entry, "state"),
+			Applied:       types.BoolValue(stringValue(entry, "state").IsNull()),
+		}
+		if subnets[i].DHCPRanges == nil {
+			subnets[i].DHCPRanges = []SDNDHCPRangeModel{}
+		}
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return strings.Compare(subnets[i].SubnetID.ValueString(), subnets[j].SubnetID.ValueString()) < 0
+	})
+
+	data.ID = data.VNet
+	data.Subnets = subnets
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d SDN subnets", len(subnets)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDNSubnetsDataSource(t *testing.T) {
+	vnet := testAccRequireEnv(t, "PROXMOX_SDN_VNET")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_sdn_subnet" "test" {
+  vnet    = %[1]q
+  cidr    = "198.51.100.0/24"
+  gateway = "198.51.100.1"
+
+  dhcp_range = [{
+    start_address = "198.51.100.100"
+    end_address   = "198.51.100.200"
+  }]
+}
+
+data "proxmox_sdn_subnets" "test" {
+  vnet = proxmox_sdn_subnet.test.vnet
+}
+`, vnet),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_sdn_subnets.test", "id", vnet),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_sdn_subnets.test", "subnets.*", map[string]string{
+						"cidr":                        "198.51.100.0/24",
+						"gateway":                     "198.51.100.1",
+						"dhcp_ranges.0.start_address": "198.51.100.100",
+						"dhcp_ranges.0.end_address":   "198.51.100.200",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SDNVNetsDataSource{}
+
+func NewSDNVNetsDataSource() datasource.DataSource {
+	return &SDNVNetsDataSource{}
+}
+
+// SDNVNetsDataSource defines the data source implementation.
+type SDNVNetsDataSource struct {
+	client *ProxmoxClient
+}
+
+// SDNVNetsDataSourceModel describes the data source data model.
+type SDNVNetsDataSourceModel struct {
+	ID    types.String        `tfsdk:"id"`
+	Zone  types.String        `tfsdk:"zone"`
+	VNets []SDNVNetStateModel `tfsdk:"vnets"`
+}
+
+// SDNVNetStateModel describes an SDN VNet and whether it is applied.
+type SDNVNetStateModel struct {
+	VNet         types.String `tfsdk:"vnet"`
+	Zone         types.String `tfsdk:"zone"`
+	Alias        types.String `tfsdk:"alias"`
+	Tag          types.Int64  `tfsdk:"tag"`
+	VLANAware    types.Bool   `tfsdk:"vlan_aware"`
+	IsolatePorts types.Bool   `tfsdk:"isolate_ports"`
+	State        types.String `tfsdk:"state"`
+	Applied      types.Bool   `tfsdk:"applied"`
+}
+
+func (d *SDNVNetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_vnets"
+}
+
+func (d *SDNVNetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Proxmox VE SDN VNets. The settings include pending changes, `applied` tells " +
+			"whether they are already in effect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Only list the VNets of this zone",
+				Optional:            true,
+			},
+			"vnets": schema.ListNestedAttribute{
+				MarkdownDescription: "SDN VNets",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vnet": schema.StringAttribute{
+							MarkdownDescription: "Name of the VNet, which is also the name of its bridge on the nodes",
+							Computed:            true,
+						},
+						"zone": schema.StringAttribute{
+							MarkdownDescription: "Zone of the VNet",
+							Computed:            true,
+						},
+						"alias": schema.StringAttribute{
+							MarkdownDescription: "Alias of the VNet",
+							Computed:            true,
+						},
+						"tag": schema.Int64Attribute{
+							MarkdownDescription: "VLAN or VXLAN ID of the VNet",
+							Computed:            true,
+						},
+						"vlan_aware": schema.BoolAttribute{
+							MarkdownDescription: "Whether guests can use VLANs inside the VNet",
+							Computed:            true,
+						},
+						"isolate_ports": schema.BoolAttribute{
+							MarkdownDescription: "Whether guests on the VNet are isolated from each other",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Pending change of the VNet, one of `new`, `changed` or `deleted`, null if " +
+								"it is applied",
+							Computed: true,
+						},
+						"applied": schema.BoolAttribute{
+							MarkdownDescription: "Whether the VNet has no pending changes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SDNVNetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SDNVNetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SDNVNetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox SDN VNets")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/cluster/sdn/vnets?pending=1", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SDN VNets, got error: %s", err))
+		return
+	}
+
+	vnets := []SDNVNetStateModel{}
+	for _, entry := range entries {
+		vnet := sdnPendingValues(entry)
+		if !data.Zone.IsNull() && stringValue(vnet, "zone").ValueString() != data.Zone.ValueString() {
+			continue
+		}
+		vnets = append(vnets, SDNVNetStateModel{
+			VNet:         stringValue(vnet, "vnet"),
+			Zone:         stringValue(vnet, "zone"),
+			Alias:        stringValue(vnet, "alias"),
+			Tag:          int64Value(vnet, "tag"),
+			VLANAware:    types.BoolValue(boolValue(vnet, "vlanaware").ValueBool()),
+			IsolatePorts: types.BoolValue(boolValue(vnet, "isolate-ports").ValueBool()),
+			State:        stringValue(entry, "state"),
+			Applied:      types.BoolValue(stringValue(entry, "state").IsNull()),
+		})
+	}
+	sort.Slice(vnets, func(i, j int) bool {
+		return strings.Compare(vnets[i].VNet.ValueString(), vnets[j].VNet.ValueString()) < 0
+	})
+
+	data.ID = types.StringValue("sdn_vnets")
+	data.VNets = vnets
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d SDN VNets", len(vnets)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
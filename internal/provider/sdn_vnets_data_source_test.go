@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDNVNetsDataSource(t *testing.T) {
+	vnet := testAccRequireEnv(t, "PROXMOX_SDN_VNET")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_sdn_vnets" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_sdn_vnets.test", "id", "sdn_vnets"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_sdn_vnets.test", "vnets.*", map[string]string{
+						"vnet": vnet,
+					}),
+				),
+			},
+			// Read testing with a zone filter
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_sdn_vnets" "test" {}
+
+data "proxmox_sdn_vnets" "zone" {
+  zone = one([for v in data.proxmox_sdn_vnets.test.vnets : v.zone if v.vnet == %[1]q])
+}
+`, vnet),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_sdn_vnets.zone", "vnets.*", map[string]string{
+						"vnet": vnet,
+					}),
+				),
+			},
+		},
+	})
+}
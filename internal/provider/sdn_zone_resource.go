@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SDNZoneResource{}
+var _ resource.ResourceWithImportState = &SDNZoneResource{}
+var _ resource.ResourceWithValidateConfig = &SDNZoneResource{}
+var _ resource.ResourceWithUpgradeState = &SDNZoneResource{}
+
+func NewSDNZoneResource() resource.Resource {
+	return &SDNZoneResource{}
+}
+
+// SDNZoneResource defines the resource implementation.
+type SDNZoneResource struct {
+	client *ProxmoxClient
+}
+
+// SDNZoneResourceModel describes the resource data model.
+type SDNZoneResourceModel struct {
+	ID                      types.String          `tfsdk:"id"`
+	Zone                    types.String          `tfsdk:"zone"`
+	Type                    types.String          `tfsdk:"type"`
+	Nodes                   types.Set             `tfsdk:"nodes"`
+	MTU                     types.Int64           `tfsdk:"mtu"`
+	IPAM                    types.String          `tfsdk:"ipam"`
+	DNS                     types.String          `tfsdk:"dns"`
+	ReverseDNS              types.String          `tfsdk:"reverse_dns"`
+	DNSZone                 types.String          `tfsdk:"dns_zone"`
+	DHCP                    types.String          `tfsdk:"dhcp"`
+	Bridge                  types.String          `tfsdk:"bridge"`
+	Tag                     types.Int64           `tfsdk:"tag"`
+	VLANProtocol            types.String          `tfsdk:"vlan_protocol"`
+	Peers                   types.Set             `tfsdk:"peers"`
+	Controller              types.String          `tfsdk:"controller"`
+	VRFVXLAN                types.Int64           `tfsdk:"vrf_vxlan"`
+	MAC                     NormalizedStringValue `tfsdk:"mac"`
+	ExitNodes               types.Set             `tfsdk:"exit_nodes"`
+	ExitNodesPrimary        types.String          `tfsdk:"exit_nodes_primary"`
+	ExitNodesLocalRouting   types.Bool            `tfsdk:"exit_nodes_local_routing"`
+	AdvertiseSubnets        types.Bool            `tfsdk:"advertise_subnets"`
+	DisableARPNDSuppression types.Bool            `tfsdk:"disable_arp_nd_suppression"`
+	RTImport                types.Set             `tfsdk:"rt_import"`
+}
+
+// sdnZoneOption is a zone option that is only valid for some zone types.
+type sdnZoneOption struct {
+	attribute string
+	key       string
+	zoneTypes []string
+}
+
+// sdnZoneTypeOptions lists the type-specific zone options.
+var sdnZoneTypeOptions = []sdnZoneOption{
+	{"dhcp", "dhcp", []string{"simple"}},
+	{"bridge", "bridge", []string{"vlan", "qinq"}},
+	{"tag", "tag", []string{"qinq"}},
+	{"vlan_protocol", "vlan-protocol", []string{"qinq"}},
+	{"peers", "peers", []string{"vxlan"}},
+	{"controller", "controller", []string{"evpn"}},
+	{"vrf_vxlan", "vrf-vxlan", []string{"evpn"}},
+	{"mac", "mac", []string{"evpn"}},
+	{"exit_nodes", "exitnodes", []string{"evpn"}},
+	{"exit_nodes_primary", "exitnodes-primary", []string{"evpn"}},
+	{"exit_nodes_local_routing", "exitnodes-local-routing", []string{"evpn"}},
+	{"advertise_subnets", "advertise-subnets", []string{"evpn"}},
+	{"disable_arp_nd_suppression", "disable-arp-nd-suppression", []string{"evpn"}},
+	{"rt_import", "rt-import", []string{"evpn"}},
+}
+
+// sdnZoneRequiredAttributes lists the attributes required by each zone type.
+var sdnZoneRequiredAttributes = map[string][]string{
+	"vlan":  {"bridge"},
+	"qinq":  {"bridge", "tag"},
+	"vxlan": {"peers"},
+	"evpn":  {"controller", "vrf_vxlan"},
+}
+
+func (r *SDNZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_zone"
+}
+
+func (r *SDNZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 turned rt_import from a comma separated string into a set.
+		Version: 1,
+
+		MarkdownDescription: "Manages a Proxmox VE SDN zone. Changes to the SDN configuration only take effect once " +
+			"it is applied cluster wide with `proxmox_sdn_apply`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the zone name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone, up to 8 alphanumeric characters",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Zone type, one of `simple`, `vlan`, `qinq`, `vxlan` or `evpn`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nodes": schema.SetAttribute{
+				MarkdownDescription: "Nodes the zone is deployed on. Defaults to all nodes",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "MTU of the zone",
+				Optional:            true,
+			},
+			"ipam": schema.StringAttribute{
+				MarkdownDescription: "IPAM plugin to use for the zone",
+				Optional:            true,
+			},
+			"dns": schema.StringAttribute{
+				MarkdownDescription: "DNS plugin to use for the zone",
+				Optional:            true,
+			},
+			"reverse_dns": schema.StringAttribute{
+				MarkdownDescription: "DNS plugin to use for reverse DNS records",
+				Optional:            true,
+			},
+			"dns_zone": schema.StringAttribute{
+				MarkdownDescription: "DNS domain of the zone",
+				Optional:            true,
+				Validators:          []validator.String{validators.DNSName()},
+			},
+			"dhcp": schema.StringAttribute{
+				MarkdownDescription: "DHCP backend of the zone, only `dnsmasq` is supported. Only valid for `simple` zones",
+				Optional:            true,
+			},
+			"bridge": schema.StringAttribute{
+				MarkdownDescription: "Local bridge or OVS switch the zone is attached to. Required for `vlan` and `qinq` zones",
+				Optional:            true,
+				Validators:          []validator.String{validators.BridgeName()},
+			},
+			"tag": schema.Int64Attribute{
+				MarkdownDescription: "Service VLAN tag. Required for `qinq` zones",
+				Optional:            true,
+			},
+			"vlan_protocol": schema.StringAttribute{
+				MarkdownDescription: "Service VLAN protocol of `qinq` zones, `802.1q` or `802.1ad`",
+				Optional:            true,
+			},
+			"peers": schema.SetAttribute{
+				MarkdownDescription: "IP addresses of the VXLAN peers. Required for `vxlan` zones",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"controller": schema.StringAttribute{
+				MarkdownDescription: "EVPN controller. Required for `evpn` zones",
+				Optional:            true,
+			},
+			"vrf_vxlan": schema.Int64Attribute{
+				MarkdownDescription: "VXLAN ID of the VRF used for routing between the VNets. Required for `evpn` zones",
+				Optional:            true,
+			},
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "Anycast MAC address of the VNet gateways of `evpn` zones",
+				CustomType:          macAddressType,
+				Optional:            true,
+			},
+			"exit_nodes": schema.SetAttribute{
+				MarkdownDescription: "Nodes routing traffic of `evpn` zones to the outside",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"exit_nodes_primary": schema.StringAttribute{
+				MarkdownDescription: "Exit node used for all outgoing traffic of `evpn` zones",
+				Optional:            true,
+			},
+			"exit_nodes_local_routing": schema.BoolAttribute{
+				MarkdownDescription: "Allow the exit nodes of `evpn` zones to reach the guests themselves",
+				Optional:            true,
+			},
+			"advertise_subnets": schema.BoolAttribute{
+				MarkdownDescription: "Advertise the full subnets of `evpn` zones, for silent guests",
+				Optional:            true,
+			},
+			"disable_arp_nd_suppression": schema.BoolAttribute{
+				MarkdownDescription: "Disable ARP and ND suppression in `evpn` zones",
+				Optional:            true,
+			},
+			"rt_import": schema.SetAttribute{
+				MarkdownDescription: "Route targets to import into `evpn` zones (e.g., `65000:100`)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SDNZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SDNZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var zoneType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &zoneType)...)
+
+	if resp.Diagnostics.HasError() || zoneType.IsNull() || zoneType.IsUnknown() {
+		return
+	}
+
+	isSet := func(name string) bool {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		return value != nil && !value.IsNull()
+	}
+
+	for _, option := range sdnZoneTypeOptions {
+		if !slices.Contains(option.zoneTypes, zoneType.ValueString()) && isSet(option.attribute) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(option.attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The %s attribute is only valid for zones of type %s.", option.attribute, strings.Join(option.zoneTypes, ", ")),
+			)
+		}
+	}
+
+	for _, name := range sdnZoneRequiredAttributes[zoneType.ValueString()] {
+		if !isSet(name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Required Attribute",
+				fmt.Sprintf("The %s attribute is required for zones of type %s.", name, zoneType.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *SDNZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SDNZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.params(false)
+	params.setString("zone", data.Zone)
+	params.setString("type", data.Type)
+
+	if err := r.client.Post(ctx, "/cluster/sdn/zones", params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SDN zone %s, got error: %s", data.Zone.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Zone
+
+	tflog.Trace(ctx, "created SDN zone", map[string]interface{}{"zone": data.Zone.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SDNZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	var zone map[string]interface{}
+	err := r.client.Get(ctx, r.path(data.ID.ValueString()), &zone)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SDN zone %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Zone = data.ID
+	data.Type = stringValue(zone, "type")
+	data.Nodes = stringSetValue(zone, "nodes")
+	data.MTU = int64Value(zone, "mtu")
+	data.IPAM = stringValue(zone, "ipam")
+	data.DNS = stringValue(zone, "dns")
+	data.ReverseDNS = stringValue(zone, "reversedns")
+	data.DNSZone = stringValue(zone, "dnszone")
+	data.DHCP = stringValue(zone, "dhcp")
+	data.Bridge = stringValue(zone, "bridge")
+	data.Tag = int64Value(zone, "tag")
+	data.VLANProtocol = stringValue(zone, "vlan-protocol")
+	data.Peers = stringSetValue(zone, "peers")
+	data.Controller = stringValue(zone, "controller")
+	data.VRFVXLAN = int64Value(zone, "vrf-vxlan")
+	data.MAC = macAddressType.value(stringValue(zone, "mac"))
+	data.ExitNodes = stringSetValue(zone, "exitnodes")
+	data.ExitNodesPrimary = stringValue(zone, "exitnodes-primary")
+	data.ExitNodesLocalRouting = boolValue(zone, "exitnodes-local-routing")
+	data.AdvertiseSubnets = boolValue(zone, "advertise-subnets")
+	data.DisableARPNDSuppression = boolValue(zone, "disable-arp-nd-suppression")
+	data.RTImport = stringSetValue(zone, "rt-import")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SDNZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if err := r.client.Put(ctx, r.path(data.Zone.ValueString()), data.params(true), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SDN zone %s, got error: %s", data.Zone.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SDNZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SDNZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Delete(ctx, r.path(data.Zone.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SDN zone %s, got error: %s", data.Zone.ValueString(), err))
+		return
+	}
+}
+
+func (r *SDNZoneResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: priorSchema(current.Schema, 0, map[string]schema.Attribute{
+				"rt_import": schema.StringAttribute{Optional: true},
+			}),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var rtImport types.String
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rt_import"), &rtImport)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The string has the format returned by the API, so it is
+				// converted the same way as when reading the zone.
+				resp.Diagnostics.Append(upgradeState(ctx, *req.State, &resp.State, map[string]attr.Value{
+					"rt_import": stringSetValue(map[string]interface{}{"rt-import": rtImport.ValueString()}, "rt-import"),
+				})...)
+			},
+		},
+	}
+}
+
+func (r *SDNZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SDNZoneResource) path(zone string) string {
+	return "/cluster/sdn/zones/" + url.PathEscape(zone)
+}
+
+// params returns the API parameters of the zone settings. When update is
+// set, settings missing from the configuration are removed.
+func (m SDNZoneResourceModel) params(update bool) apiParams {
+	params := apiParams{}
+
+	setString, setInt64, setBool, setStringSet := params.setString, params.setInt64, params.setBool, params.setStringSet
+	if update {
+		setString, setInt64, setBool, setStringSet = params.updateString, params.updateInt64, params.updateBool, params.updateStringSet
+	}
+
+	setStringSet("nodes", m.Nodes)
+	setInt64("mtu", m.MTU)
+	setString("ipam", m.IPAM)
+	setString("dns", m.DNS)
+	setString("reversedns", m.ReverseDNS)
+	setString("dnszone", m.DNSZone)
+	setString("dhcp", m.DHCP)
+	setString("bridge", m.Bridge)
+	setInt64("tag", m.Tag)
+	setString("vlan-protocol", m.VLANProtocol)
+	setStringSet("peers", m.Peers)
+	setString("controller", m.Controller)
+	setInt64("vrf-vxlan", m.VRFVXLAN)
+	setString("mac", m.MAC.StringValue)
+	setStringSet("exitnodes", m.ExitNodes)
+	setString("exitnodes-primary", m.ExitNodesPrimary)
+	setBool("exitnodes-local-routing", m.ExitNodesLocalRouting)
+	setBool("advertise-subnets", m.AdvertiseSubnets)
+	setBool("disable-arp-nd-suppression", m.DisableARPNDSuppression)
+	setStringSet("rt-import", m.RTImport)
+
+	// Options of other zone types are unknown to the API and cannot be
+	// removed, so they are dropped from the delete list.
+	if deleted, ok := params["delete"].(string); ok {
+		delete(params, "delete")
+		for _, key := range strings.Split(deleted, ",") {
+			if slices.ContainsFunc(sdnZoneTypeOptions, func(option sdnZoneOption) bool {
+				return option.key == key && !slices.Contains(option.zoneTypes, m.Type.ValueString())
+			}) {
+				continue
+			}
+			params.remove(key)
+		}
+	}
+
+	return params
+}
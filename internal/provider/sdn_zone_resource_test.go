@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDNZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSDNZoneResourceConfig(1450),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_zone.test", "id", "tfacc"),
+					resource.TestCheckResourceAttr("proxmox_sdn_zone.test", "type", "vxlan"),
+					resource.TestCheckResourceAttr("proxmox_sdn_zone.test", "mtu", "1450"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "proxmox_sdn_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSDNZoneResourceConfig(1400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_sdn_zone.test", "mtu", "1400"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSDNZoneResourceConfig(mtu int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_sdn_zone" "test" {
+  zone  = "tfacc"
+  type  = "vxlan"
+  peers = ["192.0.2.1", "192.0.2.2"]
+  mtu   = %[1]d
+}
+`, mtu)
+}
+
+func TestSDNZoneParams(t *testing.T) {
+	zone := SDNZoneResourceModel{
+		Type:   types.StringValue("vlan"),
+		Bridge: types.StringValue("vmbr0"),
+	}
+
+	params := zone.params(true)
+	if params["bridge"] != "vmbr0" {
+		t.Errorf("expected bridge to be set, got %v", params["bridge"])
+	}
+	if got, want := params["delete"], "nodes,mtu,ipam,dns,reversedns,dnszone"; got != want {
+		t.Errorf("got delete %q, want %q", got, want)
+	}
+}
+
+func TestSDNZoneUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	upgrader := (&SDNZoneResource{}).UpgradeState(ctx)[0]
+
+	stateType := upgrader.PriorSchema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range stateType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "evpn1")
+	values["zone"] = tftypes.NewValue(tftypes.String, "evpn1")
+	values["type"] = tftypes.NewValue(tftypes.String, "evpn")
+	values["mac"] = tftypes.NewValue(tftypes.String, "BC:24:11:AA:BB:CC")
+	values["exit_nodes"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "pve1"),
+	})
+	values["rt_import"] = tftypes.NewValue(tftypes.String, "65000:100,65000:200")
+
+	var current fwresource.SchemaResponse
+	(&SDNZoneResource{}).Schema(ctx, fwresource.SchemaRequest{}, &current)
+
+	req := fwresource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: tftypes.NewValue(stateType, values)},
+	}
+	resp := fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: current.Schema}}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data SDNZoneResourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	wantRTImport := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("65000:100"), types.StringValue("65000:200")})
+	if !data.RTImport.Equal(wantRTImport) {
+		t.Errorf("got rt_import %s, want %s", data.RTImport, wantRTImport)
+	}
+	if data.Zone.ValueString() != "evpn1" || data.MAC.ValueString() != "BC:24:11:AA:BB:CC" || len(data.ExitNodes.Elements()) != 1 {
+		t.Errorf("other attributes were not preserved: %+v", data)
+	}
+	if !data.Peers.IsNull() {
+		t.Errorf("expected null peers, got %s", data.Peers)
+	}
+}
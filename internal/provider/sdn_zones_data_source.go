@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SDNZonesDataSource{}
+
+func NewSDNZonesDataSource() datasource.DataSource {
+	return &SDNZonesDataSource{}
+}
+
+// SDNZonesDataSource defines the data source implementation.
+type SDNZonesDataSource struct {
+	client *ProxmoxClient
+}
+
+// SDNZonesDataSourceModel describes the data source data model.
+type SDNZonesDataSourceModel struct {
+	ID    types.String        `tfsdk:"id"`
+	Zones []SDNZoneStateModel `tfsdk:"zones"`
+}
+
+// SDNZoneStateModel describes an SDN zone and whether it is applied.
+type SDNZoneStateModel struct {
+	Zone    types.String `tfsdk:"zone"`
+	Type    types.String `tfsdk:"type"`
+	Nodes   []string     `tfsdk:"nodes"`
+	MTU     types.Int64  `tfsdk:"mtu"`
+	IPAM    types.String `tfsdk:"ipam"`
+	DNS     types.String `tfsdk:"dns"`
+	DHCP    types.String `tfsdk:"dhcp"`
+	Bridge  types.String `tfsdk:"bridge"`
+	State   types.String `tfsdk:"state"`
+	Applied types.Bool   `tfsdk:"applied"`
+}
+
+func (d *SDNZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_zones"
+}
+
+func (d *SDNZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Proxmox VE SDN zones. The settings include pending changes, `applied` tells " +
+			"whether they are already in effect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "SDN zones",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone": schema.StringAttribute{
+							MarkdownDescription: "Name of the zone",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Zone type, one of `simple`, `vlan`, `qinq`, `vxlan` or `evpn`",
+							Computed:            true,
+						},
+						"nodes": schema.ListAttribute{
+							MarkdownDescription: "Nodes the zone is deployed on, empty for all nodes",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the zone",
+							Computed:            true,
+						},
+						"ipam": schema.StringAttribute{
+							MarkdownDescription: "IPAM plugin of the zone",
+							Computed:            true,
+						},
+						"dns": schema.StringAttribute{
+							MarkdownDescription: "DNS plugin of the zone",
+							Computed:            true,
+						},
+						"dhcp": schema.StringAttribute{
+							MarkdownDescription: "DHCP backend of the zone",
+							Computed:            true,
+						},
+						"bridge": schema.StringAttribute{
+							MarkdownDescription: "Bridge of `vlan` and `qinq` zones",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Pending change of the zone, one of `new`, `changed` or `deleted`, null if " +
+								"it is applied",
+							Computed: true,
+						},
+						"applied": schema.BoolAttribute{
+							MarkdownDescription: "Whether the zone has no pending changes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SDNZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SDNZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SDNZonesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox SDN zones")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/cluster/sdn/zones?pending=1", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SDN zones, got error: %s", err))
+		return
+	}
+
+	zones := make([]SDNZoneStateModel, len(entries))
+	for i, entry := range entries {
+		zone := sdnPendingValues(entry)
+		zones[i] = SDNZoneStateModel{
+			Zone:    stringValue(zone, "zone"),
+			Type:    stringValue(zone, "type"),
+			Nodes:   splitList(stringValue(zone, "nodes").ValueString()),
+			MTU:     int64Value(zone, "mtu"),
+			IPAM:    stringValue(zone, "ipam"),
+			DNS:     stringValue(zone, "dns"),
+			DHCP:    stringValue(zone, "dhcp"),
+			Bridge:  stringValue(zone, "bridge"),
+			State:   stringValue(entry, "state"),
+			Applied: types.BoolValue(stringValue(entry, "state").IsNull()),
+		}
+	}
+	sort.Slice(zones, func(i, j int) bool {
+		return strings.Compare(zones[i].Zone.ValueString(), zones[j].Zone.ValueString()) < 0
+	})
+
+	data.ID = types.StringValue("sdn_zones")
+	data.Zones = zones
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d SDN zones", len(zones)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sdnPendingValues returns the settings of an SDN object listed with
+// pending=1, with the pending values replacing the applied ones.
+func sdnPendingValues(entry map[string]interface{}) map[string]interface{} {
+	values := map[string]interface{}{}
+	for key, value := range entry {
+		values[key] = value
+	}
+	if pending, ok := entry["pending"].(map[string]interface{}); ok {
+		for key, value := range pending {
+			values[key] = value
+		}
+	}
+	return values
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDNZonesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+resource "proxmox_sdn_zone" "test" {
+  zone  = "tfacc"
+  type  = "vxlan"
+  peers = ["192.0.2.1", "192.0.2.2"]
+  mtu   = 1450
+}
+
+data "proxmox_sdn_zones" "test" {
+  depends_on = [proxmox_sdn_zone.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_sdn_zones.test", "id", "sdn_zones"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_sdn_zones.test", "zones.*", map[string]string{
+						"zone":    "tfacc",
+						"type":    "vxlan",
+						"mtu":     "1450",
+						"state":   "new",
+						"applied": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestSDNPendingValues(t *testing.T) {
+	entry := map[string]interface{}{
+		"zone":    "dmz",
+		"mtu":     float64(1500),
+		"state":   "changed",
+		"pending": map[string]interface{}{"mtu": float64(1450)},
+	}
+
+	values := sdnPendingValues(entry)
+	if got := int64Value(values, "mtu").ValueInt64(); got != 1450 {
+		t.Errorf("got mtu %d, want the pending 1450", got)
+	}
+	if got := stringValue(values, "zone").ValueString(); got != "dmz" {
+		t.Errorf("got zone %q", got)
+	}
+	if got := int64Value(entry, "mtu").ValueInt64(); got != 1500 {
+		t.Errorf("entry was modified, got mtu %d", got)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// This file contains the helpers for upgrading the state of resources whose
+// schema changed incompatibly. Such changes increment the schema version and
+// add a resource.StateUpgrader from every prior version to the current one,
+// so existing state keeps working without importing the objects again.
+// Upgraders usually convert a few attributes and copy all others.
+
+// priorSchema returns the schema of a prior version that differs from the
+// current schema only in the given attributes. Once other attributes change
+// in a later version, prior schemas have to be spelled out instead.
+func priorSchema(current schema.Schema, version int64, attributes map[string]schema.Attribute) *schema.Schema {
+	prior := current
+	prior.Version = version
+	prior.Attributes = maps.Clone(current.Attributes)
+	maps.Copy(prior.Attributes, attributes)
+	return &prior
+}
+
+// upgradeState sets the current state to the prior state, with the values of
+// the converted attributes replaced. Attributes that are new in the current
+// schema are null.
+func upgradeState(ctx context.Context, prior tfsdk.State, current *tfsdk.State, converted map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var values map[string]tftypes.Value
+	if err := prior.Raw.As(&values); err != nil {
+		diags.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to read the prior state: %s", err))
+		return diags
+	}
+
+	stateType := current.Schema.Type().TerraformType(ctx)
+	objectType, ok := stateType.(tftypes.Object)
+	if !ok {
+		diags.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unexpected state type %s", stateType))
+		return diags
+	}
+
+	upgraded := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := converted[name]; ok {
+			tfValue, err := value.ToTerraformValue(ctx)
+			if err != nil {
+				diags.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to convert %s: %s", name, err))
+				return diags
+			}
+			upgraded[name] = tfValue
+			continue
+		}
+		if value, ok := values[name]; ok && value.Type().Equal(attributeType) {
+			upgraded[name] = value
+			continue
+		}
+		upgraded[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	current.Raw = tftypes.NewValue(stateType, upgraded)
+	return diags
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StorageStatusDataSource{}
+
+func NewStorageStatusDataSource() datasource.DataSource {
+	return &StorageStatusDataSource{}
+}
+
+// StorageStatusDataSource defines the data source implementation.
+type StorageStatusDataSource struct {
+	client *ProxmoxClient
+}
+
+// StorageStatusDataSourceModel describes the data source data model.
+type StorageStatusDataSourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	Node      types.String  `tfsdk:"node"`
+	Storage   types.String  `tfsdk:"storage"`
+	Type      types.String  `tfsdk:"type"`
+	Content   []string      `tfsdk:"content"`
+	Active    types.Bool    `tfsdk:"active"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Shared    types.Bool    `tfsdk:"shared"`
+	Total     types.Int64   `tfsdk:"total"`
+	Used      types.Int64   `tfsdk:"used"`
+	Available types.Int64   `tfsdk:"available"`
+	UsedRatio types.Float64 `tfsdk:"used_ratio"`
+}
+
+func (d *StorageStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_status"
+}
+
+func (d *StorageStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the status and usage of a storage on a Proxmox VE node, e.g. to avoid placing " +
+			"disks on nearly full storages.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier in the `node/storage` format",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Required:            true,
+			},
+			"storage": schema.StringAttribute{
+				MarkdownDescription: "Name of the storage",
+				Required:            true,
+				Validators:          []validator.String{validators.StorageID()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the storage (e.g., `dir`, `lvmthin` or `nfs`)",
+				Computed:            true,
+			},
+			"content": schema.ListAttribute{
+				MarkdownDescription: "Content types allowed on the storage, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the storage is active on the node",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the storage is enabled",
+				Computed:            true,
+			},
+			"shared": schema.BoolAttribute{
+				MarkdownDescription: "Whether the storage is shared between nodes",
+				Computed:            true,
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Total size in bytes",
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "Used space in bytes",
+				Computed:            true,
+			},
+			"available": schema.Int64Attribute{
+				MarkdownDescription: "Available space in bytes",
+				Computed:            true,
+			},
+			"used_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Used share of the total size between `0` and `1`, null if the size is unknown",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *StorageStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StorageStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, storage := data.Node.ValueString(), data.Storage.ValueString()
+
+	tflog.Debug(ctx, "Reading Proxmox storage status", map[string]interface{}{"node": node, "storage": storage})
+
+	var status map[string]interface{}
+	err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/storage/"+url.PathEscape(storage)+"/status", &status)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status of storage %s on node %s, got error: %s", storage, node, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, storage))
+	data.Type = stringValue(status, "type")
+	data.Content = splitList(stringValue(status, "content").ValueString())
+	sort.Strings(data.Content)
+	data.Active = types.BoolValue(boolValue(status, "active").ValueBool())
+	data.Enabled = types.BoolValue(boolValue(status, "enabled").ValueBool())
+	data.Shared = types.BoolValue(boolValue(status, "shared").ValueBool())
+	data.Total = int64Value(status, "total")
+	data.Used = int64Value(status, "used")
+	data.Available = int64Value(status, "avail")
+	data.UsedRatio = types.Float64Null()
+	if total := data.Total.ValueInt64(); total > 0 {
+		data.UsedRatio = types.Float64Value(float64(data.Used.ValueInt64()) / float64(total))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
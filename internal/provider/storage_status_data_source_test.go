@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStorageStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_storage_status" "test" {
+  node    = %[1]q
+  storage = "local"
+}
+`, testNode()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_storage_status.test", "id", testNode()+"/local"),
+					resource.TestCheckResourceAttr("data.proxmox_storage_status.test", "type", "dir"),
+					resource.TestCheckResourceAttr("data.proxmox_storage_status.test", "active", "true"),
+					resource.TestCheckResourceAttrSet("data.proxmox_storage_status.test", "total"),
+					resource.TestCheckResourceAttrSet("data.proxmox_storage_status.test", "used_ratio"),
+				),
+			},
+		},
+	})
+}
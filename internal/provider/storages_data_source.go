@@ -5,10 +5,9 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,20 +30,29 @@ type StoragesDataSource struct {
 // StoragesDataSourceModel describes the data source data model.
 type StoragesDataSourceModel struct {
 	ID       types.String   `tfsdk:"id"`
+	Filters  []FilterModel  `tfsdk:"filter"`
 	Storages []StorageModel `tfsdk:"storages"`
 }
 
 // StorageModel describes a single storage entry.
 type StorageModel struct {
-	Storage      types.String `tfsdk:"storage"`
-	Type         types.String `tfsdk:"type"`
-	Content      types.String `tfsdk:"content"`
-	Path         types.String `tfsdk:"path"`
-	Priority     types.Int64  `tfsdk:"priority"`
-	Digest       types.String `tfsdk:"digest"`
-	PruneBackups types.String `tfsdk:"prune_backups"`
+	Storage      types.String      `tfsdk:"storage"`
+	Type         types.String      `tfsdk:"type"`
+	Content      types.String      `tfsdk:"content"`
+	Path         types.String      `tfsdk:"path"`
+	Priority     types.Int64       `tfsdk:"priority"`
+	Digest       types.String      `tfsdk:"digest"`
+	PruneBackups types.String      `tfsdk:"prune_backups"`
+	Nodes        []string          `tfsdk:"nodes"`
+	Shared       types.Bool        `tfsdk:"shared"`
+	Disable      types.Bool        `tfsdk:"disable"`
+	Options      map[string]string `tfsdk:"options"`
 }
 
+// storageAttributeKeys are the keys of /storage entries that have their own
+// attributes in StorageModel. All others are type-specific options.
+var storageAttributeKeys = []string{"storage", "type", "content", "path", "priority", "digest", "prune-backups", "nodes", "shared", "disable"}
+
 func (d *StoragesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_storages"
 }
@@ -91,10 +99,33 @@ func (d *StoragesDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 							MarkdownDescription: "Prune backups configuration",
 							Computed:            true,
 						},
+						"nodes": schema.ListAttribute{
+							MarkdownDescription: "Nodes the storage is available on, sorted. Null if it is available on all nodes",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"shared": schema.BoolAttribute{
+							MarkdownDescription: "Whether all nodes access the same data on the storage, e.g. on NFS or Ceph",
+							Computed:            true,
+						},
+						"disable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the storage is disabled",
+							Computed:            true,
+						},
+						"options": schema.MapAttribute{
+							MarkdownDescription: "Other options of the storage, which depend on its type, by their API names " +
+								"(e.g., `server` and `export` of NFS storages or `vgname` of LVM storages)",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("storages"),
+		},
 	}
 }
 
@@ -126,41 +157,15 @@ func (d *StoragesDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	tflog.Debug(ctx, "Reading Proxmox storages")
 
-	// Make API request to get storages
-	httpResp, err := d.client.DoRequest("GET", "/storage", nil)
-	if err != nil {
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/storage", &entries); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storages, got error: %s", err))
 		return
 	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to read storages, got status %d: %s", httpResp.StatusCode, string(body)),
-		)
-		return
-	}
-
-	body, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read response body: %s", err))
-		return
-	}
-
-	var storageResponse struct {
-		Data []map[string]interface{} `json:"data"`
-	}
-
-	if err := json.Unmarshal(body, &storageResponse); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return
-	}
 
 	// Convert response to model
-	storages := make([]StorageModel, len(storageResponse.Data))
-	for i, storageData := range storageResponse.Data {
+	storages := make([]StorageModel, len(entries))
+	for i, storageData := range entries {
 		storage := StorageModel{}
 
 		if val, ok := storageData["storage"].(string); ok {
@@ -205,9 +210,32 @@ func (d *StoragesDataSource) Read(ctx context.Context, req datasource.ReadReques
 			storage.PruneBackups = types.StringNull()
 		}
 
+		if nodes := splitList(stringValue(storageData, "nodes").ValueString()); len(nodes) > 0 {
+			sort.Strings(nodes)
+			storage.Nodes = nodes
+		}
+		storage.Shared = types.BoolValue(boolValue(storageData, "shared").ValueBool())
+		storage.Disable = types.BoolValue(boolValue(storageData, "disable").ValueBool())
+
+		storage.Options = map[string]string{}
+		for key := range storageData {
+			if slices.Contains(storageAttributeKeys, key) {
+				continue
+			}
+			if value := stringValue(storageData, key); !value.IsNull() {
+				storage.Options[key] = value.ValueString()
+			}
+		}
+
 		storages[i] = storage
 	}
 
+	storages, diags := applyFilters(data.Filters, storages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Storages = storages
 	data.ID = types.StringValue("storages")
 
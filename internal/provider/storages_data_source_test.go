@@ -6,6 +6,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -64,3 +65,57 @@ func testTokenSecret() string {
 	}
 	return tokenSecret
 }
+
+func TestStoragesDataSourceRead(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddStorage("local-lvm", "lvmthin", map[string]interface{}{"thinpool": "data", "vgname": "pve"})
+	h.Fake.AddStorage("nfs", "nfs", map[string]interface{}{
+		"server": "10.0.0.2", "export": "/srv", "path": "/mnt/pve/nfs", "content": "backup",
+		"nodes": "pve2,pve", "shared": 1, "disable": 1,
+	})
+
+	data, err := h.readDataSource("proxmox_storages", map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "type", "values": []interface{}{"dir", "nfs"}}},
+	})
+	if err != nil {
+		t.Fatalf("unable to read storages: %s", err)
+	}
+
+	storages := data["storages"].([]interface{})
+	if len(storages) != 2 {
+		t.Fatalf("expected 2 storages, got %v", storages)
+	}
+	for i, want := range []map[string]interface{}{
+		{"storage": "local", "type": "dir", "path": "/var/lib/vz", "content": "iso,vztmpl,backup"},
+		{"storage": "nfs", "type": "nfs", "path": "/mnt/pve/nfs", "content": "backup"},
+	} {
+		storage := storages[i].(map[string]interface{})
+		for key, value := range want {
+			if storage[key] != value {
+				t.Errorf("storage %d: expected %s %q, got %v", i, key, value, storage[key])
+			}
+		}
+	}
+
+	local, nfs := storages[0].(map[string]interface{}), storages[1].(map[string]interface{})
+	if local["nodes"] != nil || local["shared"] != false || local["disable"] != false || len(local["options"].(map[string]interface{})) != 0 {
+		t.Errorf("expected the local storage on all nodes without options, got %v", local)
+	}
+	if !reflect.DeepEqual(nfs["nodes"], []interface{}{"pve", "pve2"}) || nfs["shared"] != true || nfs["disable"] != true {
+		t.Errorf("expected the NFS storage on pve and pve2, shared and disabled, got %v", nfs)
+	}
+	if want := map[string]interface{}{"server": "10.0.0.2", "export": "/srv"}; !reflect.DeepEqual(nfs["options"], want) {
+		t.Errorf("expected NFS options %v, got %v", want, nfs["options"])
+	}
+
+	// Storages can be filtered on the new attributes like on all others.
+	data, err = h.readDataSource("proxmox_storages", map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "nodes", "values": []interface{}{"pve2"}}},
+	})
+	if err != nil {
+		t.Fatalf("unable to read storages: %s", err)
+	}
+	if storages := data["storages"].([]interface{}); len(storages) != 1 || storages[0].(map[string]interface{})["storage"] != "nfs" {
+		t.Errorf("expected only the NFS storage on pve2, got %v", storages)
+	}
+}
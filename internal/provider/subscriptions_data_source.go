@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubscriptionsDataSource{}
+
+func NewSubscriptionsDataSource() datasource.DataSource {
+	return &SubscriptionsDataSource{}
+}
+
+// SubscriptionsDataSource defines the data source implementation.
+type SubscriptionsDataSource struct {
+	client *ProxmoxClient
+}
+
+// SubscriptionsDataSourceModel describes the data source data model.
+type SubscriptionsDataSourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	AllActive     types.Bool          `tfsdk:"all_active"`
+	Subscriptions []SubscriptionModel `tfsdk:"subscriptions"`
+}
+
+// SubscriptionModel describes the subscription of a node.
+type SubscriptionModel struct {
+	Node        types.String `tfsdk:"node"`
+	Online      types.Bool   `tfsdk:"online"`
+	Status      types.String `tfsdk:"status"`
+	Active      types.Bool   `tfsdk:"active"`
+	Level       types.String `tfsdk:"level"`
+	ProductName types.String `tfsdk:"product_name"`
+	Sockets     types.Int64  `tfsdk:"sockets"`
+	NextDueDate types.String `tfsdk:"next_due_date"`
+	ServerID    types.String `tfsdk:"server_id"`
+}
+
+func (d *SubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+func (d *SubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the subscription status of all Proxmox VE cluster nodes. The subscription of " +
+			"offline nodes cannot be read, their details are null.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"all_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether all nodes have an active subscription. Offline nodes count as inactive",
+				Computed:            true,
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				MarkdownDescription: "Subscriptions of the nodes",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Name of the node",
+							Computed:            true,
+						},
+						"online": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node is online",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Subscription status (e.g., `active`, `notfound`, `expired` or `invalid`)",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subscription is active",
+							Computed:            true,
+						},
+						"level": schema.StringAttribute{
+							MarkdownDescription: "Subscription level, `c` (Community), `b` (Basic), `s` (Standard) or " +
+								"`p` (Premium)",
+							Computed: true,
+						},
+						"product_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the subscription product",
+							Computed:            true,
+						},
+						"sockets": schema.Int64Attribute{
+							MarkdownDescription: "Number of CPU sockets covered by the subscription",
+							Computed:            true,
+						},
+						"next_due_date": schema.StringAttribute{
+							MarkdownDescription: "Next due date of the subscription in the `YYYY-MM-DD` format",
+							Computed:            true,
+						},
+						"server_id": schema.StringAttribute{
+							MarkdownDescription: "Server ID the subscription is bound to",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubscriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox subscriptions")
+
+	var entries []map[string]interface{}
+	if err := d.client.GetCached(ctx, "/nodes", &entries); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read nodes, got error: %s", err))
+		return
+	}
+
+	allActive := true
+	subscriptions := make([]SubscriptionModel, len(entries))
+	for i, entry := range entries {
+		node := stringValue(entry, "node").ValueString()
+		subscriptions[i] = SubscriptionModel{
+			Node:        types.StringValue(node),
+			Online:      types.BoolValue(stringValue(entry, "status").ValueString() == "online"),
+			Status:      types.StringNull(),
+			Active:      types.BoolValue(false),
+			Level:       types.StringNull(),
+			ProductName: types.StringNull(),
+			Sockets:     types.Int64Null(),
+			NextDueDate: types.StringNull(),
+			ServerID:    types.StringNull(),
+		}
+		if !subscriptions[i].Online.ValueBool() {
+			allActive = false
+			continue
+		}
+
+		var subscription map[string]interface{}
+		if err := d.client.Get(ctx, "/nodes/"+url.PathEscape(node)+"/subscription", &subscription); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subscription of node %s, got error: %s", node, err))
+			return
+		}
+
+		status := strings.ToLower(stringValue(subscription, "status").ValueString())
+		subscriptions[i].Status = types.StringValue(status)
+		subscriptions[i].Active = types.BoolValue(status == "active")
+		subscriptions[i].Level = stringValue(subscription, "level")
+		subscriptions[i].ProductName = stringValue(subscription, "productname")
+		subscriptions[i].Sockets = int64Value(subscription, "sockets")
+		subscriptions[i].NextDueDate = stringValue(subscription, "nextduedate")
+		subscriptions[i].ServerID = stringValue(subscription, "serverid")
+		allActive = allActive && status == "active"
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return strings.Compare(subscriptions[i].Node.ValueString(), subscriptions[j].Node.ValueString()) < 0
+	})
+
+	data.ID = types.StringValue("subscriptions")
+	data.AllActive = types.BoolValue(allActive)
+	data.Subscriptions = subscriptions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
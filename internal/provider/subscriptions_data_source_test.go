@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_subscriptions" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_subscriptions.test", "id", "subscriptions"),
+					resource.TestCheckResourceAttrSet("data.proxmox_subscriptions.test", "all_active"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_subscriptions.test", "subscriptions.*", map[string]string{
+						"node":   testNode(),
+						"online": "true",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+// This file contains the sweepers that delete objects left behind by failed
+// acceptance tests, so they do not pile up in shared lab clusters. Objects
+// belong to tests if their name starts with testAccPrefix, guests also if
+// they are tagged with it or use an ID between testAccMinVMID and
+// testAccMaxVMID. The sweepers run against the cluster of the PROXMOX_*
+// environment variables with `go test ./internal/provider -v -sweep=all`.
+// The region is ignored, as all nodes of the cluster are swept.
+
+// testAccPrefix starts the names of objects created by acceptance tests.
+const testAccPrefix = "tfacc"
+
+// Guest IDs reserved for acceptance tests.
+const (
+	testAccMinVMID = 990
+	testAccMaxVMID = 999
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("proxmox_vm", &resource.Sweeper{
+		Name: "proxmox_vm",
+		F: func(region string) error {
+			return sweepWithClient(func(ctx context.Context, client *ProxmoxClient) error {
+				return sweepGuests(ctx, client, guestTypeVM)
+			})
+		},
+	})
+	resource.AddTestSweepers("proxmox_lxc", &resource.Sweeper{
+		Name: "proxmox_lxc",
+		F: func(region string) error {
+			return sweepWithClient(func(ctx context.Context, client *ProxmoxClient) error {
+				return sweepGuests(ctx, client, guestTypeLXC)
+			})
+		},
+	})
+	// Storages may hold the disks of test guests, which are destroyed first.
+	resource.AddTestSweepers("proxmox_storage", &resource.Sweeper{
+		Name:         "proxmox_storage",
+		Dependencies: []string{"proxmox_vm", "proxmox_lxc"},
+		F: func(region string) error {
+			return sweepWithClient(sweepStorages)
+		},
+	})
+	resource.AddTestSweepers("proxmox_user", &resource.Sweeper{
+		Name: "proxmox_user",
+		F: func(region string) error {
+			return sweepWithClient(sweepUsers)
+		},
+	})
+}
+
+// sweepWithClient calls sweep with a client for the cluster of the
+// acceptance tests.
+func sweepWithClient(sweep func(ctx context.Context, client *ProxmoxClient) error) error {
+	for _, name := range []string{"PROXMOX_ENDPOINT", "PROXMOX_TOKEN_ID", "PROXMOX_TOKEN_SECRET"} {
+		if os.Getenv(name) == "" {
+			return fmt.Errorf("%s environment variable must be set for sweepers", name)
+		}
+	}
+
+	client := &ProxmoxClient{
+		HTTPClient: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}},
+		Endpoint:    testEndpoint(),
+		TokenID:     testTokenID(),
+		TokenSecret: testTokenSecret(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+	return sweep(ctx, client)
+}
+
+// isTestGuest reports whether a guest of the cluster resource list was
+// created by acceptance tests.
+func isTestGuest(entry map[string]interface{}) bool {
+	vmID := int64Value(entry, "vmid").ValueInt64()
+	if vmID >= testAccMinVMID && vmID <= testAccMaxVMID {
+		return true
+	}
+	if strings.HasPrefix(stringValue(entry, "name").ValueString(), testAccPrefix) {
+		return true
+	}
+	return slices.Contains(splitList(stringValue(entry, "tags").ValueString()), testAccPrefix)
+}
+
+// sweepGuests stops and destroys the test guests of a type, including their
+// disks. Failures are collected so that one stuck guest does not keep the
+// others.
+func sweepGuests(ctx context.Context, client *ProxmoxClient, guestType string) error {
+	var entries []map[string]interface{}
+	if err := client.Get(ctx, "/cluster/resources?type=vm", &entries); err != nil {
+		return fmt.Errorf("unable to list guests: %w", err)
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if stringValue(entry, "type").ValueString() != guestType || !isTestGuest(entry) {
+			continue
+		}
+
+		node, vmID := stringValue(entry, "node").ValueString(), int64Value(entry, "vmid").ValueInt64()
+		guest := guestPath(guestType, node, vmID)
+		log.Printf("[INFO] Destroying %s %d on node %s", guestLabel(guestType), vmID, node)
+
+		var err error
+		if stringValue(entry, "status").ValueString() == "running" {
+			var upid string
+			err = client.Post(ctx, guest+"/status/stop", nil, &upid)
+			if err == nil {
+				err = client.waitForTask(ctx, upid)
+			}
+		}
+		if err == nil {
+			var upid string
+			err = client.Delete(ctx, guest+"?purge=1&destroy-unreferenced-disks=1", &upid)
+			if err == nil {
+				err = client.waitForTask(ctx, upid)
+			}
+		}
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to destroy %s %d: %w", guestLabel(guestType), vmID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweepStorages removes the test storages from the cluster configuration.
+// The data on the storages is kept.
+func sweepStorages(ctx context.Context, client *ProxmoxClient) error {
+	var entries []map[string]interface{}
+	if err := client.Get(ctx, "/storage", &entries); err != nil {
+		return fmt.Errorf("unable to list storages: %w", err)
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		storage := stringValue(entry, "storage").ValueString()
+		if !strings.HasPrefix(storage, testAccPrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Removing storage %s", storage)
+		if err := client.Delete(ctx, "/storage/"+url.PathEscape(storage), nil); err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to remove storage %s: %w", storage, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweepUsers deletes the test users together with their API tokens.
+func sweepUsers(ctx context.Context, client *ProxmoxClient) error {
+	var entries []map[string]interface{}
+	if err := client.Get(ctx, "/access/users", &entries); err != nil {
+		return fmt.Errorf("unable to list users: %w", err)
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		userID := stringValue(entry, "userid").ValueString()
+		if !strings.HasPrefix(userID, testAccPrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting user %s", userID)
+		if err := client.Delete(ctx, "/access/users/"+url.PathEscape(userID), nil); err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to delete user %s: %w", userID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func TestSweepers(t *testing.T) {
+	fake := fakeproxmox.New(t)
+	fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 100, map[string]interface{}{"name": "web"})
+	fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 101, map[string]interface{}{"name": "web", "tags": "prod;tfacc"})
+	fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 990, map[string]interface{}{"name": "restored"})
+	fake.AddGuest(fakeproxmox.LXC, fakeproxmox.Node, 102, map[string]interface{}{"hostname": "tfacc-ct"})
+	fake.AddGuest(fakeproxmox.LXC, fakeproxmox.Node, 103, map[string]interface{}{"hostname": "ct"})
+	fake.AddStorage("tfacc-nfs", "nfs", map[string]interface{}{"server": "10.0.0.2", "export": "/srv"})
+	fake.AddUser("tfacc-user@pve")
+	fake.AddUser("alice@pve")
+
+	client := &ProxmoxClient{
+		HTTPClient:       fake.Client(),
+		Endpoint:         fake.URL,
+		TokenID:          fakeproxmox.TokenID,
+		TokenSecret:      fakeproxmox.TokenSecret,
+		taskPollInterval: time.Millisecond,
+	}
+
+	// Running guests are stopped before they are destroyed.
+	var upid string
+	if err := client.Post(context.Background(), "/nodes/pve/qemu/990/status/start", nil, &upid); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.waitForTask(context.Background(), upid); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sweep := range []func(context.Context, *ProxmoxClient) error{
+		func(ctx context.Context, client *ProxmoxClient) error { return sweepGuests(ctx, client, guestTypeVM) },
+		func(ctx context.Context, client *ProxmoxClient) error { return sweepGuests(ctx, client, guestTypeLXC) },
+		sweepStorages,
+		sweepUsers,
+	} {
+		if err := sweep(context.Background(), client); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	for vmID, kept := range map[int64]bool{100: true, 101: false, 990: false, 102: false, 103: true} {
+		if _, _, ok := fake.Guest(vmID); ok != kept {
+			t.Errorf("guest %d: expected kept %t, got %t", vmID, kept, ok)
+		}
+	}
+
+	var storages []map[string]interface{}
+	if err := client.Get(context.Background(), "/storage", &storages); err != nil {
+		t.Fatal(err)
+	}
+	if len(storages) != 1 || storages[0]["storage"] != "local" {
+		t.Errorf("expected only the local storage to be kept, got %v", storages)
+	}
+
+	var users []map[string]interface{}
+	if err := client.Get(context.Background(), "/access/users", &users); err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users[0]["userid"] != "alice@pve" || users[1]["userid"] != "root@pam" {
+		t.Errorf("expected only the other users to be kept, got %v", users)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultTaskPollInterval is the interval in which the status of running
+// tasks is checked, unless the provider configures another one.
+const defaultTaskPollInterval = 2 * time.Second
+
+// pollInterval returns the interval in which the status of running tasks is
+// checked.
+func (c *ProxmoxClient) pollInterval() time.Duration {
+	if c.taskPollInterval > 0 {
+		return c.taskPollInterval
+	}
+	return defaultTaskPollInterval
+}
+
+// parseUPIDNode returns the node a task is running on. Task identifiers
+// (UPIDs) have the format UPID:node:pid:pstart:starttime:type:id:user:.
+func parseUPIDNode(upid string) (string, error) {
+	parts := strings.Split(upid, ":")
+	if len(parts) < 8 || parts[0] != "UPID" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected task identifier %q", upid)
+	}
+	return parts[1], nil
+}
+
+// waitForTask blocks until the task has finished and returns an error if it
+// did not finish successfully. Tasks that finished with warnings, such as
+// backups of guests with unreadable files, are successful. The error
+// contains the last lines of the task log, which usually explain the
+// failure. The task timeout of the provider, if any, applies in addition to
+// the deadline of ctx.
+func (c *ProxmoxClient) waitForTask(ctx context.Context, upid string) error {
+	node, err := parseUPIDNode(upid)
+	if err != nil {
+		return err
+	}
+
+	// Tasks change guests and their status while they run, so responses
+	// cached before or during the task are outdated once it ended.
+	defer c.responses.invalidate()
+
+	if c.taskTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.taskTimeout)
+		defer cancel()
+	}
+
+	taskPath := "/nodes/" + url.PathEscape(node) + "/tasks/" + url.PathEscape(upid)
+
+	for {
+		var status struct {
+			Status     string `json:"status"`
+			ExitStatus string `json:"exitstatus"`
+		}
+		if err := c.Get(ctx, taskPath+"/status", &status); err != nil {
+			return fmt.Errorf("unable to read status of task %s: %w", upid, err)
+		}
+
+		if status.Status == "stopped" {
+			if status.ExitStatus == "OK" {
+				return nil
+			}
+			if warnings, ok := strings.CutPrefix(status.ExitStatus, "WARNINGS:"); ok {
+				tflog.Warn(ctx, "task finished with warnings", map[string]interface{}{
+					"upid":     upid,
+					"warnings": strings.TrimSpace(warnings),
+				})
+				return nil
+			}
+			return fmt.Errorf("task %s failed: %s%s", upid, status.ExitStatus, c.taskLogTail(ctx, taskPath))
+		}
+
+		tflog.Trace(ctx, "waiting for task", map[string]interface{}{"upid": upid})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for task %s: %w", upid, ctx.Err())
+		case <-time.After(c.pollInterval()):
+		}
+	}
+}
+
+// taskLogTail returns the last lines of a task log formatted for inclusion
+// in an error message, or nothing if the log cannot be read.
+func (c *ProxmoxClient) taskLogTail(ctx context.Context, taskPath string) string {
+	var lines []struct {
+		T string `json:"t"`
+	}
+	if err := c.Get(ctx, taskPath+"/log?limit=10000", &lines); err != nil || len(lines) == 0 {
+		return ""
+	}
+
+	if len(lines) > 10 {
+		lines = lines[len(lines)-10:]
+	}
+
+	var log strings.Builder
+	for _, line := range lines {
+		log.WriteString("\n  " + line.T)
+	}
+	return log.String()
+}
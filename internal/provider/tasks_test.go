@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseUPIDNode(t *testing.T) {
+	node, err := parseUPIDNode("UPID:pve1:0001A2B3:0C4D5E6F:65A1B2C3:srvreload:networking:root@pam:")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if node != "pve1" {
+		t.Errorf("expected node pve1, got %q", node)
+	}
+
+	for _, upid := range []string{"", "pve1", "UPID::1:2:3:type:id:user:", "TASK:pve1:1:2:3:type:id:user:"} {
+		if _, err := parseUPIDNode(upid); err == nil {
+			t.Errorf("expected error for %q", upid)
+		}
+	}
+}
+
+func TestWaitForTaskSettings(t *testing.T) {
+	var polls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls.Add(1)
+		fmt.Fprint(w, `{"data":{"status":"running"}}`)
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{
+		HTTPClient:       server.Client(),
+		Endpoint:         server.URL,
+		taskPollInterval: 10 * time.Millisecond,
+		taskTimeout:      100 * time.Millisecond,
+	}
+
+	err := client.waitForTask(context.Background(), "UPID:pve1:0001A2B3:0C4D5E6F:65A1B2C3:vzdump:100:root@pam:")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the task timeout to expire, got %v", err)
+	}
+	if got := polls.Load(); got < 5 {
+		t.Errorf("expected the configured poll interval to be used, got %d polls", got)
+	}
+}
+
+func TestWaitForTaskExitStatus(t *testing.T) {
+	for exitStatus, wantErr := range map[string]bool{
+		"OK":                      false,
+		"WARNINGS: 2":             false,
+		"unable to create CT 100": true,
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"status":"stopped","exitstatus":%q}}`, exitStatus)
+		}))
+
+		client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL}
+		err := client.waitForTask(context.Background(), "UPID:pve1:0001A2B3:0C4D5E6F:65A1B2C3:vzdump:100:root@pam:")
+		if (err != nil) != wantErr {
+			t.Errorf("exit status %q: expected error %t, got %v", exitStatus, wantErr, err)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// operationTimeouts are the default timeouts of the long-running operations
+// of a resource. Only operations with a default can be configured in the
+// timeouts block.
+type operationTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// opts returns the options of the timeouts block, which is decoded into a
+// timeouts.Value field tagged `tfsdk:"timeouts"`. The descriptions document
+// the defaults.
+func (t operationTimeouts) opts() timeouts.Opts {
+	return timeouts.Opts{
+		Create:            t.Create > 0,
+		Update:            t.Update > 0,
+		Delete:            t.Delete > 0,
+		CreateDescription: timeoutDescription("create", t.Create),
+		UpdateDescription: timeoutDescription("update", t.Update),
+		DeleteDescription: timeoutDescription("delete", t.Delete),
+	}
+}
+
+func timeoutDescription(operation string, timeout time.Duration) string {
+	return fmt.Sprintf("Timeout of the %s operation as duration (e.g., `30s`, `10m` or `2h`). Defaults to `%s`", operation, formatTimeout(timeout))
+}
+
+// formatTimeout formats a duration without zero units, e.g. "1h" instead of
+// "1h0m0s".
+func formatTimeout(timeout time.Duration) string {
+	formatted := timeout.String()
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
+
+// parseTimeout parses a positive duration such as "30m" or "1h30m".
+func parseTimeout(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q, expected e.g. 30s, 10m or 2h", value)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("duration %q must be positive", value)
+	}
+	return timeout, nil
+}
+
+// timeoutValidator validates durations of provider and resource settings
+// during planning, so that invalid durations do not fail an apply halfway.
+type timeoutValidator struct{}
+
+func (v timeoutValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration"
+}
+
+func (v timeoutValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeoutValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parseTimeout(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", err.Error())
+	}
+}
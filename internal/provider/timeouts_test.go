@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatTimeout(t *testing.T) {
+	for timeout, want := range map[time.Duration]string{
+		30 * time.Second: "30s",
+		5 * time.Minute:  "5m",
+		time.Hour:        "1h",
+		90 * time.Minute: "1h30m",
+		90 * time.Second: "1m30s",
+	} {
+		if got := formatTimeout(timeout); got != want {
+			t.Errorf("%d: got %q, want %q", timeout, got, want)
+		}
+	}
+}
+
+func TestOperationTimeoutsOpts(t *testing.T) {
+	opts := operationTimeouts{Create: time.Hour, Delete: 10 * time.Minute}.opts()
+	if !opts.Create || opts.Update || !opts.Delete || opts.Read {
+		t.Errorf("expected create and delete timeouts only, got %+v", opts)
+	}
+	if !strings.HasSuffix(opts.CreateDescription, "Defaults to `1h`") || !strings.HasSuffix(opts.DeleteDescription, "Defaults to `10m`") {
+		t.Errorf("expected the defaults in the descriptions, got %q and %q", opts.CreateDescription, opts.DeleteDescription)
+	}
+}
+
+func TestParseTimeout(t *testing.T) {
+	if got, err := parseTimeout("1h30m"); err != nil || got != 90*time.Minute {
+		t.Errorf("got %s, %v, want 1h30m", got, err)
+	}
+	for _, value := range []string{"soon", "0s", "-5m"} {
+		if _, err := parseTimeout(value); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/pveapi"
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserPasswordResource{}
+var _ resource.ResourceWithImportState = &UserPasswordResource{}
+
+func NewUserPasswordResource() resource.Resource {
+	return &UserPasswordResource{}
+}
+
+// UserPasswordResource defines the resource implementation.
+type UserPasswordResource struct {
+	client *ProxmoxClient
+}
+
+// UserPasswordResourceModel describes the resource data model.
+type UserPasswordResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	UserID               types.String `tfsdk:"user_id"`
+	Password             types.String `tfsdk:"password"`
+	PasswordVersion      types.Int64  `tfsdk:"password_version"`
+	ConfirmationPassword types.String `tfsdk:"confirmation_password"`
+}
+
+func (r *UserPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_password"
+}
+
+func (r *UserPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the password of an existing Proxmox VE user without storing it in the Terraform " +
+			"state, which requires Terraform 1.11 or later. Change `password_version` to rotate the password.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier, equal to the user ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User ID in the `user@realm` format",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "New password of the user",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators:          []validator.String{validators.APIParameter("PUT /access/password", "password")},
+			},
+			"password_version": secretVersionAttribute("password"),
+			"confirmation_password": schema.StringAttribute{
+				MarkdownDescription: "Current password of the user owning the API token of the provider. Proxmox VE 8 " +
+					"requires it to change passwords unless the change is made as `root@pam`, which API tokens never are",
+				Optional:   true,
+				Sensitive:  true,
+				WriteOnly:  true,
+				Validators: []validator.String{validators.APIParameter("PUT /access/password", "confirmation-password")},
+			},
+		},
+	}
+}
+
+func (r *UserPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Write-only values are only available in the configuration.
+	var config UserPasswordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setPassword(ctx, config); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set password of user %s, got error: %s", data.UserID.ValueString(), err))
+		return
+	}
+
+	data.ID = data.UserID
+
+	tflog.Trace(ctx, "set user password", map[string]interface{}{"user": data.UserID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPasswordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	err := r.client.Get(ctx, "/access/users/"+url.PathEscape(data.ID.ValueString()), nil)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.UserID = data.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var config UserPasswordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withResourceID(ctx, data.ID)
+
+	if secretVersionChanged(data.PasswordVersion, state.PasswordVersion) {
+		if err := r.setPassword(ctx, config); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set password of user %s, got error: %s", data.UserID.ValueString(), err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Passwords cannot be unset, so removing the resource only drops it from
+	// the Terraform state.
+}
+
+func (r *UserPasswordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setPassword sets the password of the user with the write-only values of
+// the configuration.
+func (r *UserPasswordResource) setPassword(ctx context.Context, config UserPasswordResourceModel) error {
+	return r.client.Put(ctx, "/access/password", pveapi.ChangePasswordRequest{
+		UserID:               config.UserID.ValueString(),
+		Password:             config.Password.ValueString(),
+		ConfirmationPassword: optionalString(config.ConfirmationPassword),
+	}, nil)
+}
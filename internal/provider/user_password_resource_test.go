@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+func TestAccUserPasswordResource(t *testing.T) {
+	user := testAccRequireEnv(t, "PROXMOX_USER")
+	confirmation := testAccRequireEnv(t, "PROXMOX_TOKEN_OWNER_PASSWORD")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserPasswordResourceConfig(user, "Initial-Passw0rd", 1, confirmation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_user_password.test", "id", user),
+					resource.TestCheckNoResourceAttr("proxmox_user_password.test", "password"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserPasswordResourceConfig(user, "Rotated-Passw0rd", 2, confirmation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("proxmox_user_password.test", "password_version", "2"),
+					resource.TestCheckNoResourceAttr("proxmox_user_password.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserPasswordResourceConfig(user, password string, version int, confirmation string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "proxmox_user_password" "test" {
+  user_id               = %[1]q
+  password              = %[2]q
+  password_version      = %[3]d
+  confirmation_password = %[4]q
+}
+`, user, password, version, confirmation)
+}
+
+func TestUserPasswordResource(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddUser("alice@pve")
+
+	config := map[string]interface{}{
+		"user_id":          "alice@pve",
+		"password":         "Initial-Passw0rd",
+		"password_version": 1,
+	}
+	// Changes by API tokens must be confirmed with the password of their
+	// owner.
+	if _, err := h.create("proxmox_user_password", config); err == nil || !strings.Contains(err.Error(), "a password is required") {
+		t.Fatalf("expected the missing confirmation to fail, got %v", err)
+	}
+	config["confirmation_password"] = "wrong"
+	if _, err := h.create("proxmox_user_password", config); err == nil || !strings.Contains(err.Error(), "authentication failure") {
+		t.Fatalf("expected the wrong confirmation to fail, got %v", err)
+	}
+
+	config["confirmation_password"] = fakeproxmox.RootPassword
+	password, err := h.create("proxmox_user_password", config)
+	if err != nil {
+		t.Fatalf("unable to set password: %s", err)
+	}
+	if got, _ := h.Fake.Password("alice@pve"); got != "Initial-Passw0rd" {
+		t.Errorf("expected the password to be set, got %q", got)
+	}
+	if attributes := password.attributes(); attributes["password"] != nil || attributes["confirmation_password"] != nil {
+		t.Errorf("expected no passwords in the state, got %v", attributes)
+	}
+
+	// The password is only sent again when its version changes.
+	config["password"] = "Rotated-Passw0rd"
+	if err := h.update(password, config); err != nil {
+		t.Fatalf("unable to update password: %s", err)
+	}
+	if got, _ := h.Fake.Password("alice@pve"); got != "Initial-Passw0rd" {
+		t.Errorf("expected the password to be kept, got %q", got)
+	}
+	config["password_version"] = 2
+	if err := h.update(password, config); err != nil {
+		t.Fatalf("unable to rotate password: %s", err)
+	}
+	if got, _ := h.Fake.Password("alice@pve"); got != "Rotated-Passw0rd" {
+		t.Errorf("expected the password to be rotated, got %q", got)
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/pveapi"
+)
+
+// The helpers below convert loosely typed values decoded from Proxmox API
+// responses into framework values. Proxmox is inconsistent about encoding:
+// numbers are sometimes quoted and booleans are usually 0/1 integers.
+
+func stringValue(data map[string]interface{}, key string) types.String {
+	switch val := data[key].(type) {
+	case string:
+		return types.StringValue(val)
+	case float64:
+		return types.StringValue(strconv.FormatFloat(val, 'f', -1, 64))
+	case bool:
+		return types.StringValue(strconv.FormatBool(val))
+	}
+	return types.StringNull()
+}
+
+func int64Value(data map[string]interface{}, key string) types.Int64 {
+	switch val := data[key].(type) {
+	case float64:
+		return types.Int64Value(int64(val))
+	case string:
+		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+	return types.Int64Null()
+}
+
+func float64Value(data map[string]interface{}, key string) types.Float64 {
+	switch val := data[key].(type) {
+	case float64:
+		return types.Float64Value(val)
+	case string:
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return types.Float64Value(f)
+		}
+	}
+	return types.Float64Null()
+}
+
+func boolValue(data map[string]interface{}, key string) types.Bool {
+	switch val := data[key].(type) {
+	case bool:
+		return types.BoolValue(val)
+	case float64:
+		return types.BoolValue(val != 0)
+	case string:
+		if b, ok := parseBool(val); ok {
+			return types.BoolValue(b)
+		}
+	}
+	return types.BoolNull()
+}
+
+// parseBool parses the boolean spellings accepted by Proxmox.
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "1", "true", "yes", "on":
+		return true, true
+	case "0", "false", "no", "off":
+		return false, true
+	}
+	return false, false
+}
+
+// splitList splits a Proxmox list string on commas, semicolons or
+// whitespace, dropping empty entries.
+func splitList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t' || r == '\n'
+	})
+}
+
+// stringSetValue converts the list data[key], either a list string or a JSON
+// array, into a set of strings. Missing and empty lists are returned as a
+// null set.
+func stringSetValue(data map[string]interface{}, key string) types.Set {
+	items := splitList(stringValue(data, key).ValueString())
+	if array, ok := data[key].([]interface{}); ok {
+		for _, item := range array {
+			if s, ok := item.(string); ok && s != "" {
+				items = append(items, s)
+			}
+		}
+	}
+	if len(items) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	elems := make([]attr.Value, len(items))
+	for i, item := range items {
+		elems[i] = types.StringValue(item)
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// int64SetValue converts the list data[key] into a set of integers, skipping
+// entries that are not numeric. Missing and empty lists are returned as a
+// null set.
+func int64SetValue(data map[string]interface{}, key string) types.Set {
+	var elems []attr.Value
+	for _, item := range splitList(stringValue(data, key).ValueString()) {
+		if i, err := strconv.ParseInt(item, 10, 64); err == nil {
+			elems = append(elems, types.Int64Value(i))
+		}
+	}
+	if len(elems) == 0 {
+		return types.SetNull(types.Int64Type)
+	}
+	return types.SetValueMust(types.Int64Type, elems)
+}
+
+// apiParams collects request parameters from Terraform values. Null and
+// unknown values are skipped so Proxmox applies its own defaults.
+type apiParams map[string]interface{}
+
+func (p apiParams) setString(key string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() {
+		p[key] = v.ValueString()
+	}
+}
+
+func (p apiParams) setInt64(key string, v types.Int64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		p[key] = v.ValueInt64()
+	}
+}
+
+func (p apiParams) setFloat64(key string, v types.Float64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		p[key] = v.ValueFloat64()
+	}
+}
+
+func (p apiParams) setBool(key string, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		if v.ValueBool() {
+			p[key] = 1
+		} else {
+			p[key] = 0
+		}
+	}
+}
+
+// setStringSet sets key to the comma separated elements of a set of strings.
+func (p apiParams) setStringSet(key string, v types.Set) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	var items []string
+	for _, elem := range v.Elements() {
+		if s, ok := elem.(types.String); ok {
+			items = append(items, s.ValueString())
+		}
+	}
+	p[key] = strings.Join(items, ",")
+}
+
+// setInt64Set sets key to the comma separated elements of a set of integers.
+func (p apiParams) setInt64Set(key string, v types.Set) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	var items []string
+	for _, elem := range v.Elements() {
+		if i, ok := elem.(types.Int64); ok {
+			items = append(items, strconv.FormatInt(i.ValueInt64(), 10))
+		}
+	}
+	p[key] = strings.Join(items, ",")
+}
+
+// setStringArray sets key to the elements of a set of strings, for options
+// the API declares as arrays.
+func (p apiParams) setStringArray(key string, v types.Set) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	items := []string{}
+	for _, elem := range v.Elements() {
+		if s, ok := elem.(types.String); ok {
+			items = append(items, s.ValueString())
+		}
+	}
+	p[key] = items
+}
+
+// remove adds key to the Proxmox "delete" parameter, which resets an option
+// to its default on update calls.
+func (p apiParams) remove(key string) {
+	if existing, ok := p["delete"].(string); ok && existing != "" {
+		p["delete"] = existing + "," + key
+		return
+	}
+	p["delete"] = key
+}
+
+// The update variants behave like their set counterparts but remove the
+// option when the planned value is null.
+
+func (p apiParams) updateString(key string, v types.String) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setString(key, v)
+}
+
+func (p apiParams) updateInt64(key string, v types.Int64) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setInt64(key, v)
+}
+
+func (p apiParams) updateFloat64(key string, v types.Float64) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setFloat64(key, v)
+}
+
+func (p apiParams) updateBool(key string, v types.Bool) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setBool(key, v)
+}
+
+func (p apiParams) updateStringSet(key string, v types.Set) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setStringSet(key, v)
+}
+
+func (p apiParams) updateStringArray(key string, v types.Set) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setStringArray(key, v)
+}
+
+func (p apiParams) updateInt64Set(key string, v types.Set) {
+	if v.IsNull() {
+		p.remove(key)
+		return
+	}
+	p.setInt64Set(key, v)
+}
+
+// The optional helpers convert Terraform values into the optional fields of
+// the typed requests of package pveapi, returning nil for null and unknown
+// values like the set methods of apiParams.
+
+func optionalString(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return pveapi.Ptr(v.ValueString())
+}
+
+func optionalInt64(v types.Int64) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return pveapi.Ptr(v.ValueInt64())
+}
+
+func optionalBool(v types.Bool) *pveapi.Bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return pveapi.Ptr(pveapi.Bool(v.ValueBool()))
+}
+
+// parsePropertyString parses a Proxmox property string such as
+// "enable=1,burst=5" into its key/value pairs. A leading value without a key
+// is stored under defaultKey.
+func parsePropertyString(s, defaultKey string) map[string]string {
+	result := map[string]string{}
+	for _, part := range strings.Split(s, ",") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			key, value = defaultKey, part
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// propertyStringValues returns the properties of data[key], which the API
+// returns either as a property string or, for some endpoints, already parsed
+// into an object.
+func propertyStringValues(data map[string]interface{}, key, defaultKey string) map[string]string {
+	switch value := data[key].(type) {
+	case string:
+		return parsePropertyString(value, defaultKey)
+	case map[string]interface{}:
+		props := map[string]string{}
+		for k := range value {
+			props[k] = stringValue(value, k).ValueString()
+		}
+		return props
+	}
+	return nil
+}
+
+// propertyString builds a Proxmox property string from Terraform values,
+// skipping null and unknown ones.
+type propertyString []string
+
+func (p *propertyString) addString(key string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() {
+		*p = append(*p, key+"="+v.ValueString())
+	}
+}
+
+func (p *propertyString) addInt64(key string, v types.Int64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		*p = append(*p, key+"="+strconv.FormatInt(v.ValueInt64(), 10))
+	}
+}
+
+func (p *propertyString) addBool(key string, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		if v.ValueBool() {
+			*p = append(*p, key+"=1")
+		} else {
+			*p = append(*p, key+"=0")
+		}
+	}
+}
+
+func (p propertyString) String() string {
+	return strings.Join(p, ",")
+}
+
+// The property variants convert a single value of a parsed property string.
+
+func propertyStringValue(props map[string]string, key string) types.String {
+	if value, ok := props[key]; ok {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+func propertyInt64Value(props map[string]string, key string) types.Int64 {
+	if value, ok := props[key]; ok {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+	return types.Int64Null()
+}
+
+func propertyBoolValue(props map[string]string, key string) types.Bool {
+	if b, ok := parseBool(props[key]); ok {
+		return types.BoolValue(b)
+	}
+	return types.BoolNull()
+}
+
+// formatID joins the parts of a composite resource ID.
+func formatID(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// parseID splits a composite resource ID into exactly n non-empty parts.
+func parseID(id string, n int, format string) ([]string, error) {
+	parts := strings.SplitN(id, "/", n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected ID %q, expected format %s", id, format)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected ID %q, expected format %s", id, format)
+		}
+	}
+	return parts, nil
+}
+
+// parseGuestID parses an ID in the node/vmid format.
+func parseGuestID(id string) (string, int64, error) {
+	parts, err := parseID(id, 2, "node/vmid")
+	if err != nil {
+		return "", 0, err
+	}
+	vmID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected ID %q, the VM ID must be numeric", id)
+	}
+	return parts[0], vmID, nil
+}
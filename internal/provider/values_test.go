@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBoolValue(t *testing.T) {
+	data := map[string]interface{}{
+		"number": float64(1),
+		"string": "0",
+		"bool":   true,
+		"other":  "maybe",
+	}
+
+	if got := boolValue(data, "number"); !got.Equal(types.BoolValue(true)) {
+		t.Errorf("number: got %s", got)
+	}
+	if got := boolValue(data, "string"); !got.Equal(types.BoolValue(false)) {
+		t.Errorf("string: got %s", got)
+	}
+	if got := boolValue(data, "bool"); !got.Equal(types.BoolValue(true)) {
+		t.Errorf("bool: got %s", got)
+	}
+	if got := boolValue(data, "other"); !got.IsNull() {
+		t.Errorf("other: expected null, got %s", got)
+	}
+	if got := boolValue(data, "missing"); !got.IsNull() {
+		t.Errorf("missing: expected null, got %s", got)
+	}
+}
+
+func TestParsePropertyString(t *testing.T) {
+	got := parsePropertyString("1,burst=5,rate=1/second", "enable")
+	want := map[string]string{"enable": "1", "burst": "5", "rate": "1/second"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPropertyString(t *testing.T) {
+	var props propertyString
+	props.addBool("enable", types.BoolValue(true))
+	props.addInt64("burst", types.Int64Null())
+	props.addString("rate", types.StringValue("1/second"))
+
+	if got, want := props.String(), "enable=1,rate=1/second"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAPIParamsRemove(t *testing.T) {
+	params := apiParams{}
+	params.updateString("comment", types.StringNull())
+	params.updateBool("enable", types.BoolValue(false))
+	params.updateInt64("max", types.Int64Null())
+
+	want := apiParams{"delete": "comment,max", "enable": 0}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("got %v, want %v", params, want)
+	}
+}
+
+func TestParseGuestID(t *testing.T) {
+	node, vmID, err := parseGuestID("pve1/100")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if node != "pve1" || vmID != 100 {
+		t.Errorf("got %s/%d", node, vmID)
+	}
+
+	for _, id := range []string{"pve1", "pve1/", "/100", "pve1/abc"} {
+		if _, _, err := parseGuestID(id); err == nil {
+			t.Errorf("%q: expected error", id)
+		}
+	}
+}
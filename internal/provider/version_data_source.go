@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VersionDataSource{}
+
+func NewVersionDataSource() datasource.DataSource {
+	return &VersionDataSource{}
+}
+
+// VersionDataSource defines the data source implementation.
+type VersionDataSource struct {
+	client *ProxmoxClient
+}
+
+// VersionDataSourceModel describes the data source data model.
+type VersionDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Version types.String `tfsdk:"version"`
+	Release types.String `tfsdk:"release"`
+	RepoID  types.String `tfsdk:"repo_id"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+}
+
+func (d *VersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (d *VersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the Proxmox VE version of the node the provider connects to, e.g. to only use " +
+			"features of newer releases.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Full version (e.g., `8.2.4`)",
+				Computed:            true,
+			},
+			"release": schema.StringAttribute{
+				MarkdownDescription: "Release (e.g., `8.2`)",
+				Computed:            true,
+			},
+			"repo_id": schema.StringAttribute{
+				MarkdownDescription: "Commit ID of the pve-manager package",
+				Computed:            true,
+			},
+			"major": schema.Int64Attribute{
+				MarkdownDescription: "Major version (e.g., `8`)",
+				Computed:            true,
+			},
+			"minor": schema.Int64Attribute{
+				MarkdownDescription: "Minor version (e.g., `2`)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VersionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox version")
+
+	var version map[string]interface{}
+	if err := d.client.GetCached(ctx, "/version", &version); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read version, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("version")
+	data.Version = stringValue(version, "version")
+	data.Release = stringValue(version, "release")
+	data.RepoID = stringValue(version, "repoid")
+	data.Major, data.Minor = types.Int64Null(), types.Int64Null()
+
+	parts := strings.Split(data.Version.ValueString(), ".")
+	if major, err := strconv.ParseInt(parts[0], 10, 64); err == nil {
+		data.Major = types.Int64Value(major)
+	}
+	if len(parts) > 1 {
+		if minor, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+			data.Minor = types.Int64Value(minor)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVersionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_version" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_version.test", "id", "version"),
+					resource.TestMatchResourceAttr("data.proxmox_version.test", "version", regexp.MustCompile(`^\d+\.\d+`)),
+					resource.TestCheckResourceAttrSet("data.proxmox_version.test", "release"),
+					resource.TestCheckResourceAttrSet("data.proxmox_version.test", "major"),
+					resource.TestCheckResourceAttrSet("data.proxmox_version.test", "minor"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// vmDiskKeyPattern matches the configuration keys of virtual machine disks.
+var vmDiskKeyPattern = regexp.MustCompile(`^(ide|sata|scsi|virtio|efidisk|tpmstate)\d+$`)
+
+// vmNetworkOptions lists the options of a network device property string. The
+// remaining key is the device model with the MAC address as value.
+var vmNetworkOptions = []string{"bridge", "firewall", "link_down", "macaddr", "mtu", "queues", "rate", "tag", "trunks"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VMDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VMDataSource{}
+
+func NewVMDataSource() datasource.DataSource {
+	return &VMDataSource{}
+}
+
+// VMDataSource defines the data source implementation.
+type VMDataSource struct {
+	client *ProxmoxClient
+}
+
+// VMDataSourceModel describes the data source data model.
+type VMDataSourceModel struct {
+	ID          types.String     `tfsdk:"id"`
+	VMID        types.Int64      `tfsdk:"vm_id"`
+	Name        types.String     `tfsdk:"name"`
+	Node        types.String     `tfsdk:"node"`
+	Status      types.String     `tfsdk:"status"`
+	Uptime      types.Int64      `tfsdk:"uptime"`
+	Lock        types.String     `tfsdk:"lock"`
+	Template    types.Bool       `tfsdk:"template"`
+	Pool        types.String     `tfsdk:"pool"`
+	Tags        []string         `tfsdk:"tags"`
+	Description types.String     `tfsdk:"description"`
+	Sockets     types.Int64      `tfsdk:"sockets"`
+	Cores       types.Int64      `tfsdk:"cores"`
+	CPUType     types.String     `tfsdk:"cpu_type"`
+	Memory      types.Int64      `tfsdk:"memory"`
+	Balloon     types.Int64      `tfsdk:"balloon"`
+	OSType      types.String     `tfsdk:"os_type"`
+	BIOS        types.String     `tfsdk:"bios"`
+	Machine     types.String     `tfsdk:"machine"`
+	Boot        types.String     `tfsdk:"boot"`
+	OnBoot      types.Bool       `tfsdk:"on_boot"`
+	Agent       types.Bool       `tfsdk:"agent"`
+	Networks    []VMNetworkModel `tfsdk:"networks"`
+	Disks       []VMDiskModel    `tfsdk:"disks"`
+}
+
+// VMNetworkModel describes a network device of a virtual machine.
+type VMNetworkModel struct {
+	Key        types.String `tfsdk:"key"`
+	Model      types.String `tfsdk:"model"`
+	MACAddress types.String `tfsdk:"mac_address"`
+	Bridge     types.String `tfsdk:"bridge"`
+	VLAN       types.Int64  `tfsdk:"vlan"`
+	Firewall   types.Bool   `tfsdk:"firewall"`
+	LinkDown   types.Bool   `tfsdk:"link_down"`
+	MTU        types.Int64  `tfsdk:"mtu"`
+}
+
+// VMDiskModel describes a disk or drive of a virtual machine.
+type VMDiskModel struct {
+	Key     types.String `tfsdk:"key"`
+	Volume  types.String `tfsdk:"volume"`
+	Storage types.String `tfsdk:"storage"`
+	Size    types.String `tfsdk:"size"`
+	Media   types.String `tfsdk:"media"`
+}
+
+func (d *VMDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+func (d *VMDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current configuration and status of a virtual machine, looked up by ID or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier in the `node/vm_id` format",
+				Computed:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the virtual machine. Either `vm_id` or `name` must be set",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the virtual machine. Either `vm_id` or `name` must be set",
+				Optional:            true,
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Node the virtual machine is located on. When set, only this node is searched",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the virtual machine (e.g., `running` or `stopped`)",
+				Computed:            true,
+			},
+			"uptime": schema.Int64Attribute{
+				MarkdownDescription: "Uptime in seconds",
+				Computed:            true,
+			},
+			"lock": schema.StringAttribute{
+				MarkdownDescription: "Lock held on the virtual machine (e.g., `backup` or `migrate`)",
+				Computed:            true,
+			},
+			"template": schema.BoolAttribute{
+				MarkdownDescription: "Whether the virtual machine is a template",
+				Computed:            true,
+			},
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "Pool the virtual machine is a member of",
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags of the virtual machine, sorted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Notes of the virtual machine",
+				Computed:            true,
+			},
+			"sockets": schema.Int64Attribute{
+				MarkdownDescription: "Number of CPU sockets",
+				Computed:            true,
+			},
+			"cores": schema.Int64Attribute{
+				MarkdownDescription: "Number of cores per socket",
+				Computed:            true,
+			},
+			"cpu_type": schema.StringAttribute{
+				MarkdownDescription: "Emulated CPU type",
+				Computed:            true,
+			},
+			"memory": schema.Int64Attribute{
+				MarkdownDescription: "Memory in MiB",
+				Computed:            true,
+			},
+			"balloon": schema.Int64Attribute{
+				MarkdownDescription: "Minimum memory of the balloon device in MiB, `0` if ballooning is disabled",
+				Computed:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Guest operating system type (e.g., `l26` or `win11`)",
+				Computed:            true,
+			},
+			"bios": schema.StringAttribute{
+				MarkdownDescription: "BIOS implementation, `seabios` or `ovmf`",
+				Computed:            true,
+			},
+			"machine": schema.StringAttribute{
+				MarkdownDescription: "Emulated machine type",
+				Computed:            true,
+			},
+			"boot": schema.StringAttribute{
+				MarkdownDescription: "Boot order",
+				Computed:            true,
+			},
+			"on_boot": schema.BoolAttribute{
+				MarkdownDescription: "Whether the virtual machine is started when the node boots",
+				Computed:            true,
+			},
+			"agent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the QEMU guest agent is enabled",
+				Computed:            true,
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "Network devices, sorted by key",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Configuration key of the device (e.g., `net0`)",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Device model (e.g., `virtio` or `e1000`)",
+							Computed:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the device",
+							Computed:            true,
+						},
+						"bridge": schema.StringAttribute{
+							MarkdownDescription: "Bridge the device is attached to",
+							Computed:            true,
+						},
+						"vlan": schema.Int64Attribute{
+							MarkdownDescription: "VLAN tag of the device",
+							Computed:            true,
+						},
+						"firewall": schema.BoolAttribute{
+							MarkdownDescription: "Whether the firewall is enabled on the device",
+							Computed:            true,
+						},
+						"link_down": schema.BoolAttribute{
+							MarkdownDescription: "Whether the link of the device is disconnected",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "MTU of the device",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"disks": schema.ListNestedAttribute{
+				MarkdownDescription: "Disks and drives, sorted by key",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Configuration key of the disk (e.g., `scsi0` or `efidisk0`)",
+							Computed:            true,
+						},
+						"volume": schema.StringAttribute{
+							MarkdownDescription: "Volume ID of the disk, or `none` for an empty drive",
+							Computed:            true,
+						},
+						"storage": schema.StringAttribute{
+							MarkdownDescription: "Storage of the volume",
+							Computed:            true,
+						},
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Size of the disk (e.g., `32G`)",
+							Computed:            true,
+						},
+						"media": schema.StringAttribute{
+							MarkdownDescription: "Media type, `cdrom` for CD-ROM drives",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VMDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VMDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VMDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.VMID.IsNull() && data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vm_id"),
+			"Missing Attribute Configuration",
+			"Either vm_id or name must be set to look up the virtual machine.",
+		)
+	}
+}
+
+func (d *VMDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VMDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox virtual machine", map[string]interface{}{"vm_id": data.VMID.ValueInt64(), "name": data.Name.ValueString()})
+
+	entry, err := findGuest(ctx, d.client, guestTypeVM, data.VMID.ValueInt64(), data.Name.ValueString(), data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find virtual machine, got error: %s", err))
+		return
+	}
+
+	node, vmID := stringValue(entry, "node").ValueString(), int64Value(entry, "vmid").ValueInt64()
+	vmPath := guestPath(guestTypeVM, node, vmID)
+
+	var config, status map[string]interface{}
+	if err := d.client.Get(ctx, vmPath+"/config", &config); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration of virtual machine %d, got error: %s", vmID, err))
+		return
+	}
+	if err := d.client.Get(ctx, vmPath+"/status/current", &status); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status of virtual machine %d, got error: %s", vmID, err))
+		return
+	}
+
+	data.ID = types.StringValue(formatID(node, strconv.FormatInt(vmID, 10)))
+	data.VMID = types.Int64Value(vmID)
+	data.Name = stringValue(config, "name")
+	data.Node = types.StringValue(node)
+	data.Status = stringValue(status, "status")
+	data.Uptime = int64Value(status, "uptime")
+	data.Lock = stringValue(status, "lock")
+	data.Template = types.BoolValue(boolValue(config, "template").ValueBool())
+	data.Pool = stringValue(entry, "pool")
+	data.Tags = splitList(stringValue(config, "tags").ValueString())
+	sort.Strings(data.Tags)
+	data.Description = stringValue(config, "description")
+	data.Sockets = int64Value(config, "sockets")
+	data.Cores = int64Value(config, "cores")
+	data.CPUType = propertyStringValue(propertyStringValues(config, "cpu", "cputype"), "cputype")
+	data.Memory = int64Value(config, "memory")
+	data.Balloon = int64Value(config, "balloon")
+	data.OSType = stringValue(config, "ostype")
+	data.BIOS = stringValue(config, "bios")
+	data.Machine = stringValue(config, "machine")
+	data.Boot = stringValue(config, "boot")
+	data.OnBoot = types.BoolValue(boolValue(config, "onboot").ValueBool())
+	data.Agent = types.BoolValue(propertyBoolValue(propertyStringValues(config, "agent", "enabled"), "enabled").ValueBool())
+	data.Networks, data.Disks = newVMDeviceModels(config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newVMDeviceModels returns the network devices and disks of a virtual
+// machine configuration, sorted by key.
+func newVMDeviceModels(config map[string]interface{}) ([]VMNetworkModel, []VMDiskModel) {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	networks, disks := []VMNetworkModel{}, []VMDiskModel{}
+	for _, key := range keys {
+		switch {
+		case strings.HasPrefix(key, "net"):
+			if _, err := strconv.Atoi(strings.TrimPrefix(key, "net")); err != nil {
+				continue
+			}
+			props := propertyStringValues(config, key, "")
+			network := VMNetworkModel{
+				Key:        types.StringValue(key),
+				Model:      types.StringNull(),
+				MACAddress: propertyStringValue(props, "macaddr"),
+				Bridge:     propertyStringValue(props, "bridge"),
+				VLAN:       propertyInt64Value(props, "tag"),
+				Firewall:   types.BoolValue(propertyBoolValue(props, "firewall").ValueBool()),
+				LinkDown:   types.BoolValue(propertyBoolValue(props, "link_down").ValueBool()),
+				MTU:        propertyInt64Value(props, "mtu"),
+			}
+			for name, value := range props {
+				if !slices.Contains(vmNetworkOptions, name) {
+					network.Model = types.StringValue(name)
+					network.MACAddress = types.StringValue(value)
+				}
+			}
+			networks = append(networks, network)
+		case vmDiskKeyPattern.MatchString(key):
+			props := propertyStringValues(config, key, "file")
+			volume := propertyStringValue(props, "file")
+			storage := types.StringNull()
+			if name, _, ok := strings.Cut(volume.ValueString(), ":"); ok {
+				storage = types.StringValue(name)
+			}
+			disks = append(disks, VMDiskModel{
+				Key:     types.StringValue(key),
+				Volume:  volume,
+				Storage: storage,
+				Size:    propertyStringValue(props, "size"),
+				Media:   propertyStringValue(props, "media"),
+			})
+		}
+	}
+	return networks, disks
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+func TestAccVMDataSource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_vm" "test" {
+  vm_id = %[1]s
+}
+
+data "proxmox_vm" "by_name" {
+  name = data.proxmox_vm.test.name
+  node = data.proxmox_vm.test.node
+}
+`, vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_vm.test", "vm_id", vmID),
+					resource.TestCheckResourceAttrSet("data.proxmox_vm.test", "name"),
+					resource.TestCheckResourceAttrSet("data.proxmox_vm.test", "status"),
+					resource.TestCheckResourceAttrSet("data.proxmox_vm.test", "memory"),
+					resource.TestCheckResourceAttrPair("data.proxmox_vm.by_name", "id", "data.proxmox_vm.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestVMDeviceModels(t *testing.T) {
+	config := map[string]interface{}{
+		"net1":     "e1000=BC:24:11:00:00:02,bridge=vmbr1,link_down=1",
+		"net0":     "virtio=BC:24:11:00:00:01,bridge=vmbr0,firewall=1,tag=10",
+		"scsi0":    "local-lvm:vm-100-disk-0,iothread=1,size=32G",
+		"ide2":     "none,media=cdrom",
+		"efidisk0": "local-lvm:vm-100-disk-1,efitype=4m,size=4M",
+		"netboot":  "ignored",
+		"scsihw":   "virtio-scsi-single",
+	}
+
+	networks, disks := newVMDeviceModels(config)
+	if len(networks) != 2 || len(disks) != 3 {
+		t.Fatalf("unexpected devices %v, %v", networks, disks)
+	}
+
+	net0 := networks[0]
+	if net0.Key.ValueString() != "net0" || net0.Model.ValueString() != "virtio" ||
+		net0.MACAddress.ValueString() != "BC:24:11:00:00:01" || net0.VLAN.ValueInt64() != 10 ||
+		!net0.Firewall.ValueBool() || net0.LinkDown.ValueBool() {
+		t.Errorf("unexpected net0 %+v", net0)
+	}
+	if !networks[1].LinkDown.ValueBool() || networks[1].Model.ValueString() != "e1000" {
+		t.Errorf("unexpected net1 %+v", networks[1])
+	}
+
+	if disks[0].Key.ValueString() != "efidisk0" || disks[2].Key.ValueString() != "scsi0" {
+		t.Errorf("unexpected disk order %v", disks)
+	}
+	if disks[1].Volume.ValueString() != "none" || !disks[1].Storage.IsNull() || disks[1].Media.ValueString() != "cdrom" {
+		t.Errorf("unexpected ide2 %+v", disks[1])
+	}
+	if disks[2].Storage.ValueString() != "local-lvm" || disks[2].Size.ValueString() != "32G" {
+		t.Errorf("unexpected scsi0 %+v", disks[2])
+	}
+}
+
+func TestVMDataSourceRead(t *testing.T) {
+	h := newTestHarness(t)
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 100, map[string]interface{}{
+		"name":   "web",
+		"cores":  "4",
+		"memory": "4096",
+		"tags":   "prod;web",
+		"net0":   "virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=10",
+		"scsi0":  "local-lvm:vm-100-disk-0,size=32G",
+	})
+	h.Fake.AddGuest(fakeproxmox.LXC, fakeproxmox.Node, 101, map[string]interface{}{"hostname": "web"})
+
+	data, err := h.readDataSource("proxmox_vm", map[string]interface{}{"name": "web"})
+	if err != nil {
+		t.Fatalf("unable to read virtual machine: %s", err)
+	}
+
+	for key, want := range map[string]interface{}{
+		"id":     fakeproxmox.Node + "/100",
+		"vm_id":  int64(100),
+		"status": "stopped",
+		"cores":  int64(4),
+		"memory": int64(4096),
+	} {
+		if data[key] != want {
+			t.Errorf("expected %s %v, got %v", key, want, data[key])
+		}
+	}
+	networks := data["networks"].([]interface{})
+	if len(networks) != 1 || networks[0].(map[string]interface{})["bridge"] != "vmbr0" || networks[0].(map[string]interface{})["vlan"] != int64(10) {
+		t.Errorf("unexpected networks %v", networks)
+	}
+	disks := data["disks"].([]interface{})
+	if len(disks) != 1 || disks[0].(map[string]interface{})["storage"] != "local-lvm" {
+		t.Errorf("unexpected disks %v", disks)
+	}
+
+	if _, err := h.readDataSource("proxmox_vm", map[string]interface{}{"vm_id": 102}); err == nil {
+		t.Error("expected an error for a missing virtual machine")
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VMNetworkInterfacesDataSource{}
+
+func NewVMNetworkInterfacesDataSource() datasource.DataSource {
+	return &VMNetworkInterfacesDataSource{}
+}
+
+// VMNetworkInterfacesDataSource defines the data source implementation.
+type VMNetworkInterfacesDataSource struct {
+	client *ProxmoxClient
+}
+
+// VMNetworkInterfacesDataSourceModel describes the data source data model.
+type VMNetworkInterfacesDataSourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	Node          types.String              `tfsdk:"node"`
+	VMID          types.Int64               `tfsdk:"vm_id"`
+	IPv4Addresses []string                  `tfsdk:"ipv4_addresses"`
+	IPv6Addresses []string                  `tfsdk:"ipv6_addresses"`
+	Interfaces    []VMNetworkInterfaceModel `tfsdk:"interfaces"`
+}
+
+// VMNetworkInterfaceModel describes a network interface reported by the
+// guest agent.
+type VMNetworkInterfaceModel struct {
+	Name          types.String `tfsdk:"name"`
+	MACAddress    types.String `tfsdk:"mac_address"`
+	IPv4Addresses []string     `tfsdk:"ipv4_addresses"`
+	IPv6Addresses []string     `tfsdk:"ipv6_addresses"`
+}
+
+func (d *VMNetworkInterfacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_network_interfaces"
+}
+
+func (d *VMNetworkInterfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the network interfaces of a running Proxmox VE virtual machine from the QEMU guest " +
+			"agent, which must be installed and enabled.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier in the `node/vm_id` format",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Name of the node the virtual machine runs on",
+				Required:            true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the virtual machine",
+				Required:            true,
+				Validators:          []validator.Int64{validators.VMID()},
+			},
+			"ipv4_addresses": schema.ListAttribute{
+				MarkdownDescription: "IPv4 addresses of all interfaces, except loopback and link-local addresses",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"ipv6_addresses": schema.ListAttribute{
+				MarkdownDescription: "IPv6 addresses of all interfaces, except loopback and link-local addresses",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"interfaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Network interfaces in the order reported by the guest",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the interface inside the guest (e.g., `eth0`)",
+							Computed:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the interface",
+							Computed:            true,
+						},
+						"ipv4_addresses": schema.ListAttribute{
+							MarkdownDescription: "IPv4 addresses of the interface in CIDR notation",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ipv6_addresses": schema.ListAttribute{
+							MarkdownDescription: "IPv6 addresses of the interface in CIDR notation",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VMNetworkInterfacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VMNetworkInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VMNetworkInterfacesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, vmID := data.Node.ValueString(), data.VMID.ValueInt64()
+	data.ID = types.StringValue(formatID(node, strconv.FormatInt(vmID, 10)))
+
+	tflog.Debug(ctx, "Reading Proxmox guest agent network interfaces", map[string]interface{}{"id": data.ID.ValueString()})
+
+	var result struct {
+		Result []map[string]interface{} `json:"result"`
+	}
+	if err := d.client.Get(ctx, guestPath(guestTypeVM, node, vmID)+"/agent/network-get-interfaces", &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network interfaces of virtual machine %s from the guest agent, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data.Interfaces = newVMNetworkInterfaceModels(result.Result)
+	data.IPv4Addresses, data.IPv6Addresses = []string{}, []string{}
+	for _, iface := range data.Interfaces {
+		data.IPv4Addresses = append(data.IPv4Addresses, routableAddresses(iface.IPv4Addresses)...)
+		data.IPv6Addresses = append(data.IPv6Addresses, routableAddresses(iface.IPv6Addresses)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d network interfaces", len(data.Interfaces)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newVMNetworkInterfaceModels converts the interfaces reported by the guest
+// agent.
+func newVMNetworkInterfaceModels(entries []map[string]interface{}) []VMNetworkInterfaceModel {
+	interfaces := make([]VMNetworkInterfaceModel, len(entries))
+	for i, entry := range entries {
+		interfaces[i] = VMNetworkInterfaceModel{
+			Name:          stringValue(entry, "name"),
+			MACAddress:    stringValue(entry, "hardware-address"),
+			IPv4Addresses: []string{},
+			IPv6Addresses: []string{},
+		}
+
+		addresses, _ := entry["ip-addresses"].([]interface{})
+		for _, item := range addresses {
+			address, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			cidr := stringValue(address, "ip-address").ValueString() + "/" + stringValue(address, "prefix").ValueString()
+			switch stringValue(address, "ip-address-type").ValueString() {
+			case "ipv4":
+				interfaces[i].IPv4Addresses = append(interfaces[i].IPv4Addresses, cidr)
+			case "ipv6":
+				interfaces[i].IPv6Addresses = append(interfaces[i].IPv6Addresses, cidr)
+			}
+		}
+	}
+	return interfaces
+}
+
+// routableAddresses returns the addresses, without prefix length, that are
+// neither loopback nor link-local addresses.
+func routableAddresses(cidrs []string) []string {
+	var addresses []string
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+		addr := prefix.Addr()
+		if addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+			continue
+		}
+		addresses = append(addresses, addr.String())
+	}
+	return addresses
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMNetworkInterfacesDataSource(t *testing.T) {
+	// The VM must be running with the guest agent installed.
+	vmID := testAccRequireEnv(t, "PROXMOX_AGENT_VM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_vm_network_interfaces" "test" {
+  node  = %[1]q
+  vm_id = %[2]s
+}
+`, testNode(), vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_vm_network_interfaces.test", "id", testNode()+"/"+vmID),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_vm_network_interfaces.test", "interfaces.*", map[string]string{
+						"name":             "lo",
+						"ipv4_addresses.0": "127.0.0.1/8",
+					}),
+					resource.TestCheckResourceAttrSet("data.proxmox_vm_network_interfaces.test", "ipv4_addresses.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestVMNetworkInterfaceModels(t *testing.T) {
+	interfaces := newVMNetworkInterfaceModels([]map[string]interface{}{
+		{
+			"name": "lo",
+			"ip-addresses": []interface{}{
+				map[string]interface{}{"ip-address": "127.0.0.1", "ip-address-type": "ipv4", "prefix": float64(8)},
+				map[string]interface{}{"ip-address": "::1", "ip-address-type": "ipv6", "prefix": float64(128)},
+			},
+		},
+		{
+			"name":             "eth0",
+			"hardware-address": "bc:24:11:00:00:01",
+			"ip-addresses": []interface{}{
+				map[string]interface{}{"ip-address": "192.0.2.10", "ip-address-type": "ipv4", "prefix": float64(24)},
+				map[string]interface{}{"ip-address": "2001:db8::10", "ip-address-type": "ipv6", "prefix": float64(64)},
+				map[string]interface{}{"ip-address": "fe80::be24:11ff:fe00:1", "ip-address-type": "ipv6", "prefix": float64(64)},
+			},
+		},
+	})
+
+	if len(interfaces) != 2 || interfaces[1].MACAddress.ValueString() != "bc:24:11:00:00:01" {
+		t.Fatalf("unexpected interfaces %+v", interfaces)
+	}
+	if want := []string{"192.0.2.10/24"}; !reflect.DeepEqual(interfaces[1].IPv4Addresses, want) {
+		t.Errorf("got %v, want %v", interfaces[1].IPv4Addresses, want)
+	}
+	if got := routableAddresses(interfaces[0].IPv6Addresses); got != nil {
+		t.Errorf("expected no routable loopback addresses, got %v", got)
+	}
+	if want := []string{"2001:db8::10"}; !reflect.DeepEqual(routableAddresses(interfaces[1].IPv6Addresses), want) {
+		t.Errorf("got %v, want %v", routableAddresses(interfaces[1].IPv6Addresses), want)
+	}
+}
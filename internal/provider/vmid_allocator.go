@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// This file contains the allocation of guest IDs. /cluster/nextid returns
+// the lowest free ID without reserving it, so lookups running in parallel
+// during one Terraform run return the same ID and the guests created with it
+// collide. The allocator hands out every ID only once per provider process
+// and moves on to the next ID when Proxmox VE reports a candidate as taken,
+// e.g. because another client has just created a guest with it.
+
+// vmIDConflictRetries bounds the candidates that are tried after Proxmox VE
+// reported IDs as taken that were not in the cluster resource list.
+const vmIDConflictRetries = 20
+
+// vmIDAllocator holds the guest IDs handed out by the provider process.
+type vmIDAllocator struct {
+	mu        sync.Mutex
+	allocated map[int64]bool
+}
+
+// allocateVMID returns the lowest guest ID between lower and upper that is
+// free in the cluster and has not been returned by the client before.
+func (c *ProxmoxClient) allocateVMID(ctx context.Context, lower, upper int64) (int64, error) {
+	allocator := c.vmIDs
+	if allocator == nil {
+		allocator = &vmIDAllocator{}
+	}
+
+	allocator.mu.Lock()
+	defer allocator.mu.Unlock()
+
+	if allocator.allocated == nil {
+		allocator.allocated = map[int64]bool{}
+	}
+
+	// The cached cluster resources may be older than guests created during
+	// this run, so the list is requested again.
+	var entries []map[string]interface{}
+	if err := c.Get(ctx, "/cluster/resources?type=vm", &entries); err != nil {
+		return 0, err
+	}
+	used := map[int64]bool{}
+	for _, entry := range entries {
+		used[int64Value(entry, "vmid").ValueInt64()] = true
+	}
+
+	candidate := lower
+	for conflicts := 0; ; candidate++ {
+		if candidate > upper {
+			return 0, fmt.Errorf("all IDs between %d and %d are in use", lower, upper)
+		}
+		if used[candidate] || allocator.allocated[candidate] {
+			continue
+		}
+
+		// Proxmox VE confirms the candidate, or rejects it if a guest was
+		// created with it in the meantime.
+		var vmID json.Number
+		err := c.Get(ctx, "/cluster/nextid?vmid="+strconv.FormatInt(candidate, 10), &vmID)
+		if isVMIDTaken(err) {
+			conflicts++
+			if conflicts > vmIDConflictRetries {
+				return 0, fmt.Errorf("giving up after %d IDs were taken concurrently: %w", conflicts, err)
+			}
+			tflog.Debug(ctx, "guest ID taken concurrently, trying the next one", map[string]interface{}{"vm_id": candidate})
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		allocator.allocated[candidate] = true
+		return candidate, nil
+	}
+}
+
+// isVMIDTaken reports whether err indicates that a guest ID is already used.
+func isVMIDTaken(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && strings.Contains(strings.ToLower(apiErr.Body), "already exists")
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestAllocateVMID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api2/json/cluster/resources":
+			fmt.Fprint(w, `{"data":[{"type":"qemu","vmid":100},{"type":"lxc","vmid":102}]}`)
+		case "/api2/json/cluster/nextid":
+			// 101 has been taken by another client after the resource list
+			// was read.
+			if vmID := r.URL.Query().Get("vmid"); vmID == "101" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"errors":{"vmid":"VM 101 already exists"},"data":null}`)
+			} else {
+				fmt.Fprintf(w, `{"data":%q}`, vmID)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &ProxmoxClient{HTTPClient: server.Client(), Endpoint: server.URL, vmIDs: &vmIDAllocator{}}
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	allocated := map[int64]bool{}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vmID, err := client.allocateVMID(ctx, 100, 107)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if allocated[vmID] {
+				t.Errorf("ID %d allocated twice", vmID)
+			}
+			allocated[vmID] = true
+		}()
+	}
+	wg.Wait()
+
+	for _, vmID := range []int64{103, 104, 105, 106, 107} {
+		if !allocated[vmID] {
+			t.Errorf("expected ID %d to be allocated, got %v", vmID, allocated)
+		}
+	}
+
+	if _, err := client.allocateVMID(ctx, 100, 107); err == nil {
+		t.Errorf("expected an error for an exhausted range")
+	}
+}
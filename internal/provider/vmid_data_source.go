@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VMIDDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VMIDDataSource{}
+
+func NewVMIDDataSource() datasource.DataSource {
+	return &VMIDDataSource{}
+}
+
+// VMIDDataSource defines the data source implementation.
+type VMIDDataSource struct {
+	client *ProxmoxClient
+}
+
+// VMIDDataSourceModel describes the data source data model.
+type VMIDDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Min  types.Int64  `tfsdk:"min"`
+	Max  types.Int64  `tfsdk:"max"`
+	VMID types.Int64  `tfsdk:"vm_id"`
+}
+
+func (d *VMIDDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vmid"
+}
+
+func (d *VMIDDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the next free guest ID of the cluster, optionally within a range. All " +
+			"`proxmox_vmid` data sources of a Terraform run return different IDs, so guests created with them in " +
+			"parallel do not collide. The ID is not reserved in Proxmox VE and changes once it is used, so guests " +
+			"created with it should ignore later changes of their ID, e.g. with `lifecycle { ignore_changes = [vm_id] }`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"min": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Lowest ID to return. Defaults to the lower limit of the `next-id` "+
+					"datacenter option, or `%d` if it is unset", validators.MinVMID),
+				Optional: true,
+			},
+			"max": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Highest ID to return. Defaults to the upper limit of the `next-id` "+
+					"datacenter option, or `%d` if it is unset", validators.MaxVMID),
+				Optional: true,
+			},
+			"vm_id": schema.Int64Attribute{
+				MarkdownDescription: "Next free guest ID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VMIDDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VMIDDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VMIDDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lower, upper := data.bounds()
+	if lower < validators.MinVMID || upper > validators.MaxVMID || lower > upper {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The range must be within %d and %d, with min not greater than max.", validators.MinVMID, validators.MaxVMID),
+		)
+	}
+}
+
+func (d *VMIDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VMIDDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading next free Proxmox guest ID")
+
+	vmID, err := d.nextID(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find a free guest ID, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("vmid")
+	data.VMID = types.Int64Value(vmID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nextID returns the next free ID within the configured range. Unset limits
+// default to the range configured in the next-id datacenter option.
+func (d *VMIDDataSource) nextID(ctx context.Context, data VMIDDataSourceModel) (int64, error) {
+	lower, upper := data.bounds()
+
+	if data.Min.IsNull() || data.Max.IsNull() {
+		var options map[string]interface{}
+		if err := d.client.GetCached(ctx, "/cluster/options", &options); err != nil {
+			return 0, err
+		}
+		nextID := propertyStringValues(options, "next-id", "")
+		if value, err := strconv.ParseInt(nextID["lower"], 10, 64); err == nil && data.Min.IsNull() {
+			lower = value
+		}
+		if value, err := strconv.ParseInt(nextID["upper"], 10, 64); err == nil && data.Max.IsNull() {
+			upper = value
+		}
+		if lower > upper {
+			return 0, fmt.Errorf("the range from %d to %d is empty, check the next-id datacenter option", lower, upper)
+		}
+	}
+
+	return d.client.allocateVMID(ctx, lower, upper)
+}
+
+// bounds returns the configured range, with defaults for unset limits.
+func (m VMIDDataSourceModel) bounds() (int64, int64) {
+	lower, upper := int64(validators.MinVMID), int64(validators.MaxVMID)
+	if !m.Min.IsNull() && !m.Min.IsUnknown() {
+		lower = m.Min.ValueInt64()
+	}
+	if !m.Max.IsNull() && !m.Max.IsUnknown() {
+		upper = m.Max.ValueInt64()
+	}
+	return lower, upper
+}
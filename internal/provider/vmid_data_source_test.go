@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/fakeproxmox"
+)
+
+func TestAccVMIDDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_vmid" "test" {}
+
+data "proxmox_vmid" "other" {}
+
+data "proxmox_vmid" "range" {
+  min = 9000
+  max = 9999
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.proxmox_vmid.test", "vm_id"),
+					resource.TestMatchResourceAttr("data.proxmox_vmid.range", "vm_id", regexp.MustCompile(`^9\d{3}$`)),
+					func(s *terraform.State) error {
+						resources := s.RootModule().Resources
+						if test, other := resources["data.proxmox_vmid.test"].Primary.Attributes["vm_id"], resources["data.proxmox_vmid.other"].Primary.Attributes["vm_id"]; test == other {
+							return fmt.Errorf("both data sources returned ID %s", test)
+						}
+						return nil
+					},
+				),
+			},
+			// Invalid range testing
+			{
+				Config: testAccProviderConfig() + `
+data "proxmox_vmid" "test" {
+  min = 500
+  max = 400
+}
+`,
+				ExpectError: regexp.MustCompile(`min not greater than max`),
+			},
+		},
+	})
+}
+
+func TestVMIDDataSourceDatacenterRange(t *testing.T) {
+	for _, test := range []struct {
+		nextID string
+		config map[string]interface{}
+		want   int64
+	}{
+		{"", map[string]interface{}{}, 101},
+		{"lower=1000,upper=1999", map[string]interface{}{}, 1001},
+		{"lower=1000,upper=1999", map[string]interface{}{"min": 1500}, 1500},
+		{"lower=1000,upper=1999", map[string]interface{}{"max": 2500}, 1001},
+		{"lower=1000,upper=1999", map[string]interface{}{"min": 100, "max": 200}, 101},
+	} {
+		h := newTestHarness(t)
+		h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 100, nil)
+		h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 1000, nil)
+		h.Fake.NextID = test.nextID
+
+		data, err := h.readDataSource("proxmox_vmid", test.config)
+		if err != nil {
+			t.Fatalf("%q %v: unable to read data source: %s", test.nextID, test.config, err)
+		}
+		if data["vm_id"] != test.want {
+			t.Errorf("%q %v: expected ID %d, got %v", test.nextID, test.config, test.want, data["vm_id"])
+		}
+	}
+
+	h := newTestHarness(t)
+	h.Fake.AddGuest(fakeproxmox.QEMU, fakeproxmox.Node, 1000, nil)
+	h.Fake.NextID = "lower=1000,upper=1000"
+	if _, err := h.readDataSource("proxmox_vmid", map[string]interface{}{}); err == nil || !strings.Contains(err.Error(), "in use") {
+		t.Errorf("expected an exhausted range to fail, got %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VMsDataSource{}
+
+func NewVMsDataSource() datasource.DataSource {
+	return &VMsDataSource{}
+}
+
+// VMsDataSource defines the data source implementation.
+type VMsDataSource struct {
+	client *ProxmoxClient
+}
+
+// VMsDataSourceModel describes the data source data model.
+type VMsDataSourceModel struct {
+	ID       types.String     `tfsdk:"id"`
+	Node     types.String     `tfsdk:"node"`
+	Pool     types.String     `tfsdk:"pool"`
+	Status   types.String     `tfsdk:"status"`
+	Tags     []string         `tfsdk:"tags"`
+	Template types.Bool       `tfsdk:"template"`
+	Filters  []FilterModel    `tfsdk:"filter"`
+	VMs      []VMSummaryModel `tfsdk:"vms"`
+}
+
+// VMSummaryModel describes a virtual machine of the cluster resource list.
+type VMSummaryModel struct {
+	VMID      types.Int64  `tfsdk:"vm_id"`
+	Name      types.String `tfsdk:"name"`
+	Node      types.String `tfsdk:"node"`
+	Status    types.String `tfsdk:"status"`
+	Tags      []string     `tfsdk:"tags"`
+	Pool      types.String `tfsdk:"pool"`
+	Template  types.Bool   `tfsdk:"template"`
+	CPUs      types.Int64  `tfsdk:"cpus"`
+	MaxMemory types.Int64  `tfsdk:"max_memory"`
+	Uptime    types.Int64  `tfsdk:"uptime"`
+}
+
+func (d *VMsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vms"
+}
+
+func (d *VMsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the virtual machines of the Proxmox VE cluster, optionally filtered, e.g. to look up " +
+			"virtual machines that are not managed by Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual machines on this node",
+				Optional:            true,
+			},
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual machines in this pool",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual machines with this status (e.g., `running` or `stopped`)",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only list virtual machines having all of these tags",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"template": schema.BoolAttribute{
+				MarkdownDescription: "Only list templates if `true`, or only virtual machines that are no templates if " +
+					"`false`",
+				Optional: true,
+			},
+			"vms": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching virtual machines, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vm_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the virtual machine",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the virtual machine",
+							Computed:            true,
+						},
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Node the virtual machine is located on",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the virtual machine",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "Tags of the virtual machine, sorted",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"pool": schema.StringAttribute{
+							MarkdownDescription: "Pool the virtual machine is a member of",
+							Computed:            true,
+						},
+						"template": schema.BoolAttribute{
+							MarkdownDescription: "Whether the virtual machine is a template",
+							Computed:            true,
+						},
+						"cpus": schema.Int64Attribute{
+							MarkdownDescription: "Number of virtual CPUs",
+							Computed:            true,
+						},
+						"max_memory": schema.Int64Attribute{
+							MarkdownDescription: "Configured memory in bytes",
+							Computed:            true,
+						},
+						"uptime": schema.Int64Attribute{
+							MarkdownDescription: "Uptime in seconds",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("virtual machines"),
+		},
+	}
+}
+
+func (d *VMsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ProxmoxClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProxmoxClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VMsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VMsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Proxmox virtual machines")
+
+	entries, err := d.client.clusterResourcesOfType(ctx, guestTypeVM)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster resources, got error: %s", err))
+		return
+	}
+
+	vms := []VMSummaryModel{}
+	for _, entry := range entries {
+		vm := newVMSummaryModel(entry)
+		if data.matches(vm) {
+			vms = append(vms, vm)
+		}
+	}
+	vms, diags := applyFilters(data.Filters, vms)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].VMID.ValueInt64() < vms[j].VMID.ValueInt64()
+	})
+
+	data.VMs = vms
+	data.ID = types.StringValue("vms")
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d virtual machines", len(vms)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches reports whether the virtual machine passes all configured filters.
+func (m VMsDataSourceModel) matches(vm VMSummaryModel) bool {
+	if !m.Node.IsNull() && !m.Node.Equal(vm.Node) {
+		return false
+	}
+	if !m.Pool.IsNull() && !m.Pool.Equal(vm.Pool) {
+		return false
+	}
+	if !m.Status.IsNull() && !m.Status.Equal(vm.Status) {
+		return false
+	}
+	if !m.Template.IsNull() && !m.Template.Equal(vm.Template) {
+		return false
+	}
+	for _, tag := range m.Tags {
+		if !slices.Contains(vm.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func newVMSummaryModel(entry map[string]interface{}) VMSummaryModel {
+	tags := splitList(stringValue(entry, "tags").ValueString())
+	sort.Strings(tags)
+
+	template := boolValue(entry, "template")
+	if template.IsNull() {
+		template = types.BoolValue(false)
+	}
+
+	return VMSummaryModel{
+		VMID:      int64Value(entry, "vmid"),
+		Name:      stringValue(entry, "name"),
+		Node:      stringValue(entry, "node"),
+		Status:    stringValue(entry, "status"),
+		Tags:      tags,
+		Pool:      stringValue(entry, "pool"),
+		Template:  template,
+		CPUs:      int64Value(entry, "maxcpu"),
+		MaxMemory: int64Value(entry, "maxmem"),
+		Uptime:    int64Value(entry, "uptime"),
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMsDataSource(t *testing.T) {
+	vmID := testAccRequireEnv(t, "PROXMOX_VM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "proxmox_vms" "test" {}
+
+data "proxmox_vms" "templates" {
+  node     = %[1]q
+  template = true
+}
+
+data "proxmox_vms" "filtered" {
+  filter {
+    name   = "vm_id"
+    values = [%[2]q]
+  }
+}
+`, testNode(), vmID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.proxmox_vms.test", "id", "vms"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.proxmox_vms.test", "vms.*", map[string]string{
+						"vm_id": vmID,
+					}),
+					resource.TestCheckResourceAttrSet("data.proxmox_vms.templates", "vms.#"),
+					resource.TestCheckResourceAttr("data.proxmox_vms.filtered", "vms.#", "1"),
+					resource.TestCheckResourceAttr("data.proxmox_vms.filtered", "vms.0.vm_id", vmID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// This file contains the version triggers of write-only secrets, such as
+// passwords and API tokens. Write-only attributes require Terraform 1.11 or
+// later and are never stored in the state, so Terraform cannot detect when
+// their value changes. Resources with a write-only secret therefore have a
+// `<secret>_version` attribute next to it and only send the secret again on
+// update when the version changes.
+
+// secretVersionAttribute returns the `<secret>_version` attribute of the
+// described write-only secret.
+func secretVersionAttribute(secret string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("Arbitrary version number of the %s. The %[1]s is write-only, so it is "+
+			"only sent again on update when this version changes", secret),
+		Optional: true,
+	}
+}
+
+// secretVersionChanged reports whether a write-only secret has to be sent
+// again because its version in the plan differs from the state.
+func secretVersionChanged(plan, state types.Int64) bool {
+	return !plan.Equal(state)
+}
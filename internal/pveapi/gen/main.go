@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command gen generates the request types and parameter tables of package
+// pveapi from the Proxmox VE API schema, for the endpoints listed in
+// endpoints.txt. The schema is read from apidoc.js as shipped by
+// pve-docs, or from the JSON array it contains.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "apidoc.json", "path of apidoc.js or the JSON schema")
+	dir := flag.String("dir", ".", "directory of package pveapi")
+	subset := flag.Bool("subset", false, "replace apidoc.json with the listed endpoints of the schema")
+	flag.Parse()
+
+	if err := run(*schemaPath, *dir, *subset); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(schemaPath, dir string, subset bool) error {
+	schemaFile, err := os.Open(schemaPath)
+	if err != nil {
+		return err
+	}
+	defer schemaFile.Close()
+
+	schema, err := loadSchema(schemaFile)
+	if err != nil {
+		return fmt.Errorf("unable to load schema %s: %w", schemaPath, err)
+	}
+
+	endpointsFile, err := os.Open(filepath.Join(dir, "endpoints.txt"))
+	if err != nil {
+		return err
+	}
+	defer endpointsFile.Close()
+
+	endpoints, err := loadEndpoints(endpointsFile)
+	if err != nil {
+		return fmt.Errorf("unable to load endpoints: %w", err)
+	}
+
+	if subset {
+		data, err := marshalSubset(schema, endpoints)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "apidoc.json"), data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	code, warnings, err := generate(schema, endpoints)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		log.Print(warning)
+	}
+	return os.WriteFile(filepath.Join(dir, "requests_gen.go"), code, 0o644)
+}
+
+// node is a path of the API schema tree. The methods are kept as raw JSON so
+// that subsets keep all of their details.
+type node struct {
+	Path     string                     `json:"path"`
+	Text     string                     `json:"text"`
+	Leaf     int                        `json:"leaf"`
+	Info     map[string]json.RawMessage `json:"info,omitempty"`
+	Children []*node                    `json:"children,omitempty"`
+}
+
+// method is the part of a method definition used for code generation.
+type method struct {
+	Description string `json:"description"`
+	Parameters  struct {
+		Properties map[string]*property `json:"properties"`
+	} `json:"parameters"`
+}
+
+// property is a parameter definition. Formats of property strings are
+// objects, which are not named formats and are ignored.
+type property struct {
+	Type        string          `json:"type"`
+	Optional    schemaFlag      `json:"optional"`
+	Description string          `json:"description"`
+	Format      json.RawMessage `json:"format"`
+	Enum        []string        `json:"enum"`
+	Pattern     string          `json:"pattern"`
+	MinLength   int             `json:"minLength"`
+	MaxLength   int             `json:"maxLength"`
+	Minimum     *float64        `json:"minimum"`
+	Maximum     *float64        `json:"maximum"`
+}
+
+// schemaFlag is a boolean the schema gives as 0 or 1.
+type schemaFlag bool
+
+func (f *schemaFlag) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "1", "true":
+		*f = true
+	case "0", "false", "null":
+		*f = false
+	default:
+		return fmt.Errorf("invalid flag %s", data)
+	}
+	return nil
+}
+
+// endpoint is a line of endpoints.txt.
+type endpoint struct {
+	Method, Path, TypeName string
+}
+
+func (e endpoint) String() string {
+	return e.Method + " " + e.Path
+}
+
+// loadSchema reads the schema tree, skipping the JavaScript around the array
+// in apidoc.js.
+func loadSchema(r io.Reader) ([]*node, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if i := bytes.Index(data, []byte("apiSchema")); i >= 0 {
+		data = data[i:]
+	}
+	start := bytes.IndexByte(data, '[')
+	if start < 0 {
+		return nil, errors.New("no schema array found")
+	}
+
+	// The decoder stops after the array, ignoring the code following it.
+	var schema []*node
+	if err := json.NewDecoder(bytes.NewReader(data[start:])).Decode(&schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// loadEndpoints reads the endpoint list, which has an endpoint per line and
+// # comments.
+func loadEndpoints(r io.Reader) ([]endpoint, error) {
+	var endpoints []endpoint
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: expected `METHOD path TypeName`, got %q", line, text)
+		}
+		endpoints = append(endpoints, endpoint{Method: fields[0], Path: fields[1], TypeName: fields[2]})
+	}
+	return endpoints, scanner.Err()
+}
+
+// findMethod returns the definition of an endpoint.
+func findMethod(schema []*node, e endpoint) (json.RawMessage, error) {
+	for _, n := range schema {
+		if n.Path == e.Path {
+			if definition, ok := n.Info[e.Method]; ok {
+				return definition, nil
+			}
+			return nil, fmt.Errorf("%s: no such method", e)
+		}
+		if strings.HasPrefix(e.Path, n.Path+"/") {
+			return findMethod(n.Children, e)
+		}
+	}
+	return nil, fmt.Errorf("%s: no such path", e)
+}
+
+// marshalSubset returns the schema reduced to the paths and methods of the
+// endpoints.
+func marshalSubset(schema []*node, endpoints []endpoint) ([]byte, error) {
+	for _, e := range endpoints {
+		if _, err := findMethod(schema, e); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(prune(schema, endpoints)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func prune(nodes []*node, endpoints []endpoint) []*node {
+	var kept []*node
+	for _, n := range nodes {
+		pruned := &node{Path: n.Path, Text: n.Text, Leaf: n.Leaf}
+		for _, e := range endpoints {
+			if e.Path == n.Path {
+				if pruned.Info == nil {
+					pruned.Info = map[string]json.RawMessage{}
+				}
+				pruned.Info[e.Method] = n.Info[e.Method]
+			}
+		}
+		pruned.Children = prune(n.Children, endpoints)
+		if pruned.Info != nil || pruned.Children != nil {
+			kept = append(kept, pruned)
+		}
+	}
+	return kept
+}
+
+// generate returns the source of the request types and parameter tables,
+// and warnings about parts of the schema that cannot be used.
+func generate(schema []*node, endpoints []endpoint) ([]byte, []string, error) {
+	var types, tables bytes.Buffer
+	var warnings []string
+
+	for _, e := range endpoints {
+		definition, err := findMethod(schema, e)
+		if err != nil {
+			return nil, nil, err
+		}
+		var m method
+		if err := json.Unmarshal(definition, &m); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", e, err)
+		}
+
+		names := make([]string, 0, len(m.Parameters.Properties))
+		for name := range m.Parameters.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Fprintf(&types, "\n// %s holds the parameters of %s, except the ones in the path.", e.TypeName, e)
+		if description := strings.TrimSpace(m.Description); description != "" {
+			fmt.Fprintf(&types, "\n//\n%s", comment(description, ""))
+		}
+		fmt.Fprintf(&types, "\ntype %s struct {\n", e.TypeName)
+		fmt.Fprintf(&tables, "\t%q: {\n", e.String())
+
+		for _, name := range names {
+			p := m.Parameters.Properties[name]
+
+			if !strings.Contains(e.Path, "{"+name+"}") {
+				goType, ok := map[string]string{"string": "string", "integer": "int64", "number": "float64", "boolean": "Bool"}[p.Type]
+				if !ok {
+					return nil, nil, fmt.Errorf("%s: parameter %s has unsupported type %q", e, name, p.Type)
+				}
+				tag := name
+				if p.Optional {
+					goType, tag = "*"+goType, name+",omitempty"
+				}
+				if description := strings.TrimSpace(p.Description); description != "" {
+					types.WriteString(comment(description, "\t"))
+					types.WriteByte('\n')
+				}
+				fmt.Fprintf(&types, "\t%s %s `json:%q`\n", fieldName(name), goType, tag)
+			}
+
+			fmt.Fprintf(&tables, "\t\t%q: {Name: %q, Type: %q", name, name, p.Type)
+			if p.Optional {
+				tables.WriteString(", Optional: true")
+			}
+			var formatName string
+			if json.Unmarshal(p.Format, &formatName) == nil && formatName != "" {
+				fmt.Fprintf(&tables, ", Format: %q", formatName)
+			}
+			if len(p.Enum) > 0 {
+				fmt.Fprintf(&tables, ", Enum: %#v", p.Enum)
+			}
+			if p.Pattern != "" {
+				if _, err := regexp.Compile("^(?:" + p.Pattern + ")$"); err != nil {
+					warnings = append(warnings, fmt.Sprintf("%s: ignoring pattern of parameter %s: %s", e, name, err))
+				} else {
+					fmt.Fprintf(&tables, ", Pattern: %q", p.Pattern)
+				}
+			}
+			if p.MinLength > 0 {
+				fmt.Fprintf(&tables, ", MinLength: %d", p.MinLength)
+			}
+			if p.MaxLength > 0 {
+				fmt.Fprintf(&tables, ", MaxLength: %d", p.MaxLength)
+			}
+			if p.Minimum != nil {
+				fmt.Fprintf(&tables, ", Minimum: limit(%s)", strconv.FormatFloat(*p.Minimum, 'f', -1, 64))
+			}
+			if p.Maximum != nil {
+				fmt.Fprintf(&tables, ", Maximum: limit(%s)", strconv.FormatFloat(*p.Maximum, 'f', -1, 64))
+			}
+			tables.WriteString("},\n")
+		}
+
+		types.WriteString("}\n")
+		tables.WriteString("\t},\n")
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by go run ./gen; DO NOT EDIT.\n\npackage pveapi\n")
+	src.Write(types.Bytes())
+	src.WriteString("\n// parameters holds the parameters of the endpoints by `METHOD path` and name.\n")
+	src.WriteString("var parameters = map[string]map[string]Parameter{\n")
+	src.Write(tables.Bytes())
+	src.WriteString("}\n")
+
+	code, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid generated code: %w\n%s", err, src.Bytes())
+	}
+	return code, warnings, nil
+}
+
+// initialisms are the words of parameter names that are not capitalized
+// like others in field names.
+var initialisms = map[string]string{
+	"bwlimit":    "BWLimit",
+	"id":         "ID",
+	"ostemplate": "OSTemplate",
+	"tokenid":    "TokenID",
+	"userid":     "UserID",
+	"vmid":       "VMID",
+}
+
+// fieldName returns the Go field name of a parameter, e.g. NotesTemplate
+// for notes-template.
+func fieldName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// comment returns text as line comment wrapped at 80 columns.
+func comment(text, indent string) string {
+	var lines []string
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 80 && line != indent+"//" {
+			lines = append(lines, line)
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	return strings.Join(append(lines, line), "\n")
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedCode ensures the checked-in code and schema subset match the
+// generator, so that changes to either are not lost on the next run.
+func TestGeneratedCode(t *testing.T) {
+	schemaData, err := os.ReadFile("../apidoc.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := loadSchema(bytes.NewReader(schemaData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointsFile, err := os.Open("../endpoints.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer endpointsFile.Close()
+	endpoints, err := loadEndpoints(endpointsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, warnings, err := generate(schema, endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) > 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	checkedIn, err := os.ReadFile("../requests_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(code, checkedIn) {
+		t.Error("requests_gen.go is outdated, run go generate ./internal/pveapi")
+	}
+
+	subset, err := marshalSubset(schema, endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(subset, schemaData) {
+		t.Error("apidoc.json contains more than the listed endpoints or is not formatted by the generator")
+	}
+}
+
+func TestLoadSchema(t *testing.T) {
+	apidoc := `const apiSchema = [
+  {"path": "/version", "text": "version", "leaf": 1, "info": {"GET": {"description": "API version details.", "parameters": {"properties": {}}}}}
+];
+let method2cmd = { "GET": "get" };
+`
+	schema, err := loadSchema(strings.NewReader(apidoc))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := findMethod(schema, endpoint{Method: "GET", Path: "/version"}); err != nil {
+		t.Errorf("expected to find GET /version: %s", err)
+	}
+	if _, err := findMethod(schema, endpoint{Method: "POST", Path: "/version"}); err == nil {
+		t.Error("expected an error for a missing method")
+	}
+
+	if _, err := loadSchema(strings.NewReader("let x = 1;")); err == nil {
+		t.Error("expected an error without schema")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	schema, err := loadSchema(strings.NewReader(`[{"path": "/nodes", "text": "nodes", "leaf": 0, "children": [
+  {"path": "/nodes/{node}/config", "text": "config", "leaf": 1, "info": {"PUT": {"parameters": {"properties": {
+    "node": {"type": "string", "format": "pve-node"},
+    "wakeonlan": {"type": "string", "optional": 1, "pattern": "[0-9a-f:]+", "maxLength": 17},
+    "startall-onboot-delay": {"type": "integer", "optional": 1, "minimum": 0, "maximum": 300},
+    "acme": {"type": "string", "optional": 1, "format": {"domains": {"type": "string"}}},
+    "lookbehind": {"type": "string", "optional": 1, "pattern": "(?<=a)b"}
+  }}}}}
+]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, warnings, err := generate(schema, []endpoint{{Method: "PUT", Path: "/nodes/{node}/config", TypeName: "NodeConfigRequest"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, want := range []string{
+		"type NodeConfigRequest struct {",
+		"StartallOnbootDelay *int64  `json:\"startall-onboot-delay,omitempty\"`",
+		`"wakeonlan":             {Name: "wakeonlan", Type: "string", Optional: true, Pattern: "[0-9a-f:]+", MaxLength: 17},`,
+		`Minimum: limit(0), Maximum: limit(300)}`,
+		`"acme":                  {Name: "acme", Type: "string", Optional: true},`,
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("expected generated code to contain %s, got:\n%s", want, code)
+		}
+	}
+	if strings.Contains(string(code), "Node string") {
+		t.Error("expected path parameters to be left out of the request type")
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "lookbehind") {
+		t.Errorf("expected a warning about the unsupported pattern, got %v", warnings)
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	for name, want := range map[string]string{
+		"notes-template": "NotesTemplate",
+		"vmid":           "VMID",
+		"bwlimit":        "BWLimit",
+		"prune_backups":  "PruneBackups",
+		"net0":           "Net0",
+	} {
+		if got := fieldName(name); got != want {
+			t.Errorf("%s: got %s, want %s", name, got, want)
+		}
+	}
+}
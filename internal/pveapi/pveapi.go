@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package pveapi contains request types and parameter tables generated from
+// the API schema Proxmox VE publishes as apidoc.js, so that the provider
+// sends and validates parameters the way the API defines them. apidoc.json
+// holds the part of the schema for the endpoints listed in endpoints.txt.
+//
+// To update to a new Proxmox VE release, copy
+// /usr/share/pve-docs/api-viewer/apidoc.js from a node and run
+//
+//	go run ./internal/pveapi/gen -schema apidoc.js -dir internal/pveapi -subset
+//
+// which replaces apidoc.json with the listed endpoints of the new schema and
+// regenerates the code. go generate regenerates the code from apidoc.json.
+package pveapi
+
+import "fmt"
+
+//go:generate go run ./gen -schema apidoc.json -dir .
+
+// Bool is a boolean parameter, which Proxmox VE expects as 0 or 1.
+type Bool bool
+
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if b {
+		return []byte("1"), nil
+	}
+	return []byte("0"), nil
+}
+
+// Parameter describes a parameter of an endpoint as defined by the schema.
+// Limits that the schema does not define are nil or zero.
+type Parameter struct {
+	Name     string
+	Type     string
+	Optional bool
+
+	// Format is the name of a format registered by Proxmox VE, e.g.
+	// pve-storage-id.
+	Format string
+
+	Enum      []string
+	Pattern   string
+	MinLength int
+	MaxLength int
+	Minimum   *float64
+	Maximum   *float64
+}
+
+// Lookup returns a parameter of an endpoint, given as `METHOD path`. It
+// panics if the endpoint is not generated or has no such parameter, which is
+// a programming error caught by the schema tests.
+func Lookup(endpoint, name string) Parameter {
+	parameter, ok := parameters[endpoint][name]
+	if !ok {
+		panic(fmt.Sprintf("pveapi: unknown parameter %q of %s", name, endpoint))
+	}
+	return parameter
+}
+
+// Ptr returns a pointer to v, for the optional fields of requests.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// limit returns a pointer to a limit of the tables.
+func limit(v float64) *float64 {
+	return &v
+}
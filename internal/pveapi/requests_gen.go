@@ -0,0 +1,187 @@
+// Code generated by go run ./gen; DO NOT EDIT.
+
+package pveapi
+
+// ChangePasswordRequest holds the parameters of PUT /access/password, except the ones in the path.
+//
+// Change user password.
+type ChangePasswordRequest struct {
+	// The current password of the user performing the change.
+	ConfirmationPassword *string `json:"confirmation-password,omitempty"`
+	// The new password.
+	Password string `json:"password"`
+	// Full User ID, in the `name@realm` format.
+	UserID string `json:"userid"`
+}
+
+// CreateTokenRequest holds the parameters of POST /access/users/{userid}/token/{tokenid}, except the ones in the path.
+//
+// Generate a new API token for a specific user. NOTE: returns API token value,
+// which needs to be stored as it cannot be retrieved afterwards!
+type CreateTokenRequest struct {
+	Comment *string `json:"comment,omitempty"`
+	// API token expiration date (seconds since epoch). '0' means no expiration
+	// date.
+	Expire *int64 `json:"expire,omitempty"`
+	// Restrict API token privileges with separate ACLs (default), or give full
+	// privileges of corresponding user.
+	Privsep *Bool `json:"privsep,omitempty"`
+}
+
+// UpdateTokenRequest holds the parameters of PUT /access/users/{userid}/token/{tokenid}, except the ones in the path.
+//
+// Update API token for a specific user.
+type UpdateTokenRequest struct {
+	Comment *string `json:"comment,omitempty"`
+	// API token expiration date (seconds since epoch). '0' means no expiration
+	// date.
+	Expire *int64 `json:"expire,omitempty"`
+	// Restrict API token privileges with separate ACLs (default), or give full
+	// privileges of corresponding user.
+	Privsep *Bool `json:"privsep,omitempty"`
+}
+
+// CreateContainerRequest holds the parameters of POST /nodes/{node}/lxc, except the ones in the path.
+//
+// Create or restore a container.
+type CreateContainerRequest struct {
+	// Override I/O bandwidth limit (in KiB/s).
+	BWLimit *float64 `json:"bwlimit,omitempty"`
+	// Allow to overwrite existing container.
+	Force *Bool `json:"force,omitempty"`
+	// Set a host name for the container.
+	Hostname *string `json:"hostname,omitempty"`
+	// The OS template or backup file.
+	OSTemplate string `json:"ostemplate"`
+	// Add the VM to the specified pool.
+	Pool *string `json:"pool,omitempty"`
+	// Mark this as restore task.
+	Restore *Bool `json:"restore,omitempty"`
+	// Start the CT after its creation finished successfully.
+	Start *Bool `json:"start,omitempty"`
+	// Default Storage.
+	Storage *string `json:"storage,omitempty"`
+	// Assign a unique random ethernet address.
+	Unique *Bool `json:"unique,omitempty"`
+	// The (unique) ID of the VM.
+	VMID int64 `json:"vmid"`
+}
+
+// CreateVMRequest holds the parameters of POST /nodes/{node}/qemu, except the ones in the path.
+//
+// Create or restore a virtual machine.
+type CreateVMRequest struct {
+	// The backup archive. Either the file system path to a .tar or .vma file (use
+	// '-' to pipe data from stdin) or a proxmox storage backup volume identifier.
+	Archive *string `json:"archive,omitempty"`
+	// Override I/O bandwidth limit (in KiB/s).
+	BWLimit *int64 `json:"bwlimit,omitempty"`
+	// The number of cores per socket.
+	Cores *int64 `json:"cores,omitempty"`
+	// Allow to overwrite existing VM.
+	Force *Bool `json:"force,omitempty"`
+	// Memory properties.
+	Memory *string `json:"memory,omitempty"`
+	// Set a name for the VM. Only used on the configuration web interface.
+	Name *string `json:"name,omitempty"`
+	// Add the VM to the specified pool.
+	Pool *string `json:"pool,omitempty"`
+	// Start VM after it was created successfully.
+	Start *Bool `json:"start,omitempty"`
+	// Default storage.
+	Storage *string `json:"storage,omitempty"`
+	// Assign a unique random ethernet address.
+	Unique *Bool `json:"unique,omitempty"`
+	// The (unique) ID of the VM.
+	VMID int64 `json:"vmid"`
+}
+
+// BackupRequest holds the parameters of POST /nodes/{node}/vzdump, except the ones in the path.
+//
+// Create backup.
+type BackupRequest struct {
+	// Backup all known guest systems on this host.
+	All *Bool `json:"all,omitempty"`
+	// Limit I/O bandwidth (in KiB/s).
+	BWLimit *int64 `json:"bwlimit,omitempty"`
+	// Compress dump file.
+	Compress *string `json:"compress,omitempty"`
+	// Backup mode.
+	Mode *string `json:"mode,omitempty"`
+	// Template string for generating notes for the backup(s). It can contain
+	// variables which will be replaced by their values. Currently supported are
+	// {{cluster}}, {{guestname}}, {{node}}, and {{vmid}}, but more might be added
+	// in the future. Needs to be a single line, newline and backslash need to be
+	// escaped as '\n' and '\\' respectively.
+	NotesTemplate *string `json:"notes-template,omitempty"`
+	// If true, mark backup(s) as protected.
+	Protected *Bool `json:"protected,omitempty"`
+	// Prune older backups according to 'prune-backups'.
+	Remove *Bool `json:"remove,omitempty"`
+	// Store resulting file to this storage.
+	Storage *string `json:"storage,omitempty"`
+	// The ID of the guest system you want to backup.
+	VMID *string `json:"vmid,omitempty"`
+}
+
+// parameters holds the parameters of the endpoints by `METHOD path` and name.
+var parameters = map[string]map[string]Parameter{
+	"PUT /access/password": {
+		"confirmation-password": {Name: "confirmation-password", Type: "string", Optional: true, MinLength: 5, MaxLength: 64},
+		"password":              {Name: "password", Type: "string", MinLength: 5, MaxLength: 64},
+		"userid":                {Name: "userid", Type: "string", Format: "pve-userid", MaxLength: 64},
+	},
+	"POST /access/users/{userid}/token/{tokenid}": {
+		"comment": {Name: "comment", Type: "string", Optional: true},
+		"expire":  {Name: "expire", Type: "integer", Optional: true, Minimum: limit(0)},
+		"privsep": {Name: "privsep", Type: "boolean", Optional: true},
+		"tokenid": {Name: "tokenid", Type: "string", Format: "pve-tokenid"},
+		"userid":  {Name: "userid", Type: "string", Format: "pve-userid", MaxLength: 64},
+	},
+	"PUT /access/users/{userid}/token/{tokenid}": {
+		"comment": {Name: "comment", Type: "string", Optional: true},
+		"expire":  {Name: "expire", Type: "integer", Optional: true, Minimum: limit(0)},
+		"privsep": {Name: "privsep", Type: "boolean", Optional: true},
+		"tokenid": {Name: "tokenid", Type: "string", Format: "pve-tokenid"},
+		"userid":  {Name: "userid", Type: "string", Format: "pve-userid", MaxLength: 64},
+	},
+	"POST /nodes/{node}/lxc": {
+		"bwlimit":    {Name: "bwlimit", Type: "number", Optional: true, Minimum: limit(0)},
+		"force":      {Name: "force", Type: "boolean", Optional: true},
+		"hostname":   {Name: "hostname", Type: "string", Optional: true, Format: "dns-name", MaxLength: 255},
+		"node":       {Name: "node", Type: "string", Format: "pve-node"},
+		"ostemplate": {Name: "ostemplate", Type: "string", MaxLength: 255},
+		"pool":       {Name: "pool", Type: "string", Optional: true, Format: "pve-poolid"},
+		"restore":    {Name: "restore", Type: "boolean", Optional: true},
+		"start":      {Name: "start", Type: "boolean", Optional: true},
+		"storage":    {Name: "storage", Type: "string", Optional: true, Format: "pve-storage-id"},
+		"unique":     {Name: "unique", Type: "boolean", Optional: true},
+		"vmid":       {Name: "vmid", Type: "integer", Format: "pve-vmid", Minimum: limit(100), Maximum: limit(999999999)},
+	},
+	"POST /nodes/{node}/qemu": {
+		"archive": {Name: "archive", Type: "string", Optional: true, Format: "pve-volume-id-or-absolute-path", MaxLength: 255},
+		"bwlimit": {Name: "bwlimit", Type: "integer", Optional: true, Minimum: limit(0)},
+		"cores":   {Name: "cores", Type: "integer", Optional: true, Minimum: limit(1)},
+		"force":   {Name: "force", Type: "boolean", Optional: true},
+		"memory":  {Name: "memory", Type: "string", Optional: true, Format: "pve-qm-memory"},
+		"name":    {Name: "name", Type: "string", Optional: true, Format: "dns-name"},
+		"node":    {Name: "node", Type: "string", Format: "pve-node"},
+		"pool":    {Name: "pool", Type: "string", Optional: true, Format: "pve-poolid"},
+		"start":   {Name: "start", Type: "boolean", Optional: true},
+		"storage": {Name: "storage", Type: "string", Optional: true, Format: "pve-storage-id"},
+		"unique":  {Name: "unique", Type: "boolean", Optional: true},
+		"vmid":    {Name: "vmid", Type: "integer", Format: "pve-vmid", Minimum: limit(100), Maximum: limit(999999999)},
+	},
+	"POST /nodes/{node}/vzdump": {
+		"all":            {Name: "all", Type: "boolean", Optional: true},
+		"bwlimit":        {Name: "bwlimit", Type: "integer", Optional: true, Minimum: limit(0)},
+		"compress":       {Name: "compress", Type: "string", Optional: true, Enum: []string{"0", "1", "gzip", "lzo", "zstd"}},
+		"mode":           {Name: "mode", Type: "string", Optional: true, Enum: []string{"snapshot", "suspend", "stop"}},
+		"node":           {Name: "node", Type: "string", Optional: true, Format: "pve-node"},
+		"notes-template": {Name: "notes-template", Type: "string", Optional: true, MaxLength: 1024},
+		"protected":      {Name: "protected", Type: "boolean", Optional: true},
+		"remove":         {Name: "remove", Type: "boolean", Optional: true},
+		"storage":        {Name: "storage", Type: "string", Optional: true, Format: "pve-storage-id"},
+		"vmid":           {Name: "vmid", Type: "string", Optional: true, Format: "pve-vmid-list"},
+	},
+}
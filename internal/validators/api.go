@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/cemdorst/terraform-provider-proxmox/internal/pveapi"
+)
+
+var _ validator.String = APIParameterValidator{}
+var _ validator.Int64 = APIParameterValidator{}
+
+// APIParameterValidator validates values against the definition of a
+// parameter in the Proxmox VE API schema: its allowed values, pattern,
+// length and range, and the formats of this package.
+type APIParameterValidator struct {
+	parameter pveapi.Parameter
+	pattern   *regexp.Regexp
+	format    *FormatValidator
+}
+
+// formats are the validators of the named formats of the API schema.
+var formats = map[string]func() FormatValidator{
+	"dns-name":       DNSName,
+	"pve-storage-id": StorageID,
+}
+
+// APIParameter returns a validator for a parameter of an endpoint, given as
+// `METHOD path`, e.g. `POST /nodes/{node}/vzdump`. The endpoint must be
+// listed in internal/pveapi/endpoints.txt.
+func APIParameter(endpoint, name string) APIParameterValidator {
+	v := APIParameterValidator{parameter: pveapi.Lookup(endpoint, name)}
+	if v.parameter.Pattern != "" {
+		// Patterns are checked for compatibility when generating the tables.
+		v.pattern = regexp.MustCompile("^(?:" + v.parameter.Pattern + ")$")
+	}
+	if newFormat, ok := formats[v.parameter.Format]; ok {
+		format := newFormat()
+		v.format = &format
+	}
+	return v
+}
+
+func (v APIParameterValidator) Description(ctx context.Context) string {
+	var rules []string
+	p := v.parameter
+	if len(p.Enum) > 0 {
+		rules = append(rules, "one of `"+strings.Join(p.Enum, "`, `")+"`")
+	}
+	if v.format != nil {
+		rules = append(rules, "a valid "+v.format.format)
+	}
+	if v.pattern != nil {
+		rules = append(rules, "matching `"+p.Pattern+"`")
+	}
+	if rule := v.lengthRule(); rule != "" {
+		rules = append(rules, rule)
+	}
+	if rule := v.rangeRule(); rule != "" {
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		return "value must be accepted by the Proxmox VE API"
+	}
+	return "value must be " + strings.Join(rules, ", ")
+}
+
+func (v APIParameterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v APIParameterValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Invalid value %q: %s.", req.ConfigValue.ValueString(), err))
+	}
+}
+
+func (v APIParameterValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueInt64()
+	p := v.parameter
+	if (p.Minimum != nil && float64(value) < *p.Minimum) || (p.Maximum != nil && float64(value) > *p.Maximum) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Invalid value %d: must be %s.", value, v.rangeRule()))
+	}
+}
+
+// check returns why a string is not accepted, or nil.
+func (v APIParameterValidator) check(value string) error {
+	p := v.parameter
+	if len(p.Enum) > 0 && !slices.Contains(p.Enum, value) {
+		return fmt.Errorf("must be one of `%s`", strings.Join(p.Enum, "`, `"))
+	}
+	if length := utf8.RuneCountInString(value); length < p.MinLength || (p.MaxLength > 0 && length > p.MaxLength) {
+		return fmt.Errorf("must be %s", v.lengthRule())
+	}
+	if v.pattern != nil && !v.pattern.MatchString(value) {
+		return fmt.Errorf("must match `%s`", p.Pattern)
+	}
+	if v.format != nil {
+		if err := v.format.check(value); err != nil {
+			return fmt.Errorf("not a valid %s: %w", v.format.format, err)
+		}
+	}
+	return nil
+}
+
+// lengthRule describes the length limits of the parameter, if any.
+func (v APIParameterValidator) lengthRule() string {
+	p := v.parameter
+	switch {
+	case p.MinLength > 0 && p.MaxLength > 0:
+		return fmt.Sprintf("between %d and %d characters long", p.MinLength, p.MaxLength)
+	case p.MinLength > 0:
+		return fmt.Sprintf("at least %d characters long", p.MinLength)
+	case p.MaxLength > 0:
+		return fmt.Sprintf("at most %d characters long", p.MaxLength)
+	}
+	return ""
+}
+
+// rangeRule describes the range of the parameter, if any.
+func (v APIParameterValidator) rangeRule() string {
+	p := v.parameter
+	switch {
+	case p.Minimum != nil && p.Maximum != nil:
+		return fmt.Sprintf("between %s and %s", formatLimit(*p.Minimum), formatLimit(*p.Maximum))
+	case p.Minimum != nil:
+		return "at least " + formatLimit(*p.Minimum)
+	case p.Maximum != nil:
+		return "at most " + formatLimit(*p.Maximum)
+	}
+	return ""
+}
+
+func formatLimit(limit float64) string {
+	return strconv.FormatFloat(limit, 'f', -1, 64)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validators contains attribute validators for the formats Proxmox VE
+// defines for its API parameters, so that invalid values fail during planning
+// instead of with an API error halfway through an apply. Unknown and null
+// values are not validated.
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Limits of guest IDs accepted by Proxmox VE.
+const (
+	MinVMID = 100
+	MaxVMID = 999999999
+)
+
+var _ validator.Int64 = VMIDValidator{}
+var _ validator.Set = VMIDValidator{}
+
+// VMIDValidator validates guest IDs, or each guest ID of a set.
+type VMIDValidator struct{}
+
+// VMID returns a validator for guest IDs.
+func VMID() VMIDValidator {
+	return VMIDValidator{}
+}
+
+func (v VMIDValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a guest ID between %d and %d", MinVMID, MaxVMID)
+}
+
+func (v VMIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v VMIDValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if id := req.ConfigValue.ValueInt64(); id < MinVMID || id > MaxVMID {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Guest IDs must be between %d and %d, got: %d.", MinVMID, MaxVMID, id))
+	}
+}
+
+func (v VMIDValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.Int64)
+		if !ok {
+			continue
+		}
+		elementResp := &validator.Int64Response{}
+		v.ValidateInt64(ctx, validator.Int64Request{Path: req.Path.AtSetValue(element), ConfigValue: value}, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+var _ validator.String = FormatValidator{}
+var _ validator.List = FormatValidator{}
+var _ validator.Set = FormatValidator{}
+
+// FormatValidator validates strings, or each string of a list or set,
+// against a format.
+type FormatValidator struct {
+	format string
+	check  func(value string) error
+}
+
+var (
+	storageIDPattern = regexp.MustCompile(`^(?i:[a-z][a-z0-9\-_.]*[a-z0-9])$`)
+	bridgePattern    = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-_.:]*$`)
+	dnsLabelPattern  = regexp.MustCompile(`^(?i:[a-z0-9]([a-z0-9\-]*[a-z0-9])?)$`)
+)
+
+// maxInterfaceName is the longest network interface name Linux accepts.
+const maxInterfaceName = 15
+
+// StorageID returns a validator for storage IDs, e.g. `local-lvm`.
+func StorageID() FormatValidator {
+	return FormatValidator{format: "storage ID", check: func(value string) error {
+		if !storageIDPattern.MatchString(value) {
+			return fmt.Errorf("must start with a letter, end with a letter or digit and contain only letters, digits, `-`, `_` and `.`")
+		}
+		return nil
+	}}
+}
+
+// BridgeName returns a validator for the names of bridges and other network
+// interfaces, e.g. `vmbr0` or `bond0.100`.
+func BridgeName() FormatValidator {
+	return FormatValidator{format: "interface name", check: func(value string) error {
+		if len(value) > maxInterfaceName {
+			return fmt.Errorf("must not be longer than %d characters", maxInterfaceName)
+		}
+		if !bridgePattern.MatchString(value) {
+			return fmt.Errorf("must start with a letter and contain only letters, digits, `-`, `_`, `.` and `:`")
+		}
+		return nil
+	}}
+}
+
+// CIDR returns a validator for IP networks in CIDR notation, e.g.
+// `10.0.0.0/24`.
+func CIDR() FormatValidator {
+	return FormatValidator{format: "CIDR", check: func(value string) error {
+		_, err := netip.ParsePrefix(value)
+		return err
+	}}
+}
+
+// MACAddress returns a validator for MAC addresses, e.g. `bc:24:11:aa:bb:cc`.
+func MACAddress() FormatValidator {
+	return FormatValidator{format: "MAC address", check: func(value string) error {
+		_, err := net.ParseMAC(value)
+		return err
+	}}
+}
+
+// DNSName returns a validator for fully qualified or relative DNS names, e.g.
+// `pve1.example.com`.
+func DNSName() FormatValidator {
+	return FormatValidator{format: "DNS name", check: func(value string) error {
+		name := strings.TrimSuffix(value, ".")
+		if name == "" || len(name) > 253 {
+			return fmt.Errorf("must be between 1 and 253 characters long")
+		}
+		for _, label := range strings.Split(name, ".") {
+			if len(label) > 63 || !dnsLabelPattern.MatchString(label) {
+				return fmt.Errorf("label %q must be at most 63 characters long, contain only letters, digits and `-` and not start or end with `-`", label)
+			}
+		}
+		return nil
+	}}
+}
+
+// PropertyValue returns a validator for values that are sent as part of a
+// property string like `key=value,key=value`, which cannot contain commas.
+func PropertyValue() FormatValidator {
+	return FormatValidator{format: "property value", check: func(value string) error {
+		if strings.ContainsAny(value, ",\n") {
+			return fmt.Errorf("must not contain commas or line breaks")
+		}
+		return nil
+	}}
+}
+
+func (v FormatValidator) Description(ctx context.Context) string {
+	return "value must be a valid " + v.format
+}
+
+func (v FormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v FormatValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Invalid %s %q: %s.", v.format, req.ConfigValue.ValueString(), err))
+	}
+}
+
+func (v FormatValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(basetypes.StringValuable)
+		if !ok {
+			continue
+		}
+		v.validateElement(ctx, req.Path.AtListIndex(i), value, &resp.Diagnostics)
+	}
+}
+
+func (v FormatValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(basetypes.StringValuable)
+		if !ok {
+			continue
+		}
+		v.validateElement(ctx, req.Path.AtSetValue(element), value, &resp.Diagnostics)
+	}
+}
+
+// validateElement validates an element of a list or set.
+func (v FormatValidator) validateElement(ctx context.Context, elementPath path.Path, element basetypes.StringValuable, diags *diag.Diagnostics) {
+	value, valueDiags := element.ToStringValue(ctx)
+	diags.Append(valueDiags...)
+	if valueDiags.HasError() {
+		return
+	}
+	resp := &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{Path: elementPath, ConfigValue: value}, resp)
+	diags.Append(resp.Diagnostics...)
+}
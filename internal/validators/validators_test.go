@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVMID(t *testing.T) {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		value types.Int64
+		valid bool
+	}{
+		{types.Int64Value(100), true},
+		{types.Int64Value(999999999), true},
+		{types.Int64Value(99), false},
+		{types.Int64Value(1000000000), false},
+		{types.Int64Null(), true},
+		{types.Int64Unknown(), true},
+	} {
+		var resp validator.Int64Response
+		VMID().ValidateInt64(ctx, validator.Int64Request{Path: path.Root("vm_id"), ConfigValue: test.value}, &resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("%s: got diagnostics %v, want valid %t", test.value, resp.Diagnostics, test.valid)
+		}
+	}
+
+	ids := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(100), types.Int64Value(42)})
+	var resp validator.SetResponse
+	VMID().ValidateSet(ctx, validator.SetRequest{Path: path.Root("vm_ids"), ConfigValue: ids}, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Errorf("%s: got diagnostics %v, want one error", ids, resp.Diagnostics)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		validator FormatValidator
+		value     string
+		valid     bool
+	}{
+		{StorageID(), "local-lvm", true},
+		{StorageID(), "NFS_backup.1", true},
+		{StorageID(), "1local", false},
+		{StorageID(), "local-", false},
+		{StorageID(), "local lvm", false},
+		{BridgeName(), "vmbr0", true},
+		{BridgeName(), "bond0.100", true},
+		{BridgeName(), "0vmbr", false},
+		{BridgeName(), "vmbr0/1", false},
+		{BridgeName(), "averyverylongbridge", false},
+		{CIDR(), "10.0.0.0/24", true},
+		{CIDR(), "fd00::/64", true},
+		{CIDR(), "10.0.0.1", false},
+		{MACAddress(), "BC:24:11:AA:BB:CC", true},
+		{MACAddress(), "bc:24:11", false},
+		{DNSName(), "pve1", true},
+		{DNSName(), "pve1.example.com.", true},
+		{DNSName(), "-pve1.example.com", false},
+		{DNSName(), "pve_1.example.com", false},
+		{DNSName(), "pve1..example.com", false},
+		{DNSName(), "", false},
+		{PropertyValue(), "tag:ffffff;other:000000", true},
+		{PropertyValue(), "a,b", false},
+	} {
+		var resp validator.StringResponse
+		test.validator.ValidateString(ctx, validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue(test.value)}, &resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("%s %q: got diagnostics %v, want valid %t", test.validator.format, test.value, resp.Diagnostics, test.valid)
+		}
+	}
+}
+
+func TestFormatElements(t *testing.T) {
+	ctx := context.Background()
+
+	values := []attr.Value{types.StringValue("local"), types.StringValue("1local"), types.StringUnknown()}
+
+	var listResp validator.ListResponse
+	StorageID().ValidateList(ctx, validator.ListRequest{Path: path.Root("storages"), ConfigValue: types.ListValueMust(types.StringType, values)}, &listResp)
+	if listResp.Diagnostics.ErrorsCount() != 1 {
+		t.Errorf("list: got diagnostics %v, want one error", listResp.Diagnostics)
+	}
+
+	var setResp validator.SetResponse
+	StorageID().ValidateSet(ctx, validator.SetRequest{Path: path.Root("storages"), ConfigValue: types.SetValueMust(types.StringType, values)}, &setResp)
+	if setResp.Diagnostics.ErrorsCount() != 1 {
+		t.Errorf("set: got diagnostics %v, want one error", setResp.Diagnostics)
+	}
+}
+
+func TestAPIParameter(t *testing.T) {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		validator APIParameterValidator
+		value     string
+		valid     bool
+	}{
+		{APIParameter("POST /nodes/{node}/vzdump", "mode"), "snapshot", true},
+		{APIParameter("POST /nodes/{node}/vzdump", "mode"), "live", false},
+		{APIParameter("POST /nodes/{node}/vzdump", "storage"), "local", true},
+		{APIParameter("POST /nodes/{node}/vzdump", "storage"), "1local", false},
+		{APIParameter("PUT /access/password", "password"), "secret", true},
+		{APIParameter("PUT /access/password", "password"), "pw", false},
+		{APIParameter("PUT /access/password", "password"), "päss", false},
+	} {
+		var resp validator.StringResponse
+		test.validator.ValidateString(ctx, validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue(test.value)}, &resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("%s %q: got diagnostics %v, want valid %t", test.validator.parameter.Name, test.value, resp.Diagnostics, test.valid)
+		}
+	}
+
+	bwLimit := APIParameter("POST /nodes/{node}/qemu", "bwlimit")
+	for value, valid := range map[int64]bool{0: true, 1024: true, -1: false} {
+		var resp validator.Int64Response
+		bwLimit.ValidateInt64(ctx, validator.Int64Request{Path: path.Root("bwlimit"), ConfigValue: types.Int64Value(value)}, &resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("bwlimit %d: got diagnostics %v, want valid %t", value, resp.Diagnostics, valid)
+		}
+	}
+
+	if got, want := APIParameter("POST /nodes/{node}/vzdump", "mode").Description(ctx), "value must be one of `snapshot`, `suspend`, `stop`"; got != want {
+		t.Errorf("got description %q, want %q", got, want)
+	}
+}